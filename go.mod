@@ -3,6 +3,7 @@ module github.com/edgexfoundry/go-mod-secrets/v2
 require (
 	github.com/edgexfoundry/go-mod-core-contracts/v2 v2.0.0
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 )
 
 go 1.16
@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// AgentMode reports whether the requests are sent without a token through a Vault Agent, relying on its auto-auth
+// and caching
+func (c *Client) AgentMode() bool {
+	return c.agent
+}
+
+// useAgent switches to agent mode when a Vault Agent is detected for the agent method, otherwise falling back to
+// direct mode with the configured AuthToken
+func (c *Client) useAgent(ctx context.Context) error {
+	if c.detectAgent(ctx) {
+		c.agent = true
+		// the AuthToken is only for direct mode, a token sent to the agent would take precedence over its auto-auth
+		c.setAuthToken("")
+		c.lc.Info("Vault Agent detected, authentication and caching are left to the agent")
+		return nil
+	}
+
+	if c.authToken() == "" {
+		return pkg.NewErrSecretStore("Vault Agent not detected and there is no AuthToken in config to fall back to")
+	}
+
+	c.lc.Warn("Vault Agent not detected, falling back to direct mode with the configured AuthToken")
+	return nil
+}
+
+// detectAgent reports whether a Vault Agent listens at the configured address. Only the agent serves its metrics
+// endpoint, to which a Vault server responds 404.
+func (c *Client) detectAgent(ctx context.Context) bool {
+	url, err := c.Config.BuildURL(AgentMetricsAPI)
+	if err != nil {
+		return false
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		c.lc.Debugf("unable to detect a Vault Agent: %s", err.Error())
+		return false
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	return resp.StatusCode == http.StatusOK
+}
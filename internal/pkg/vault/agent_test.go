@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockAgent is a stub Vault Agent adding its auto-auth token to requests without one, or a stub Vault server
+// requiring the token when agent is false
+type mockAgent struct {
+	mutex  sync.Mutex
+	agent  bool
+	tokens []string
+}
+
+func (m *mockAgent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	token := r.Header.Get(AuthTypeHeader)
+	switch r.URL.Path {
+	case AgentMetricsAPI:
+		if !m.agent {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Gauges":[],"Counters":[]}`))
+		return
+	case lookupSelfVaultAPI:
+		_ = json.NewEncoder(w).Encode(TokenLookupResponse{Data: types.TokenMetadata{Renewable: false}})
+		return
+	}
+
+	m.tokens = append(m.tokens, token)
+	if token == "" && !m.agent {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	_, _ = w.Write([]byte(`{"data":{"password":"password"}}`))
+}
+
+func TestAgentMode(t *testing.T) {
+	tests := []struct {
+		name            string
+		agent           bool
+		authToken       string
+		expectAgentMode bool
+		expectError     bool
+		expectedToken   string
+	}{
+		{"Agent detected", true, "", true, false, ""},
+		{"Agent detected with AuthToken", true, expectedToken, true, false, ""},
+		{"Fall back to direct mode", false, expectedToken, false, false, expectedToken},
+		{"No agent nor AuthToken", false, "", false, true, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAgent{agent: test.agent}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAgent, AuthToken: test.authToken})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectAgentMode, client.AgentMode())
+
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+			assert.Equal(t, []string{test.expectedToken}, mock.tokens)
+		})
+	}
+}
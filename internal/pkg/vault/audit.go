@@ -0,0 +1,132 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableAuditDevice enables the file, syslog or socket audit device at devicePath
+func (c *Client) EnableAuditDevice(ctx context.Context, token string, devicePath string, device types.AuditDevice) error {
+	switch device.Type {
+	case types.AuditDeviceFile, types.AuditDeviceSyslog, types.AuditDeviceSocket:
+	default:
+		return pkg.NewErrSecretStore("unsupported audit device type '" + device.Type + "'")
+	}
+
+	apiPath, err := auditDevicePath(AuditDevicesAPI, devicePath)
+	if err != nil {
+		return err
+	}
+
+	// the path is read-only, it is derived from the API path
+	device.Path = ""
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 apiPath,
+		JSONObject:           device,
+		BodyReader:           nil,
+		OperationDescription: "enable " + device.Type + " audit device",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// DisableAuditDevice disables the audit device at devicePath
+func (c *Client) DisableAuditDevice(ctx context.Context, token string, devicePath string) error {
+	apiPath, err := auditDevicePath(AuditDevicesAPI, devicePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodDelete,
+		Path:                 apiPath,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "disable audit device at " + devicePath,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ListAuditDevices returns the enabled audit devices keyed by their path
+func (c *Client) ListAuditDevices(ctx context.Context, token string) (map[string]types.AuditDevice, error) {
+	response := ListAuditDevicesResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 AuditDevicesAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "list audit devices",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return map[string]types.AuditDevice{}, nil
+	}
+	return response.Data, nil
+}
+
+// AuditHash returns the hash the audit device at devicePath logs for input
+func (c *Client) AuditHash(ctx context.Context, token string, devicePath string, input string) (string, error) {
+	apiPath, err := auditDevicePath(AuditHashAPI, devicePath)
+	if err != nil {
+		return "", err
+	}
+
+	response := AuditHashResponse{}
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 apiPath,
+		JSONObject:           AuditHashRequest{Input: input},
+		BodyReader:           nil,
+		OperationDescription: "hash input for audit device at " + devicePath,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return response.Hash, nil
+}
+
+func auditDevicePath(api string, devicePath string) (string, error) {
+	devicePath = strings.Trim(devicePath, "/")
+	if devicePath == "" {
+		return "", pkg.NewErrSecretStore("audit device path cannot be empty")
+	}
+
+	return path.Join(api, devicePath), nil
+}
@@ -0,0 +1,122 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockAuditServer is a stub Vault keeping the enabled audit devices keyed by their path
+type mockAuditServer struct {
+	mutex   sync.Mutex
+	devices map[string]types.AuditDevice
+}
+
+func (m *mockAuditServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == AuditDevicesAPI && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": m.devices})
+	case strings.HasPrefix(r.URL.Path, AuditHashAPI+"/") && r.Method == http.MethodPost:
+		devicePath := strings.TrimPrefix(r.URL.Path, AuditHashAPI+"/") + "/"
+		if _, exists := m.devices[devicePath]; !exists {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request AuditHashRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		_ = json.NewEncoder(w).Encode(AuditHashResponse{Hash: testAuditHash(request.Input)})
+	case r.Method == http.MethodPut:
+		devicePath := strings.TrimPrefix(r.URL.Path, AuditDevicesAPI+"/") + "/"
+		var device types.AuditDevice
+		_ = json.NewDecoder(r.Body).Decode(&device)
+		device.Path = devicePath
+		m.devices[devicePath] = device
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(m.devices, strings.TrimPrefix(r.URL.Path, AuditDevicesAPI+"/")+"/")
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testAuditHash(input string) string {
+	mac := hmac.New(sha256.New, []byte("salt"))
+	_, _ = mac.Write([]byte(input))
+	return "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuditDevices(t *testing.T) {
+	mock := &mockAuditServer{devices: make(map[string]types.AuditDevice)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	file := types.AuditDevice{
+		Type:        types.AuditDeviceFile,
+		Description: "EdgeX audit log",
+		Options:     map[string]string{"file_path": "/vault/logs/audit.log"},
+	}
+	require.NoError(t, client.EnableAuditDevice(context.Background(), expectedToken, "file", file))
+	syslog := types.AuditDevice{Type: types.AuditDeviceSyslog, Options: map[string]string{"tag": "vault"}}
+	require.NoError(t, client.EnableAuditDevice(context.Background(), expectedToken, "/syslog/", syslog))
+	require.Error(t, client.EnableAuditDevice(context.Background(), expectedToken, "unknown", types.AuditDevice{Type: "unknown"}))
+	require.Error(t, client.EnableAuditDevice(context.Background(), expectedToken, "", file))
+
+	devices, err := client.ListAuditDevices(context.Background(), expectedToken)
+	require.NoError(t, err)
+	file.Path = "file/"
+	syslog.Path = "syslog/"
+	assert.Equal(t, map[string]types.AuditDevice{"file/": file, "syslog/": syslog}, devices)
+
+	hash, err := client.AuditHash(context.Background(), expectedToken, "file", "s.token")
+	require.NoError(t, err)
+	assert.Equal(t, testAuditHash("s.token"), hash)
+	_, err = client.AuditHash(context.Background(), expectedToken, "socket", "s.token")
+	require.Error(t, err)
+
+	require.NoError(t, client.DisableAuditDevice(context.Background(), expectedToken, "syslog"))
+	devices, err = client.ListAuditDevices(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]types.AuditDevice{"file/": file}, devices)
+
+	_, err = client.ListAuditDevices(context.Background(), "bad-token")
+	require.Error(t, err)
+}
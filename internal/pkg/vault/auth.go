@@ -0,0 +1,435 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// newTokenProvider creates the AuthenticationTokenProvider for the configured auth method. Methods which are not built
+// into the client are looked up in the auth methods registered with pkg.RegisterAuthMethod.
+// A nil provider is returned for the token method, which uses the configured AuthToken as is, and for the agent
+// method, which leaves the token to the Vault Agent.
+func newTokenProvider(c *Client) (pkg.AuthenticationTokenProvider, error) {
+	switch c.Config.Authentication.Method {
+	case "", AuthMethodToken, AuthMethodAgent:
+		return nil, nil
+	case AuthMethodAppRole:
+		return &appRoleProvider{client: c, info: c.Config.Authentication.AppRole}, nil
+	case AuthMethodKubernetes:
+		return &kubernetesProvider{client: c, info: c.Config.Authentication.Kubernetes}, nil
+	case AuthMethodCert:
+		return &certProvider{client: c, info: c.Config.Authentication.Cert}, nil
+	case AuthMethodJWT:
+		return &jwtProvider{client: c, info: c.Config.Authentication.JWT, now: time.Now}, nil
+	case AuthMethodAWS:
+		return newAWSProvider(c, c.Config.Authentication.AWS), nil
+	case AuthMethodSPIFFE:
+		return newSPIFFEProvider(c, c.Config.Authentication.SPIFFE)
+	case AuthMethodRuntime:
+		return newRuntimeTokenProvider(c, c.Config.Authentication.RuntimeTokenProvider)
+	case AuthMethodUserpass:
+		if !c.Config.Authentication.DevMode {
+			return nil, pkg.NewErrSecretStore("the userpass auth method is only supported when Authentication DevMode is enabled")
+		}
+		c.lc.Warn("using the userpass auth method, which must only be used for development")
+		return &userpassProvider{client: c, info: c.Config.Authentication.Userpass}, nil
+	default:
+		factory, ok := pkg.LookupAuthMethod(c.Config.Authentication.Method)
+		if !ok {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("invalid Authentication Method '%s' in config", c.Config.Authentication.Method))
+		}
+		return factory(c.Config, c.loginWithMethod)
+	}
+}
+
+// login obtains a new token from the token provider and uses it for all subsequent requests
+func (c *Client) login(ctx context.Context) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+
+	token, expiry, err := c.tokenProvider.GetToken(ctx)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return pkg.NewErrSecretStore(fmt.Sprintf("%s login did not return a client token", c.Config.Authentication.Method))
+	}
+	if c.Config.Authentication.ExchangeChildToken {
+		if token, expiry, err = c.exchangeChildToken(ctx, token); err != nil {
+			return err
+		}
+	}
+
+	c.setAuthToken(token)
+	if expiry.IsZero() {
+		c.lc.Infof("logged in with the %s auth method", c.Config.Authentication.Method)
+	} else {
+		c.lc.Infof("logged in with the %s auth method, token expires at %s", c.Config.Authentication.Method,
+			expiry.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// reauthenticate logs in again as the token can no longer be renewed for the given reason, and notifies the
+// reauthentication callback of the outcome
+func (c *Client) reauthenticate(ctx context.Context, reason error) error {
+	c.lc.Infof("logging in again with the %s auth method as the token can no longer be renewed: %v",
+		c.Config.Authentication.Method, reason)
+
+	err := c.login(ctx)
+	if err != nil {
+		c.lc.Errorf("unable to log in again after the token could no longer be renewed: %v", err)
+	}
+
+	c.tokenMutex.RLock()
+	callback := c.reauthCallback
+	c.tokenMutex.RUnlock()
+	if callback != nil {
+		callback(reason, err)
+	}
+
+	return err
+}
+
+// SetReauthenticationCallback sets the callback invoked after the client logs in again because its token was
+// revoked or reached its max TTL
+func (c *Client) SetReauthenticationCallback(callback pkg.ReauthenticationCallback) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.reauthCallback = callback
+}
+
+// authToken returns the token currently used for requests, which is empty once the client is destroyed
+func (c *Client) authToken() string {
+	c.tokenBufferMutex.RLock()
+	defer c.tokenBufferMutex.RUnlock()
+
+	if c.token == nil {
+		return c.Config.Authentication.AuthToken
+	}
+	return string(c.token.Bytes())
+}
+
+// setAuthToken swaps the token used for requests, wiping the buffer of the token swapped out
+func (c *Client) setAuthToken(token string) {
+	// the buffer is not locked in memory, so NewBuffer cannot fail
+	buffer, _ := secure.NewBuffer([]byte(token), secure.BufferOptions{})
+
+	c.tokenBufferMutex.Lock()
+	defer c.tokenBufferMutex.Unlock()
+	if c.token != nil {
+		_ = c.token.Destroy()
+	}
+	c.token = buffer
+}
+
+// destroyToken wipes the buffer of the token, after which the token used for requests is empty
+func (c *Client) destroyToken() {
+	c.tokenBufferMutex.Lock()
+	defer c.tokenBufferMutex.Unlock()
+	if c.token == nil {
+		// an empty buffer, so that the configured AuthToken is not used either
+		c.token = &secure.Buffer{}
+	}
+	_ = c.token.Destroy()
+}
+
+// loginWithMethod posts the login request to the auth method mounted at mountPath. It is the pkg.AuthLoginFunc
+// passed to the registered auth methods.
+func (c *Client) loginWithMethod(ctx context.Context, mountPath string, request interface{}) (string, time.Time, error) {
+	return c.postLogin(ctx, loginPath(mountPath), mountPath+" login", request)
+}
+
+// loginPath returns the login API path of the auth method mounted at mountPath
+func loginPath(mountPath string) string {
+	return fmt.Sprintf(LoginAPIFmt, url.PathEscape(strings.Trim(mountPath, "/")))
+}
+
+// postLogin posts the login request to the login API path and returns the issued token and its expiry
+func (c *Client) postLogin(ctx context.Context, path string, description string, request interface{}) (string, time.Time, error) {
+	response := LoginResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodPost,
+		Path:                 path,
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: description,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	c.lc.Debugf("%s issued the token with accessor %s", description, response.Auth.Accessor)
+	return response.Auth.ClientToken.Reveal(), response.Auth.expiry(time.Now()), nil
+}
+
+// readCredential returns value, or the trimmed contents of filePath when value is empty
+func readCredential(value string, filePath string, name string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+	if filePath == "" {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("%s is required in config", name))
+	}
+
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("unable to read %s file: %s", name, err.Error()))
+	}
+	defer secure.Wipe(contents)
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// appRoleProvider logs in with the AppRole auth method
+type appRoleProvider struct {
+	client *Client
+	info   types.AppRoleInfo
+	// secretId caches the unwrapped secret id, as a wrapping token can only be unwrapped once
+	secretId string
+	mutex    sync.Mutex
+}
+
+func (p *appRoleProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	roleId, err := readCredential(p.info.RoleId, p.info.RoleIdFile, "AppRole RoleId")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	secretId, err := p.getSecretId(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodAppRole
+	}
+
+	return p.client.loginWithMethod(ctx, mountPath, AppRoleLoginRequest{RoleId: roleId, SecretId: types.SecretString(secretId)})
+}
+
+// getSecretId returns the configured secret id, unwrapping it on first use when it is a wrapping token
+func (p *appRoleProvider) getSecretId(ctx context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.secretId != "" {
+		return p.secretId, nil
+	}
+
+	secretId, err := readCredential(p.info.SecretId, p.info.SecretIdFile, "AppRole SecretId")
+	if err != nil {
+		return "", err
+	}
+
+	if !p.info.SecretIdWrapped {
+		return secretId, nil
+	}
+
+	response := UnwrapSecretIdResponse{}
+	_, err = p.client.doRequest(ctx, RequestArgs{
+		AuthToken:            secretId,
+		Method:               http.MethodPost,
+		Path:                 UnwrapAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "unwrap AppRole secret id",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.Data.SecretId == "" {
+		return "", pkg.NewErrSecretStore("unwrapped response does not contain an AppRole secret id")
+	}
+
+	p.secretId = response.Data.SecretId.Reveal()
+	return p.secretId, nil
+}
+
+// destroy forgets the unwrapped secret id
+func (p *appRoleProvider) destroy() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.secretId = ""
+}
+
+// kubernetesProvider logs in with the Kubernetes auth method using the pod's service account JWT
+type kubernetesProvider struct {
+	client *Client
+	info   types.KubernetesAuthInfo
+}
+
+func (p *kubernetesProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	if p.info.Role == "" {
+		return "", time.Time{}, pkg.NewErrSecretStore("Kubernetes auth Role is required in config")
+	}
+
+	tokenFile := p.info.TokenFile
+	if tokenFile == "" {
+		tokenFile = defaultServiceAccountTokenFile
+	}
+	jwt, err := readCredential("", tokenFile, "Kubernetes service account token")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodKubernetes
+	}
+
+	return p.client.loginWithMethod(ctx, mountPath, KubernetesLoginRequest{Role: p.info.Role, JWT: types.SecretString(jwt)})
+}
+
+// certProvider logs in with the TLS certificate auth method. The client certificate is presented by the
+// HTTP client during the TLS handshake, so the login request only selects the certificate role.
+type certProvider struct {
+	client *Client
+	info   types.CertAuthInfo
+}
+
+func (p *certProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodCert
+	}
+
+	return p.client.loginWithMethod(ctx, mountPath, CertLoginRequest{Name: p.info.Name})
+}
+
+// userpassProvider logs in with the userpass auth method
+type userpassProvider struct {
+	client *Client
+	info   types.UserpassInfo
+}
+
+func (p *userpassProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	if p.info.Username == "" {
+		return "", time.Time{}, pkg.NewErrSecretStore("userpass auth Username is required in config")
+	}
+
+	password, err := readCredential(p.info.Password, p.info.PasswordFile, "userpass auth Password")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodUserpass
+	}
+
+	// the username is part of the login path rather than the request body
+	return p.client.postLogin(ctx, loginPath(mountPath)+"/"+url.PathEscape(p.info.Username), mountPath+" login",
+		UserpassLoginRequest{Password: types.SecretString(password)})
+}
+
+// jwtProvider logs in with the JWT/OIDC auth method
+type jwtProvider struct {
+	client *Client
+	info   types.JWTAuthInfo
+	// now abstracts the clock used to check the JWT expiry, which is most useful for testing
+	now func() time.Time
+}
+
+func (p *jwtProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	jwt, err := readCredential(p.info.Token, p.info.TokenFile, "JWT auth Token")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := validateJWTClaims(jwt, "JWT auth Token", p.info.Audience, p.now()); err != nil {
+		return "", time.Time{}, err
+	}
+
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodJWT
+	}
+
+	return p.client.loginWithMethod(ctx, mountPath, JWTLoginRequest{Role: p.info.Role, JWT: types.SecretString(jwt)})
+}
+
+// validateJWTClaims checks the audience and expiry of the JWT, described by name in the errors, so a token intended
+// for another audience is never sent to Vault. The audience is not checked when it is empty. The signature is not
+// verified, as that is done by Vault against the role's configured keys.
+func validateJWTClaims(jwt string, name string, audience string, now time.Time) error {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return pkg.NewErrSecretStore(fmt.Sprintf("%s is not a JWT", name))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to decode JWT claims: %s", err.Error()))
+	}
+
+	var claims struct {
+		// Audience is either a single string or an array of strings
+		Audience  interface{} `json:"aud"`
+		ExpiresAt int64       `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to parse JWT claims: %s", err.Error()))
+	}
+
+	if claims.ExpiresAt != 0 && !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return pkg.NewErrSecretStore(fmt.Sprintf("%s has expired", name))
+	}
+
+	if audience == "" {
+		return nil
+	}
+
+	var audiences []string
+	switch aud := claims.Audience.(type) {
+	case string:
+		audiences = []string{aud}
+	case []interface{}:
+		for _, value := range aud {
+			if s, ok := value.(string); ok {
+				audiences = append(audiences, s)
+			}
+		}
+	}
+
+	for _, value := range audiences {
+		if value == audience {
+			return nil
+		}
+	}
+
+	return pkg.NewErrSecretStore(fmt.Sprintf("%s audience %v does not include the expected audience '%s'", name, audiences, audience))
+}
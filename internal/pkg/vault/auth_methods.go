@@ -0,0 +1,126 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableAuthMethod enables the auth method at mountPath, i.e. "approle" for logins at "auth/approle/login"
+func (c *Client) EnableAuthMethod(ctx context.Context, token string, mountPath string, method types.AuthMethod) error {
+	if method.Type == "" {
+		return pkg.NewErrSecretStore("auth method type cannot be empty")
+	}
+
+	apiPath, err := authMethodPath(mountPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 apiPath,
+		JSONObject:           method,
+		BodyReader:           nil,
+		OperationDescription: "enable " + method.Type + " auth method",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// DisableAuthMethod disables the auth method at mountPath, which revokes the tokens issued by its logins
+func (c *Client) DisableAuthMethod(ctx context.Context, token string, mountPath string) error {
+	apiPath, err := authMethodPath(mountPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodDelete,
+		Path:                 apiPath,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "disable auth method at " + mountPath,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ListAuthMethods returns the enabled auth methods keyed by their mount path, i.e. "token/" and "approle/"
+func (c *Client) ListAuthMethods(ctx context.Context, token string) (map[string]types.AuthMount, error) {
+	response := ListAuthMethodsResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 AuthMethodsAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "list auth methods",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return map[string]types.AuthMount{}, nil
+	}
+	return response.Data, nil
+}
+
+// TuneAuthMethod updates the configuration of the auth method at mountPath
+func (c *Client) TuneAuthMethod(ctx context.Context, token string, mountPath string, config types.AuthMethodConfig) error {
+	apiPath, err := authMethodPath(mountPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 apiPath + "/tune",
+		JSONObject:           config,
+		BodyReader:           nil,
+		OperationDescription: "tune auth method at " + mountPath,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// authMethodPath returns the sys/auth API path of the auth method mounted at mountPath, which may include the
+// "auth/" prefix of its login paths
+func authMethodPath(mountPath string) (string, error) {
+	mountPath = strings.TrimPrefix(strings.Trim(mountPath, "/"), "auth/")
+	if mountPath == "" {
+		return "", pkg.NewErrSecretStore("auth method mount path cannot be empty")
+	}
+
+	return path.Join(AuthMethodsAPI, mountPath), nil
+}
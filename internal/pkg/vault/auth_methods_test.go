@@ -0,0 +1,129 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockAuthMethodsServer is a stub Vault keeping the enabled auth methods keyed by their mount path
+type mockAuthMethodsServer struct {
+	mutex  sync.Mutex
+	mounts map[string]types.AuthMount
+}
+
+func (m *mockAuthMethodsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if r.URL.Path == AuthMethodsAPI && r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": m.mounts})
+		return
+	}
+
+	mountPath := strings.TrimPrefix(r.URL.Path, AuthMethodsAPI+"/")
+	tune := strings.HasSuffix(mountPath, "/tune")
+	mountPath = strings.TrimSuffix(mountPath, "/tune") + "/"
+	mount, exists := m.mounts[mountPath]
+
+	switch {
+	case tune && r.Method == http.MethodPost:
+		if !exists {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&mount.Config)
+		m.mounts[mountPath] = mount
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost:
+		if exists {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"path is already in use at " + mountPath}})
+			return
+		}
+		var method types.AuthMethod
+		_ = json.NewDecoder(r.Body).Decode(&method)
+		m.mounts[mountPath] = types.AuthMount{
+			Type:        method.Type,
+			Accessor:    "auth_" + method.Type + "_1234",
+			Description: method.Description,
+			Local:       method.Local,
+			Config:      method.Config,
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(m.mounts, mountPath)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestAuthMethods(t *testing.T) {
+	mock := &mockAuthMethodsServer{mounts: map[string]types.AuthMount{
+		"token/": {Type: "token", Accessor: "auth_token_1234", Description: "token based credentials"},
+	}}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	approle := types.AuthMethod{Type: "approle", Description: "EdgeX services", Config: types.AuthMethodConfig{MaxLeaseTTL: 3600}}
+	require.NoError(t, client.EnableAuthMethod(context.Background(), expectedToken, "approle", approle))
+	require.Error(t, client.EnableAuthMethod(context.Background(), expectedToken, "auth/approle/", approle), "already enabled")
+	require.Error(t, client.EnableAuthMethod(context.Background(), expectedToken, "cert", types.AuthMethod{}), "missing type")
+	require.Error(t, client.EnableAuthMethod(context.Background(), expectedToken, "/", approle), "missing path")
+
+	mounts, err := client.ListAuthMethods(context.Background(), expectedToken)
+	require.NoError(t, err)
+	require.Len(t, mounts, 2)
+	assert.Equal(t, types.AuthMount{
+		Type:        "approle",
+		Accessor:    "auth_approle_1234",
+		Description: "EdgeX services",
+		Config:      types.AuthMethodConfig{MaxLeaseTTL: 3600},
+	}, mounts["approle/"])
+
+	config := types.AuthMethodConfig{DefaultLeaseTTL: 600, MaxLeaseTTL: 7200, TokenType: "batch"}
+	require.NoError(t, client.TuneAuthMethod(context.Background(), expectedToken, "approle", config))
+	assert.Equal(t, config, mock.mounts["approle/"].Config)
+	require.Error(t, client.TuneAuthMethod(context.Background(), expectedToken, "kubernetes", config))
+
+	require.NoError(t, client.DisableAuthMethod(context.Background(), expectedToken, "auth/approle"))
+	mounts, err = client.ListAuthMethods(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.NotContains(t, mounts, "approle/")
+
+	_, err = client.ListAuthMethods(context.Background(), "bad-token")
+	require.Error(t, err)
+}
@@ -0,0 +1,554 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testRoleId      = "test-role-id"
+	testSecretId    = "test-secret-id"
+	testWrapToken   = "test-wrapping-token"
+	testClientToken = "test-client-token"
+)
+
+// mockAuthServer is a stub Vault serving the auth method login APIs, token lookup and a single secret
+type mockAuthServer struct {
+	mutex       sync.Mutex
+	logins      int
+	unwraps     int
+	checkLogin  func(r *http.Request) bool
+	loginMethod string
+	// loginPath overrides the login API path of auth methods which don't use auth/<method>/login
+	loginPath string
+	// ttl is the TTL reported by the token lookup, defaults to an hour
+	ttl int
+	// renewTTL is the TTL returned by token renewals, which omit it when zero, while revoked fails renewals until
+	// the next login
+	renewTTL int
+	revoked  bool
+	renewals int
+}
+
+func (m *mockAuthServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	loginPath := m.loginPath
+	if loginPath == "" {
+		loginPath = "/v1/auth/" + m.loginMethod + "/login"
+	}
+
+	switch r.URL.Path {
+	case loginPath:
+		if !m.checkLogin(r) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.logins++
+		m.revoked = false
+		_ = json.NewEncoder(w).Encode(LoginResponse{Auth: LoginAuth{
+			ClientToken: testClientToken, Accessor: "accessor", LeaseDuration: 3600, Renewable: true}})
+	case UnwrapAPI:
+		if r.Header.Get(AuthTypeHeader) != testWrapToken || m.unwraps > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.unwraps++
+		_, _ = w.Write([]byte(`{"data":{"secret_id":"` + testSecretId + `","secret_id_accessor":"accessor"}}`))
+	case lookupSelfVaultAPI:
+		if r.Header.Get(AuthTypeHeader) != testClientToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		ttl := m.ttl
+		if ttl == 0 {
+			ttl = 3600
+		}
+		_ = json.NewEncoder(w).Encode(TokenLookupResponse{Data: types.TokenMetadata{Renewable: true, Ttl: ttl}})
+	case renewSelfVaultAPI:
+		if r.Header.Get(AuthTypeHeader) != testClientToken || m.revoked {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		m.renewals++
+		if m.renewTTL == 0 {
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"` + testClientToken + `","renewable":true}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(LoginResponse{Auth: LoginAuth{
+			ClientToken: testClientToken, LeaseDuration: m.renewTTL, Renewable: true}})
+	case testPath + "/redisdb":
+		if r.Header.Get(AuthTypeHeader) != testClientToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"password":"password"}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func decodeLoginRequest(r *http.Request) map[string]string {
+	var request map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&request)
+	return request
+}
+
+func newAuthTestConfig(t *testing.T, server *httptest.Server, auth types.AuthenticationInfo) types.SecretConfig {
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host, port, _ := net.SplitHostPort(serverURL.Host)
+	portNum, _ := strconv.Atoi(port)
+
+	return types.SecretConfig{Protocol: "http", Host: host, Port: portNum, Path: testPath, Authentication: auth}
+}
+
+func TestAppRoleLogin(t *testing.T) {
+	dir := t.TempDir()
+	roleIdFile := filepath.Join(dir, "role-id")
+	require.NoError(t, ioutil.WriteFile(roleIdFile, []byte(testRoleId+"\n"), 0600))
+
+	tests := []struct {
+		name    string
+		appRole types.AppRoleInfo
+	}{
+		{"Secret id", types.AppRoleInfo{RoleIdFile: roleIdFile, SecretId: testSecretId}},
+		{"Wrapped secret id", types.AppRoleInfo{RoleId: testRoleId, SecretId: testWrapToken, SecretIdWrapped: true}},
+		{"Custom mount path", types.AppRoleInfo{MountPath: "/edgex-approle/", RoleId: testRoleId, SecretId: testSecretId}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginMethod: AuthMethodAppRole,
+				checkLogin: func(r *http.Request) bool {
+					request := decodeLoginRequest(r)
+					return request["role_id"] == testRoleId && request["secret_id"] == testSecretId
+				},
+			}
+			if test.appRole.MountPath != "" {
+				mock.loginMethod = "edgex-approle"
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAppRole, AppRole: test.appRole})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			require.NoError(t, err)
+
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+			// logging in again must reuse the unwrapped secret id
+			require.NoError(t, client.login(ctx))
+			assert.Equal(t, 2, mock.logins)
+		})
+	}
+}
+
+func TestAuthMethodConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		auth        types.AuthenticationInfo
+		expectError bool
+	}{
+		{"Valid - token", types.AuthenticationInfo{Method: AuthMethodToken, AuthToken: "token"}, false},
+		{"Valid - approle without token", types.AuthenticationInfo{Method: AuthMethodAppRole}, false},
+		{"Invalid - token method without token", types.AuthenticationInfo{Method: AuthMethodToken}, true},
+		{"Invalid - unknown method", types.AuthenticationInfo{Method: "unknown", AuthToken: "token"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewClient(types.SecretConfig{Authentication: test.auth}, WithHTTPClient(http.DefaultClient), WithLogger(logger.NewMockClient()), WithAuthTokenRequired())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.auth.Method == AuthMethodAppRole, client.tokenProvider != nil)
+		})
+	}
+}
+
+func TestUserpassLogin(t *testing.T) {
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("dev-password\n"), 0600))
+
+	tests := []struct {
+		name        string
+		userpass    types.UserpassInfo
+		devMode     bool
+		expectError bool
+	}{
+		{"Valid - password", types.UserpassInfo{Username: "developer", Password: "dev-password"}, true, false},
+		{"Valid - password file", types.UserpassInfo{Username: "developer", PasswordFile: passwordFile}, true, false},
+		{"Invalid - not dev mode", types.UserpassInfo{Username: "developer", Password: "dev-password"}, false, true},
+		{"Invalid - no username", types.UserpassInfo{Password: "dev-password"}, true, true},
+		{"Invalid - wrong password", types.UserpassInfo{Username: "developer", Password: "wrong"}, true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginPath: "/v1/auth/userpass/login/developer",
+				checkLogin: func(r *http.Request) bool {
+					return decodeLoginRequest(r)["password"] == "dev-password"
+				},
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodUserpass,
+				Userpass: test.userpass, DevMode: test.devMode})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			if test.expectError {
+				require.Error(t, err)
+				assert.Equal(t, 0, mock.logins)
+				return
+			}
+
+			require.NoError(t, err)
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+		})
+	}
+}
+
+func TestReauthentication(t *testing.T) {
+	tests := []struct {
+		name     string
+		ttl      int
+		renewTTL int
+		revoke   bool
+		reason   func(err error) bool
+	}{
+		// a 2s TTL renews the token every second
+		{"Token revoked", 2, 2, true, isForbidden},
+		// a 4s TTL renews the token every 2 seconds, so a renewed TTL of 1s expires before the next renewal
+		{"Max TTL reached", 4, 1, false, func(err error) bool { return err == errTokenMaxTTLReached }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginMethod: AuthMethodAppRole,
+				checkLogin:  func(r *http.Request) bool { return true },
+				ttl:         test.ttl,
+				renewTTL:    test.renewTTL,
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAppRole,
+				AppRole: types.AppRoleInfo{RoleId: testRoleId, SecretId: testSecretId}})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			require.NoError(t, err)
+
+			reasons := make(chan error, 10)
+			client.SetReauthenticationCallback(func(reason error, err error) {
+				assert.NoError(t, err)
+				reasons <- reason
+			})
+
+			mock.mutex.Lock()
+			mock.revoked = test.revoke
+			mock.mutex.Unlock()
+
+			select {
+			case reason := <-reasons:
+				assert.True(t, test.reason(reason), "unexpected reason: %v", reason)
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "client did not log in again")
+			}
+
+			mock.mutex.Lock()
+			defer mock.mutex.Unlock()
+			assert.Equal(t, 2, mock.logins)
+			assert.Equal(t, testClientToken, client.authToken())
+		})
+	}
+}
+
+func TestRenewalWithoutTTL(t *testing.T) {
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodAppRole,
+		checkLogin:  func(r *http.Request) bool { return true },
+		// a 2s TTL renews the token every second, with responses which don't report the renewed TTL
+		ttl: 2,
+	}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAppRole,
+		AppRole: types.AppRoleInfo{RoleId: testRoleId, SecretId: testSecretId}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+
+	reasons := make(chan error, 10)
+	client.SetReauthenticationCallback(func(reason error, _ error) { reasons <- reason })
+
+	require.Eventually(t, func() bool {
+		mock.mutex.Lock()
+		defer mock.mutex.Unlock()
+		return mock.renewals >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case reason := <-reasons:
+		require.Fail(t, "renewals without a TTL must not log in again", "reason: %v", reason)
+	default:
+	}
+	mock.mutex.Lock()
+	defer mock.mutex.Unlock()
+	assert.Equal(t, 1, mock.logins)
+}
+
+func TestAppRoleLoginMissingCredentials(t *testing.T) {
+	server := httptest.NewServer(&mockAuthServer{})
+	defer server.Close()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAppRole,
+		AppRole: types.AppRoleInfo{RoleId: testRoleId, SecretIdFile: "/missing/secret-id"}})
+	_, err := NewSecretsClient(context.Background(), config, logger.NewMockClient(), nil)
+	require.Error(t, err)
+}
+
+func TestKubernetesLogin(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte("first-jwt"), 0600))
+
+	expectedJWT := "first-jwt"
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodKubernetes,
+		checkLogin: func(r *http.Request) bool {
+			request := decodeLoginRequest(r)
+			return request["role"] == "edgex-core-data" && request["jwt"] == expectedJWT
+		},
+	}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodKubernetes,
+		Kubernetes: types.KubernetesAuthInfo{Role: "edgex-core-data", TokenFile: tokenFile}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+	// the rotated service account token is read on the next login
+	expectedJWT = "rotated-jwt"
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(expectedJWT), 0600))
+	require.NoError(t, client.login(ctx))
+	assert.Equal(t, 2, mock.logins)
+
+	config.Authentication.Kubernetes.Role = ""
+	_, err = NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.Error(t, err)
+}
+
+func TestCertLogin(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	clientCert := writeClientCert(t, certPath, keyPath, "core-data", time.Now())
+
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodCert,
+		checkLogin: func(r *http.Request) bool {
+			request := decodeLoginRequest(r)
+			return request["name"] == "edgex" && len(r.TLS.PeerCertificates) == 1 &&
+				r.TLS.PeerCertificates[0].Subject.CommonName == "core-data"
+		},
+	}
+	server := httptest.NewUnstartedServer(mock)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	rootCaCertPath := filepath.Join(dir, "root-ca.crt")
+	require.NoError(t, ioutil.WriteFile(rootCaCertPath,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodCert,
+		Cert: types.CertAuthInfo{Name: "edgex", CertPath: certPath, KeyPath: keyPath}})
+	config.Protocol = "https"
+	config.RootCaCertPath = rootCaCertPath
+
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+	// the CA must be pinned for the cert auth method
+	config.RootCaCertPath = ""
+	_, err = NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.Error(t, err)
+}
+
+// makeJWT builds an unsigned JWT with the claims, the signature is only verified by Vault
+func makeJWT(t *testing.T, claims map[string]interface{}) string {
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func TestJWTLogin(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Unix()
+	validJWT := makeJWT(t, map[string]interface{}{"sub": "spiffe://edgex/core-data", "aud": []string{"vault", "other"}, "exp": expiry})
+
+	tests := []struct {
+		name        string
+		jwt         string
+		audience    string
+		expectError bool
+	}{
+		{"Valid - audience array", validJWT, "vault", false},
+		{"Valid - audience string", makeJWT(t, map[string]interface{}{"aud": "vault", "exp": expiry}), "vault", false},
+		{"Valid - no audience check", makeJWT(t, map[string]interface{}{"aud": "other"}), "", false},
+		{"Invalid - wrong audience", validJWT, "consul", true},
+		{"Invalid - expired", makeJWT(t, map[string]interface{}{"aud": "vault", "exp": time.Now().Add(-time.Minute).Unix()}), "vault", true},
+		{"Invalid - not a JWT", "not-a-jwt", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginMethod: AuthMethodJWT,
+				checkLogin: func(r *http.Request) bool {
+					request := decodeLoginRequest(r)
+					return request["role"] == "edgex" && request["jwt"] == test.jwt
+				},
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodJWT,
+				JWT: types.JWTAuthInfo{Role: "edgex", Token: test.jwt, Audience: test.audience}})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			if test.expectError {
+				require.Error(t, err)
+				assert.Equal(t, 0, mock.logins, "the JWT must not be sent to Vault")
+				return
+			}
+
+			require.NoError(t, err)
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+		})
+	}
+}
+
+func TestRegisteredAuthMethod(t *testing.T) {
+	pkg.RegisterAuthMethod("test-custom", func(config types.SecretConfig, login pkg.AuthLoginFunc) (pkg.AuthenticationTokenProvider, error) {
+		return pkg.AuthenticationTokenProviderFunc(func(ctx context.Context) (string, time.Time, error) {
+			return login(ctx, "custom", map[string]string{"path": config.Path})
+		}), nil
+	})
+
+	tokenEnvVar := "TEST_REGISTERED_AUTH_METHOD_TOKEN"
+	require.NoError(t, os.Setenv(tokenEnvVar, testClientToken))
+	defer func() { _ = os.Unsetenv(tokenEnvVar) }()
+
+	tests := []struct {
+		name   string
+		auth   types.AuthenticationInfo
+		logins int
+	}{
+		{"Registered method logging in", types.AuthenticationInfo{Method: "test-custom"}, 1},
+		{"Built-in env method", types.AuthenticationInfo{Method: pkg.AuthMethodEnv, TokenEnvVar: tokenEnvVar}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginMethod: "custom",
+				checkLogin: func(r *http.Request) bool {
+					return decodeLoginRequest(r)["path"] == testPath
+				},
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			client, err := NewSecretsClient(ctx, newAuthTestConfig(t, server, test.auth), logger.NewMockClient(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.logins, mock.logins)
+
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/aws"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	stsGlobalEndpoint   = "https://sts.amazonaws.com/"
+	stsRegionalEndpoint = "https://sts.%s.amazonaws.com/"
+	stsGlobalRegion     = "us-east-1"
+	stsService          = "sts"
+	getCallerIdentity   = "Action=GetCallerIdentity&Version=2011-06-15"
+	awsIAMServerIdName  = "X-Vault-AWS-IAM-Server-ID"
+
+	defaultMetadataEndpoint   = "http://169.254.169.254"
+	metadataTokenAPI          = "/latest/api/token"
+	metadataCredentialsAPI    = "/latest/meta-data/iam/security-credentials/"
+	metadataTokenHeader       = "X-aws-ec2-metadata-token"
+	metadataTokenTTLHeader    = "X-aws-ec2-metadata-token-ttl-seconds"
+	containerCredentialsHost  = "http://169.254.170.2"
+	awsCredentialsExpiryGrace = 5 * time.Minute
+)
+
+// awsProvider logs in with the iam login type of the AWS auth method. The sts:GetCallerIdentity request is signed
+// locally and sent to Vault, which executes it against AWS to learn the IAM principal, so the AWS secret key never
+// leaves the host.
+type awsProvider struct {
+	client *Client
+	info   types.AWSAuthInfo
+	// caller is used for the AWS credential endpoints, which must not be sent through the Vault HTTP client
+	caller pkg.Caller
+	// now abstracts the clock used for request signing, which is most useful for testing
+	now func() time.Time
+
+	creds       aws.Credentials
+	credsExpiry time.Time
+	mutex       sync.Mutex
+}
+
+func newAWSProvider(c *Client, info types.AWSAuthInfo) *awsProvider {
+	return &awsProvider{client: c, info: info, caller: http.DefaultClient, now: time.Now}
+}
+
+func (p *awsProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	creds, err := p.credentials(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	request, err := p.signedIdentityRequest(creds)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	mountPath := p.info.MountPath
+	if mountPath == "" {
+		mountPath = AuthMethodAWS
+	}
+
+	return p.client.loginWithMethod(ctx, mountPath, request)
+}
+
+// signedIdentityRequest builds the login request holding the signed sts:GetCallerIdentity request
+func (p *awsProvider) signedIdentityRequest(creds aws.Credentials) (AWSIAMLoginRequest, error) {
+	endpoint := stsGlobalEndpoint
+	region := stsGlobalRegion
+	if p.info.Region != "" {
+		endpoint = fmt.Sprintf(stsRegionalEndpoint, p.info.Region)
+		region = p.info.Region
+	}
+
+	body := []byte(getCallerIdentity)
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return AWSIAMLoginRequest{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	if p.info.ServerIdHeader != "" {
+		// the server id header is signed so the request can't be replayed against a different Vault server
+		req.Header.Set(awsIAMServerIdName, p.info.ServerIdHeader)
+	}
+	aws.SignRequest(req, body, creds, region, stsService, p.now())
+
+	headers, err := json.Marshal(req.Header)
+	if err != nil {
+		return AWSIAMLoginRequest{}, err
+	}
+
+	return AWSIAMLoginRequest{
+		Role:              p.info.Role,
+		IAMRequestMethod:  req.Method,
+		IAMRequestURL:     base64.StdEncoding.EncodeToString([]byte(endpoint)),
+		IAMRequestBody:    base64.StdEncoding.EncodeToString(body),
+		IAMRequestHeaders: base64.StdEncoding.EncodeToString(headers),
+	}, nil
+}
+
+// destroy forgets the cached temporary credentials
+func (p *awsProvider) destroy() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.creds = aws.Credentials{}
+	p.credsExpiry = time.Time{}
+}
+
+// credentials returns the AWS credentials from the config, the environment, the container credentials endpoint
+// or the EC2 instance metadata service, in that order. Temporary credentials are cached until shortly before
+// they expire.
+func (p *awsProvider) credentials(ctx context.Context) (aws.Credentials, error) {
+	if p.info.AccessKeyId != "" {
+		return aws.Credentials{
+			AccessKeyId:     p.info.AccessKeyId,
+			SecretAccessKey: p.info.SecretAccessKey,
+			SessionToken:    p.info.SessionToken,
+		}, nil
+	}
+
+	if accessKeyId := os.Getenv("AWS_ACCESS_KEY_ID"); accessKeyId != "" {
+		return aws.Credentials{
+			AccessKeyId:     accessKeyId,
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}, nil
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.creds.AccessKeyId != "" && p.now().Before(p.credsExpiry.Add(-awsCredentialsExpiryGrace)) {
+		return p.creds, nil
+	}
+
+	var creds awsCredentialsResponse
+	var err error
+	if uri, header := containerCredentialsRequest(); uri != "" {
+		creds, err = p.containerCredentials(ctx, uri, header)
+	} else {
+		creds, err = p.instanceCredentials(ctx)
+	}
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	if creds.AccessKeyId == "" || creds.SecretAccessKey == "" {
+		return aws.Credentials{}, pkg.NewErrSecretStore("AWS credentials response does not contain an access key")
+	}
+
+	p.creds = aws.Credentials{
+		AccessKeyId:     creds.AccessKeyId,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.Token,
+	}
+	p.credsExpiry = creds.Expiration
+	return p.creds, nil
+}
+
+// awsCredentialsResponse is returned by both the container credentials endpoint and the instance metadata service
+type awsCredentialsResponse struct {
+	AccessKeyId     string    `json:"AccessKeyId"`
+	SecretAccessKey string    `json:"SecretAccessKey"`
+	Token           string    `json:"Token"`
+	Expiration      time.Time `json:"Expiration"`
+}
+
+// containerCredentialsRequest returns the container credentials URI and Authorization header value from the
+// environment. An empty URI is returned when not running with container credentials.
+func containerCredentialsRequest() (string, string) {
+	uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+	if relative := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); relative != "" {
+		uri = containerCredentialsHost + relative
+	}
+
+	header := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	if tokenFile := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"); tokenFile != "" {
+		// the token file is read on every request as EKS Pod Identity rotates it
+		if contents, err := ioutil.ReadFile(tokenFile); err == nil {
+			header = strings.TrimSpace(string(contents))
+		}
+	}
+
+	return uri, header
+}
+
+func (p *awsProvider) containerCredentials(ctx context.Context, uri string, authorization string) (awsCredentialsResponse, error) {
+	headers := map[string]string{}
+	if authorization != "" {
+		headers["Authorization"] = authorization
+	}
+
+	creds := awsCredentialsResponse{}
+	body, err := p.metadataRequest(ctx, http.MethodGet, uri, headers)
+	if err != nil {
+		return creds, err
+	}
+	if err := json.Unmarshal(body, &creds); err != nil {
+		return creds, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse AWS container credentials: %s", err.Error()))
+	}
+	return creds, nil
+}
+
+// instanceCredentials reads the instance profile credentials from the EC2 instance metadata service using IMDSv2
+func (p *awsProvider) instanceCredentials(ctx context.Context) (awsCredentialsResponse, error) {
+	endpoint := p.info.MetadataEndpoint
+	if endpoint == "" {
+		endpoint = defaultMetadataEndpoint
+	}
+	endpoint = strings.TrimRight(endpoint, "/")
+
+	creds := awsCredentialsResponse{}
+	token, err := p.metadataRequest(ctx, http.MethodPut, endpoint+metadataTokenAPI, map[string]string{metadataTokenTTLHeader: "300"})
+	if err != nil {
+		return creds, err
+	}
+	headers := map[string]string{metadataTokenHeader: string(token)}
+
+	roles, err := p.metadataRequest(ctx, http.MethodGet, endpoint+metadataCredentialsAPI, headers)
+	if err != nil {
+		return creds, err
+	}
+	role := strings.TrimSpace(strings.SplitN(string(roles), "\n", 2)[0])
+	if role == "" {
+		return creds, pkg.NewErrSecretStore("no IAM role is attached to the EC2 instance")
+	}
+
+	body, err := p.metadataRequest(ctx, http.MethodGet, endpoint+metadataCredentialsAPI+role, headers)
+	if err != nil {
+		return creds, err
+	}
+	if err := json.Unmarshal(body, &creds); err != nil {
+		return creds, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse EC2 instance credentials: %s", err.Error()))
+	}
+	return creds, nil
+}
+
+func (p *awsProvider) metadataRequest(ctx context.Context, method string, url string, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := p.caller.Do(req)
+	if err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to obtain AWS credentials: %s", err.Error()))
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("Received a '%d' response from %s while obtaining AWS credentials", resp.StatusCode, url))
+	}
+	return body, nil
+}
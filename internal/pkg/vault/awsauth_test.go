@@ -0,0 +1,193 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// checkAWSLogin validates the signed sts:GetCallerIdentity request in an AWS iam login request
+func checkAWSLogin(r *http.Request, accessKeyId string, scope string, serverId string) bool {
+	var request AWSIAMLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return false
+	}
+
+	body, _ := base64.StdEncoding.DecodeString(request.IAMRequestBody)
+	endpoint, _ := base64.StdEncoding.DecodeString(request.IAMRequestURL)
+	rawHeaders, _ := base64.StdEncoding.DecodeString(request.IAMRequestHeaders)
+	headers := http.Header{}
+	if err := json.Unmarshal(rawHeaders, &headers); err != nil {
+		return false
+	}
+
+	return request.Role == "edgex-core-data" &&
+		request.IAMRequestMethod == http.MethodPost &&
+		string(body) == getCallerIdentity &&
+		strings.HasPrefix(string(endpoint), "https://sts.") &&
+		headers.Get(awsIAMServerIdName) == serverId &&
+		strings.HasPrefix(headers.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+accessKeyId+"/") &&
+		strings.Contains(headers.Get("Authorization"), scope) &&
+		(serverId == "" || strings.Contains(headers.Get("Authorization"), strings.ToLower(awsIAMServerIdName)))
+}
+
+func unsetAWSEnvironment() func() {
+	var restores []func()
+	for _, env := range []string{"AWS_ACCESS_KEY_ID", "AWS_CONTAINER_CREDENTIALS_FULL_URI", "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"} {
+		if value, ok := os.LookupEnv(env); ok {
+			_ = os.Unsetenv(env)
+			restores = append(restores, func(env string, value string) func() {
+				return func() { _ = os.Setenv(env, value) }
+			}(env, value))
+		}
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+func TestAWSLogin(t *testing.T) {
+	defer unsetAWSEnvironment()()
+
+	tests := []struct {
+		name  string
+		info  types.AWSAuthInfo
+		scope string
+	}{
+		{"Global endpoint", types.AWSAuthInfo{}, "/us-east-1/sts/aws4_request"},
+		{"Regional endpoint with server id", types.AWSAuthInfo{Region: "eu-west-1", ServerIdHeader: "vault.edgex"}, "/eu-west-1/sts/aws4_request"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{
+				loginMethod: AuthMethodAWS,
+				checkLogin: func(r *http.Request) bool {
+					return checkAWSLogin(r, "test-key", test.scope, test.info.ServerIdHeader)
+				},
+			}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			test.info.Role = "edgex-core-data"
+			test.info.AccessKeyId = "test-key"
+			test.info.SecretAccessKey = "test-secret"
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAWS, AWS: test.info})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			require.NoError(t, err)
+
+			secrets, err := client.GetSecrets(ctx, "/redisdb")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+		})
+	}
+}
+
+// mockInstanceMetadata is a stub EC2 instance metadata service serving IMDSv2 instance profile credentials
+type mockInstanceMetadata struct {
+	mutex       sync.Mutex
+	credentials int
+	expiration  time.Time
+}
+
+func (m *mockInstanceMetadata) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.URL.Path == metadataTokenAPI {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = w.Write([]byte("imds-token"))
+		return
+	}
+
+	if r.Header.Get(metadataTokenHeader) != "imds-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case metadataCredentialsAPI:
+		_, _ = w.Write([]byte("edgex-instance-role\n"))
+	case metadataCredentialsAPI + "edgex-instance-role":
+		m.credentials++
+		_ = json.NewEncoder(w).Encode(awsCredentialsResponse{
+			AccessKeyId: "instance-key", SecretAccessKey: "instance-secret", Token: "session", Expiration: m.expiration})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestAWSLoginInstanceCredentials(t *testing.T) {
+	defer unsetAWSEnvironment()()
+
+	metadata := &mockInstanceMetadata{expiration: time.Now().Add(time.Hour)}
+	metadataServer := httptest.NewServer(metadata)
+	defer metadataServer.Close()
+
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodAWS,
+		checkLogin: func(r *http.Request) bool {
+			return checkAWSLogin(r, "instance-key", "/us-east-1/sts/aws4_request", "")
+		},
+	}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodAWS,
+		AWS: types.AWSAuthInfo{Role: "edgex-core-data", MetadataEndpoint: metadataServer.URL}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+	// the instance credentials are cached until they are about to expire
+	require.NoError(t, client.login(ctx))
+	assert.Equal(t, 2, mock.logins)
+	assert.Equal(t, 1, metadata.credentials)
+
+	metadata.expiration = time.Now().Add(time.Minute)
+	client.tokenProvider.(*awsProvider).credsExpiry = metadata.expiration
+	require.NoError(t, client.login(ctx))
+	assert.Equal(t, 2, metadata.credentials)
+}
@@ -0,0 +1,96 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+const (
+	rootCapability = "root"
+	denyCapability = "deny"
+)
+
+// GetCapabilities returns the capabilities of the client's token on each of the API paths
+func (c *Client) GetCapabilities(ctx context.Context, paths ...string) (map[string][]string, error) {
+	if len(paths) == 0 {
+		return map[string][]string{}, nil
+	}
+
+	request := CapabilitiesRequest{Paths: make([]string, len(paths))}
+	for index, apiPath := range paths {
+		request.Paths[index] = strings.TrimPrefix(strings.TrimPrefix(apiPath, "/"), "v1/")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodPost, CapabilitiesSelfAPI, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CapabilitiesResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	capabilities := make(map[string][]string, len(paths))
+	for index, apiPath := range paths {
+		capabilities[apiPath] = response.Data[request.Paths[index]]
+	}
+	return capabilities, nil
+}
+
+// RequireSecretCapabilities checks the client's token has the capabilities on the path GetSecrets reads the
+// sub-path from
+func (c *Client) RequireSecretCapabilities(ctx context.Context, subPath string, capabilities ...string) error {
+	secretPath := strings.TrimPrefix(strings.TrimPrefix(path.Join("/", c.Config.Path, subPath), "/"), "v1/")
+
+	granted, err := c.GetCapabilities(ctx, secretPath)
+	if err != nil {
+		return err
+	}
+
+	missing := missingCapabilities(granted[secretPath], capabilities)
+	if len(missing) > 0 {
+		return pkg.NewErrMissingCapabilities(secretPath, missing, granted[secretPath])
+	}
+
+	return nil
+}
+
+// missingCapabilities returns the required capabilities which are not granted. The root capability grants all the
+// capabilities and deny none of them.
+func missingCapabilities(granted []string, required []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, capability := range granted {
+		grantedSet[capability] = true
+	}
+	if grantedSet[rootCapability] {
+		return nil
+	}
+
+	var missing []string
+	for _, capability := range required {
+		if grantedSet[denyCapability] || !grantedSet[capability] {
+			missing = append(missing, capability)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,83 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestCapabilities(t *testing.T) {
+	granted := map[string][]string{
+		"secret/edgex/core-data/redisdb": {"read", "list"},
+		"secret/edgex/core-data/admin":   {"deny"},
+		"secret/edgex/core-data/root":    {"root"},
+	}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(AuthTypeHeader) != expectedToken || r.URL.Path != CapabilitiesSelfAPI || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		var request CapabilitiesRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		data := make(map[string][]string)
+		for _, path := range request.Paths {
+			capabilities, ok := granted[path]
+			if !ok {
+				capabilities = []string{"deny"}
+			}
+			data[path] = capabilities
+		}
+		_ = json.NewEncoder(w).Encode(CapabilitiesResponse{Data: data})
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = "/v1/secret/edgex/core-data/"
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	ctx := context.Background()
+
+	capabilities, err := client.GetCapabilities(ctx, "/v1/secret/edgex/core-data/redisdb", "secret/edgex/other")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"/v1/secret/edgex/core-data/redisdb": {"read", "list"},
+		"secret/edgex/other":                 {"deny"},
+	}, capabilities)
+
+	require.NoError(t, client.RequireSecretCapabilities(ctx, "/redisdb", "read"))
+	require.NoError(t, client.RequireSecretCapabilities(ctx, "root", "read", "update", "delete"))
+
+	err = client.RequireSecretCapabilities(ctx, "/redisdb", "read", "update")
+	require.Error(t, err)
+	require.IsType(t, pkg.ErrMissingCapabilities{}, err)
+	assert.Equal(t, []string{"update"}, err.(pkg.ErrMissingCapabilities).Missing())
+	assert.Contains(t, err.Error(), "'secret/edgex/core-data/redisdb'")
+
+	err = client.RequireSecretCapabilities(ctx, "/admin", "read")
+	require.Error(t, err)
+	assert.Equal(t, []string{"read"}, err.(pkg.ErrMissingCapabilities).Missing())
+}
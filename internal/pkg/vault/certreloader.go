@@ -0,0 +1,88 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// certReloader provides the client certificate for TLS handshakes, reloading it when the certificate or key file
+// is modified so that rotated certificates are picked up without restarting the service.
+type certReloader struct {
+	certPath    string
+	keyPath     string
+	mutex       sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+func newCertReloader(certPath string, keyPath string) (*certReloader, error) {
+	if certPath == "" || keyPath == "" {
+		return nil, pkg.NewErrSecretStore("CertPath and KeyPath are required in config for the cert auth method")
+	}
+
+	reloader := &certReloader{certPath: certPath, keyPath: keyPath}
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate. If a modified certificate fails to load,
+// for instance because only one of the files has been replaced so far, the previous certificate is used.
+func (r *certReloader) GetClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_ = r.reload()
+
+	return r.cert, nil
+}
+
+// reload loads the certificate when the files have been modified since the last load. Must be called with the
+// mutex held, apart from during construction.
+func (r *certReloader) reload() error {
+	certInfo, err := os.Stat(r.certPath)
+	if err != nil {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to read client certificate: %s", err.Error()))
+	}
+	keyInfo, err := os.Stat(r.keyPath)
+	if err != nil {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to read client key: %s", err.Error()))
+	}
+
+	if r.cert != nil && certInfo.ModTime().Equal(r.certModTime) && keyInfo.ModTime().Equal(r.keyModTime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to load client certificate: %s", err.Error()))
+	}
+
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+
+	return nil
+}
@@ -17,36 +17,98 @@ package vault
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
-	"io/ioutil"
-	"net/http"
+	"fmt"
+	"sync"
 
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 )
 
 // *Client defines the behavior for interacting with the Vault REST secret key/value store via HTTP(S).
+// It is safe for concurrent use once constructed; the Config must not be modified after that.
 type Client struct {
 	Config     types.SecretConfig
 	HttpCaller pkg.Caller
 	lc         logger.LoggingClient
 	context    context.Context
+	// flavor is the Vault API server flavor, which is empty until detected when Compatibility is "auto"
+	flavor      string
+	flavorMutex sync.Mutex
+	// kvVersion is the version of the KV secrets engine detected when KVVersion is "auto", 0 until detected
+	kvVersion      int
+	kvVersionMutex sync.Mutex
+	// tokenProvider logs in to obtain the token, it is nil when the configured AuthToken is used as is
+	tokenProvider pkg.AuthenticationTokenProvider
+	// token holds the token swapped in by logins and renewals in a buffer, which is wiped when swapped out or by
+	// Destroy. It is nil until the first swap, in which case the configured AuthToken is used, so the Config is never
+	// written once the client is shared between goroutines.
+	token            *secure.Buffer
+	tokenBufferMutex sync.RWMutex
+	// loginMutex serializes the logins of the token file watch and of the reauthentication
+	loginMutex sync.Mutex
+	// tokenMutex protects the callbacks, the instrumentation and the middleware
+	tokenMutex      sync.RWMutex
+	reauthCallback  pkg.ReauthenticationCallback
+	warningCallback pkg.WarningCallback
+	// metrics records the requests and token renewals, it is nil when they are not recorded
+	metrics pkg.Metrics
+	// tracer starts a span for each request, it is nil when they are not traced
+	tracer pkg.Tracer
+	// middleware wraps the HttpCaller for each request, the first being the outermost
+	middleware []pkg.Middleware
+	// updateCallbacks are notified after secrets are stored through the client
+	updateCallbacks pkg.SecretUpdatedCallbacks
+	// retryPolicy is how requests failing to reach the secret store are retried
+	retryPolicy types.RetryPolicy
+	// agent is set when the requests go without a token through the Vault Agent detected for the agent method
+	agent bool
+	// stopRenewal stops the renewal of the token and the token file watch started by NewSecretsClient, it is nil
+	// when none was started
+	stopRenewal context.CancelFunc
+	// leases holds the ids of the leases of the dynamic secrets obtained through the client, which are revoked by
+	// Shutdown unless revoked before
+	leases      map[string]bool
+	leasesMutex sync.Mutex
+	// secretsFlight de-duplicates identical GetSecrets calls in flight
+	secretsFlight flightGroup
 }
 
-// NewVaultClient constructs a Vault *Client which communicates with Vault via HTTP(S)
+// NewClient constructs a Vault *Client which communicates with Vault via HTTP(S), configured by options
 //
-// lc is any logging client that implements the loggingClient interface;
-// today EdgeX's logger.LoggingClient from go-mod-core-contracts satisfies this implementation
-//
-func NewClient(config types.SecretConfig, requester pkg.Caller, forSecrets bool, lc logger.LoggingClient) (*Client, error) {
-	if forSecrets && config.Authentication.AuthToken == "" {
-		return nil, pkg.NewErrSecretStore("AuthToken is required in config")
+// The logger is set WithLogger; any logging client that implements the loggingClient interface, i.e. EdgeX's
+// logger.LoggingClient from go-mod-core-contracts, satisfies this implementation
+func NewClient(config types.SecretConfig, options ...ClientOption) (*Client, error) {
+	settings := newClientOptions(options)
+	if settings.namespace != nil {
+		config.Namespace = *settings.namespace
+	}
+	if settings.retryPolicy != nil {
+		config.Retry = *settings.retryPolicy
+	}
+
+	var flavor string
+	switch config.Compatibility {
+	case "", CompatibilityVault:
+		flavor = CompatibilityVault
+	case CompatibilityOpenBao:
+		flavor = CompatibilityOpenBao
+	case CompatibilityAuto:
+		// detected on first use
+	default:
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("invalid Compatibility '%s' in config", config.Compatibility))
+	}
+
+	switch config.KVVersion {
+	case "", KVVersion1, KVVersion2, KVVersionAuto:
+	default:
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("invalid KVVersion '%s' in config", config.KVVersion))
 	}
 
 	var err error
+	requester := settings.requester
 	if requester == nil {
 		requester, err = createHTTPClient(config)
 		if err != nil {
@@ -55,38 +117,42 @@ func NewClient(config types.SecretConfig, requester pkg.Caller, forSecrets bool,
 	}
 
 	vaultClient := Client{
-		Config:     config,
-		HttpCaller: requester,
-		lc:         lc,
+		Config:      config,
+		HttpCaller:  requester,
+		lc:          settings.lc,
+		flavor:      flavor,
+		retryPolicy: config.Retry,
+		metrics:     settings.metrics,
+		tracer:      settings.tracer,
+		middleware:  settings.middleware,
+	}
+
+	if vaultClient.tokenProvider, err = newTokenProvider(&vaultClient); err != nil {
+		return nil, err
+	}
+
+	if vaultClient.tokenProvider != nil {
+		if vaultClient.Config.Authentication.AuthType == "" {
+			vaultClient.Config.Authentication.AuthType = AuthTypeHeader
+		}
+	} else if settings.authTokenRequired && config.Authentication.AuthToken == "" &&
+		config.Authentication.Method != AuthMethodAgent {
+		return nil, pkg.NewErrSecretStore("AuthToken is required in config")
 	}
 
 	return &vaultClient, err
 }
 
 func createHTTPClient(config types.SecretConfig) (pkg.Caller, error) {
-
-	if config.RootCaCertPath == "" {
-		return http.DefaultClient, nil
+	if config.Protocol == types.UnixProtocol {
+		// a local Vault Agent listener, which is not reached over TLS
+		return pkg.NewUnixSocketHTTPClient(config.Host, config.Transport)
 	}
 
-	// Read and load the CA Root certificate so the client will be able to use TLS without skipping the verification of
-	// the cert received by the server.
-	caCert, err := ioutil.ReadFile(config.RootCaCertPath)
+	tlsConfig, err := newTLSConfig(config)
 	if err != nil {
-		return nil, ErrCaRootCert{
-			path:        config.RootCaCertPath,
-			description: err.Error(),
-		}
+		return nil, err
 	}
-	caCertPool := x509.NewCertPool()
-	caCertPool.AppendCertsFromPEM(caCert)
-
-	return &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:    caCertPool,
-				ServerName: config.ServerName,
-			},
-		},
-	}, nil
+
+	return pkg.NewHTTPClient(config.Transport, tlsConfig)
 }
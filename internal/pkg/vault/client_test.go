@@ -46,7 +46,7 @@ func TestNewClient(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			client, err := NewClient(test.Config, nil, true, mockLogger)
+			client, err := NewClient(test.Config, WithLogger(mockLogger), WithAuthTokenRequired())
 			if test.ExpectError {
 				require.Error(t, err)
 				return
@@ -0,0 +1,80 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// healthDetectQuery makes sys/health respond 200 for standby, sealed and uninitialized servers, so the version
+// is reported regardless of the server's state.
+const healthDetectQuery = "?standbyok=true&perfstandbyok=true&sealedcode=200&uninitcode=200"
+
+// ServerFlavor returns the flavor of the Vault API server, either CompatibilityVault or CompatibilityOpenBao.
+// The flavor comes from the Compatibility config, or is detected from sys/health when it is "auto".
+func (c *Client) ServerFlavor(ctx context.Context) (string, error) {
+	c.flavorMutex.Lock()
+	defer c.flavorMutex.Unlock()
+
+	if c.flavor != "" {
+		return c.flavor, nil
+	}
+
+	response := HealthResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodGet,
+		Path:                 HealthAPI + healthDetectQuery,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "detect server flavor",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	c.flavor = flavorFromVersion(response.Version)
+	c.lc.Infof("detected %s server flavor from version '%s'", c.flavor, response.Version)
+
+	return c.flavor, nil
+}
+
+// IsOpenBao reports whether the server is OpenBao, detecting the flavor if needed
+func (c *Client) IsOpenBao(ctx context.Context) (bool, error) {
+	flavor, err := c.ServerFlavor(ctx)
+	return flavor == CompatibilityOpenBao, err
+}
+
+// flavorFromVersion determines the flavor from the version reported by sys/health. OpenBao forked from Vault 1.14
+// and restarted its versioning at 2.0.0, while HashiCorp Vault has only released 1.x versions, so any version
+// naming OpenBao in its build metadata or with a major version of 2 or above is treated as OpenBao.
+func flavorFromVersion(version string) string {
+	lower := strings.ToLower(version)
+	if strings.Contains(lower, "bao") {
+		return CompatibilityOpenBao
+	}
+
+	major := strings.SplitN(strings.TrimPrefix(lower, "v"), ".", 2)[0]
+	if number, err := strconv.Atoi(major); err == nil && number >= 2 {
+		return CompatibilityOpenBao
+	}
+
+	return CompatibilityVault
+}
@@ -0,0 +1,89 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestServerFlavor(t *testing.T) {
+	tests := []struct {
+		name           string
+		compatibility  string
+		version        string
+		expectedFlavor string
+		expectRequest  bool
+	}{
+		{"Default", "", "2.0.0", CompatibilityVault, false},
+		{"Explicit Vault", CompatibilityVault, "2.0.0", CompatibilityVault, false},
+		{"Explicit OpenBao", CompatibilityOpenBao, "1.15.2", CompatibilityOpenBao, false},
+		{"Auto - Vault", CompatibilityAuto, "1.15.2", CompatibilityVault, true},
+		{"Auto - OpenBao", CompatibilityAuto, "2.1.0", CompatibilityOpenBao, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := 0
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				require.Equal(t, HealthAPI, r.URL.EscapedPath())
+				require.Equal(t, "200", r.URL.Query().Get("sealedcode"))
+				_ = json.NewEncoder(w).Encode(HealthResponse{Initialized: true, Sealed: true, Version: test.version})
+			}))
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.MockLogger{})
+			client.Config.Compatibility = test.compatibility
+			client, err := NewClient(client.Config, WithHTTPClient(pkg.NewMockRequester().Insecure()), WithLogger(logger.MockLogger{}))
+			require.NoError(t, err)
+
+			flavor, err := client.ServerFlavor(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedFlavor, flavor)
+
+			// the detected flavor is cached
+			isOpenBao, err := client.IsOpenBao(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedFlavor == CompatibilityOpenBao, isOpenBao)
+			assert.Equal(t, test.expectRequest, requests == 1)
+		})
+	}
+}
+
+func TestInvalidCompatibility(t *testing.T) {
+	_, err := NewClient(types.SecretConfig{Compatibility: "consul"}, WithHTTPClient(pkg.NewMockRequester().Insecure()), WithLogger(logger.MockLogger{}))
+	require.Error(t, err)
+}
+
+func TestFlavorFromVersion(t *testing.T) {
+	assert.Equal(t, CompatibilityVault, flavorFromVersion("1.15.2"))
+	assert.Equal(t, CompatibilityVault, flavorFromVersion("1.9.0+ent"))
+	assert.Equal(t, CompatibilityVault, flavorFromVersion(""))
+	assert.Equal(t, CompatibilityOpenBao, flavorFromVersion("2.0.0"))
+	assert.Equal(t, CompatibilityOpenBao, flavorFromVersion("v2.3.1"))
+	assert.Equal(t, CompatibilityOpenBao, flavorFromVersion("1.14.9+openbao"))
+}
@@ -15,28 +15,148 @@
 
 package vault
 
+import "time"
+
 const (
+	// defaultRetryInterval is the wait before the first retry when the retry policy has no InitialInterval
+	defaultRetryInterval = 100 * time.Millisecond
+	// maxErrorBodySize bounds how much of a failed response is read for its error strings
+	maxErrorBodySize = 64 * 1024
+
 	// NamespaceHeader specifies the header name to use when including Namespace information in a request.
 	NamespaceHeader = "X-Vault-Namespace"
 	AuthTypeHeader  = "X-Vault-Token"
 
+	// RetryAfterHeader and the RateLimit headers carry the hints of a rate limited response, the latter only when
+	// the rate limit quota of Vault is configured with enable_rate_limit_response_headers.
+	RetryAfterHeader         = "Retry-After"
+	RateLimitLimitHeader     = "X-Ratelimit-Limit"
+	RateLimitRemainingHeader = "X-Ratelimit-Remaining"
+
 	HealthAPI              = "/v1/sys/health"
+	AgentMetricsAPI        = "/agent/v1/metrics"
 	InitAPI                = "/v1/sys/init"
 	UnsealAPI              = "/v1/sys/unseal"
+	SealStatusAPI          = "/v1/sys/seal-status"
+	SealAPI                = "/v1/sys/seal"
+	RekeyInitAPI           = "/v1/sys/rekey/init"
+	RekeyUpdateAPI         = "/v1/sys/rekey/update"
+	RekeyVerifyAPI         = "/v1/sys/rekey/verify"
+	RotateAPI              = "/v1/sys/rotate"
+	KeyStatusAPI           = "/v1/sys/key-status"
+	RaftSnapshotAPI        = "/v1/sys/storage/raft/snapshot"
+	RaftConfigurationAPI   = "/v1/sys/storage/raft/configuration"
+	RaftRemovePeerAPI      = "/v1/sys/storage/raft/remove-peer"
+	LeaderAPI              = "/v1/sys/leader"
+	HAStatusAPI            = "/v1/sys/ha-status"
+	StepDownAPI            = "/v1/sys/step-down"
 	CreatePolicyPath       = "/v1/sys/policies/acl/%s"
 	CreateTokenAPI         = "/v1/auth/token/create"
+	CreateOrphanTokenAPI   = "/v1/auth/token/create-orphan"
+	CreateRoleTokenAPI     = "/v1/auth/token/create/%s"
+	TokenRolesAPI          = "/v1/auth/token/roles"
+	TokenRoleAPI           = "/v1/auth/token/roles/%s"
 	ListAccessorsAPI       = "/v1/auth/token/accessors"
 	RevokeAccessorAPI      = "/v1/auth/token/revoke-accessor"
 	LookupAccessorAPI      = "/v1/auth/token/lookup-accessor"
 	LookupSelfAPI          = "/v1/auth/token/lookup-self"
 	RevokeSelfAPI          = "/v1/auth/token/revoke-self"
+	RevokeOrphanAPI        = "/v1/auth/token/revoke-orphan"
+	RenewSelfAPI           = "/v1/auth/token/renew-self"
 	RootTokenControlAPI    = "/v1/sys/generate-root/attempt"
 	RootTokenRetrievalAPI  = "/v1/sys/generate-root/update"
 	MountsAPI              = "/v1/sys/mounts"
+	RemountAPI             = "/v1/sys/remount"
+	MountInfoAPI           = "/v1/sys/internal/ui/mounts"
 	GenerateConsulTokenAPI = "/v1/consul/creds/%s"
+	ConsulAccessAPI        = "/v1/%s/config/access"
+	ConsulRoleAPI          = "/v1/%s/roles/%s"
+	SSHCAConfigAPI         = "/v1/%s/config/ca"
+	SSHRoleAPI             = "/v1/%s/roles/%s"
+	SSHSignAPI             = "/v1/%s/sign/%s"
+	RabbitMQConnectionAPI  = "/v1/%s/config/connection"
+	RabbitMQRoleAPI        = "/v1/%s/roles/%s"
+	RabbitMQCredentialsAPI = "/v1/rabbitmq/creds/%s"
+	EntityByNameAPI        = "/v1/identity/entity/name/%s"
+	EntityAliasAPI         = "/v1/identity/entity-alias"
+	GroupByNameAPI         = "/v1/identity/group/name/%s"
+	IdentityTokenKeyAPI    = "/v1/identity/oidc/key/%s"
+	IdentityTokenRoleAPI   = "/v1/identity/oidc/role/%s"
+	IdentityTokenAPI       = "/v1/identity/oidc/token/%s"
+	IdentityIntrospectAPI  = "/v1/identity/oidc/introspect"
+	CapabilitiesSelfAPI    = "/v1/sys/capabilities-self"
+	AuthMethodsAPI         = "/v1/sys/auth"
+	AuditDevicesAPI        = "/v1/sys/audit"
+	AuditHashAPI           = "/v1/sys/audit-hash"
+	DatabaseCredentialsAPI = "/v1/database/creds/%s"
+	DatabaseConfigAPI      = "/v1/%s/config/%s"
+	DatabaseRoleAPI        = "/v1/%s/roles/%s"
+	LeaseRenewAPI          = "/v1/sys/leases/renew"
+	LeaseRevokeAPI         = "/v1/sys/leases/revoke"
+	LeaseLookupAPI         = "/v1/sys/leases/lookup"
+	LeaseRevokePrefixAPI   = "/v1/sys/leases/revoke-prefix/%s"
+	TransitKeyAPI          = "/v1/%s/keys/%s"
+	TransitKeyRotateAPI    = "/v1/%s/keys/%s/rotate"
+	TransitKeyConfigAPI    = "/v1/%s/keys/%s/config"
+	TransitKeyTrimAPI      = "/v1/%s/keys/%s/trim"
+	TransitEncryptAPI      = "/v1/transit/encrypt/%s"
+	TransitDecryptAPI      = "/v1/transit/decrypt/%s"
+	TransitRewrapAPI       = "/v1/transit/rewrap/%s"
+	TransitSignAPI         = "/v1/transit/sign/%s"
+	TransitVerifyAPI       = "/v1/transit/verify/%s"
+	TransitHMACAPI         = "/v1/transit/hmac/%s"
+	PKIGenerateRootAPI     = "/v1/%s/root/generate/internal"
+	PKIGenerateCSRAPI      = "/v1/%s/intermediate/generate/internal"
+	PKISignIntermediateAPI = "/v1/%s/root/sign-intermediate"
+	PKISetSignedAPI        = "/v1/%s/intermediate/set-signed"
+	PKIRoleAPI             = "/v1/%s/roles/%s"
+	PKIIssueAPI            = "/v1/%s/issue/%s"
+
+	LoginAPIFmt   = "/v1/auth/%s/login"
+	UnwrapAPI     = "/v1/sys/wrapping/unwrap"
+	WrapAPI       = "/v1/sys/wrapping/wrap"
+	WrapLookupAPI = "/v1/sys/wrapping/lookup"
+	CubbyholeAPI  = "/v1/cubbyhole/%s"
+
+	WrapTTLHeader = "X-Vault-Wrap-TTL"
 
 	lookupSelfVaultAPI = "/v1/auth/token/lookup-self"
 	renewSelfVaultAPI  = "/v1/auth/token/renew-self"
 
 	emptyToken = ""
+
+	// CompatibilityVault, CompatibilityOpenBao and CompatibilityAuto are the supported values of
+	// SecretConfig.Compatibility
+	CompatibilityVault   = "vault"
+	CompatibilityOpenBao = "openbao"
+	CompatibilityAuto    = "auto"
+
+	// KVVersion1, KVVersion2 and KVVersionAuto are the supported SecretConfig.KVVersion values
+	KVVersion1    = "1"
+	KVVersion2    = "2"
+	KVVersionAuto = "auto"
+
+	// AuthMethodToken and the login auth methods are the supported AuthenticationInfo.Method values
+	AuthMethodToken      = "token"
+	AuthMethodAppRole    = "approle"
+	AuthMethodKubernetes = "kubernetes"
+	AuthMethodCert       = "cert"
+	AuthMethodJWT        = "jwt"
+	AuthMethodAWS        = "aws"
+	AuthMethodUserpass   = "userpass"
+	AuthMethodSPIFFE     = "spiffe"
+	AuthMethodRuntime    = "runtime"
+	// AuthMethodAgent sends the requests without a token to a co-located Vault Agent, which adds the token of its
+	// auto-auth and may serve cached responses
+	AuthMethodAgent = "agent"
+
+	// SVIDTypeJWT and SVIDTypeX509 are the supported SPIFFEAuthInfo.SVIDType values
+	SVIDTypeJWT  = "jwt"
+	SVIDTypeX509 = "x509"
+
+	// TokenTypeService and TokenTypeBatch are the supported TokenCreateRequest.Type values
+	TokenTypeService = "service"
+	TokenTypeBatch   = "batch"
+
+	defaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 )
@@ -0,0 +1,76 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// ConfigureConsulAccess configures the connection of the Consul secrets engine at mountPoint to Consul
+func (c *Client) ConfigureConsulAccess(ctx context.Context, token string, mountPoint string, access types.ConsulAccess) error {
+	if access.Address == "" || access.Token == "" {
+		return pkg.NewErrSecretStore("Consul address and token cannot be empty for configuring Consul access")
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(ConsulAccessAPI, strings.Trim(mountPoint, "/")), access,
+		"configure Consul access for "+mountPoint)
+}
+
+// CreateConsulRole creates or replaces the role named roleName of the Consul secrets engine at mountPoint
+func (c *Client) CreateConsulRole(ctx context.Context, token string, mountPoint string, roleName string, role types.ConsulRole) error {
+	if roleName == "" {
+		return pkg.NewErrSecretStore("Consul role name cannot be empty")
+	}
+	if len(role.ConsulPolicies) == 0 && len(role.ConsulRoles) == 0 && len(role.ServiceIdentities) == 0 {
+		return pkg.NewErrSecretStore(fmt.Sprintf("Consul role '%s' must have policies, roles or service identities", roleName))
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(ConsulRoleAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)), role,
+		"create Consul role "+roleName)
+}
+
+// GetConsulCredentials creates a Consul ACL token for the role using the client's token. Unlike
+// GenerateConsulToken, the lease is returned so the token can be renewed and revoked.
+func (c *Client) GetConsulCredentials(ctx context.Context, role string) (types.ConsulCredentials, error) {
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return types.ConsulCredentials{}, pkg.NewErrSecretStore("role cannot be empty for getting Consul credentials")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodGet, fmt.Sprintf(GenerateConsulTokenAPI, url.PathEscape(role)), nil)
+	if err != nil {
+		return types.ConsulCredentials{}, err
+	}
+
+	var response ConsulCredentialsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.ConsulCredentials{}, err
+	}
+	c.trackLease(response.Lease)
+
+	return types.ConsulCredentials{
+		Token:    response.Data.Token,
+		Accessor: response.Data.Accessor,
+		Lease:    response.Lease,
+	}, nil
+}
@@ -0,0 +1,109 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockConsulServer is a stub Vault with the Consul secrets engine mounted at "consul"
+type mockConsulServer struct {
+	mutex  sync.Mutex
+	access types.ConsulAccess
+	roles  map[string]types.ConsulRole
+}
+
+func (m *mockConsulServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/v1/consul/config/access" && r.Method == http.MethodPost:
+		_ = json.NewDecoder(r.Body).Decode(&m.access)
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/consul/roles/") && r.Method == http.MethodPost:
+		var role types.ConsulRole
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		m.roles[strings.TrimPrefix(r.URL.Path, "/v1/consul/roles/")] = role
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/consul/creds/") && r.Method == http.MethodGet:
+		name := strings.TrimPrefix(r.URL.Path, "/v1/consul/creds/")
+		role, ok := m.roles[name]
+		if !ok || m.access.Token == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {`role "` + name + `" not found`}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"lease_id":       "consul/creds/" + name + "/lease1",
+			"lease_duration": role.TTL,
+			"renewable":      true,
+			"data":           map[string]interface{}{"token": "consul-token", "accessor": "consul-accessor", "local": false},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestConsulSecretEngine(t *testing.T) {
+	mock := &mockConsulServer{roles: make(map[string]types.ConsulRole)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	access := types.ConsulAccess{Address: "edgex-core-consul:8500", Token: "bootstrap-token"}
+	require.NoError(t, client.ConfigureConsulAccess(context.Background(), expectedToken, "consul", access))
+	assert.Equal(t, access, mock.access)
+	require.Error(t, client.ConfigureConsulAccess(context.Background(), expectedToken, "consul", types.ConsulAccess{Address: "edgex-core-consul:8500"}))
+
+	role := types.ConsulRole{ServiceIdentities: []string{"core-data"}, TTL: 3600, MaxTTL: 86400}
+	require.NoError(t, client.CreateConsulRole(context.Background(), expectedToken, "consul", "core-data", role))
+	assert.Equal(t, role, mock.roles["core-data"])
+	require.Error(t, client.CreateConsulRole(context.Background(), expectedToken, "consul", "core-data", types.ConsulRole{TTL: 3600}))
+
+	credentials, err := client.GetConsulCredentials(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, types.ConsulCredentials{
+		Token:    "consul-token",
+		Accessor: "consul-accessor",
+		Lease:    types.Lease{LeaseId: "consul/creds/core-data/lease1", LeaseDuration: 3600, Renewable: true},
+	}, credentials)
+
+	_, err = client.GetConsulCredentials(context.Background(), "unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+	_, err = client.GetConsulCredentials(context.Background(), "")
+	require.Error(t, err)
+}
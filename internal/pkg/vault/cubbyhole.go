@@ -0,0 +1,118 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// cubbyholeDeliveryUses covers writing the payload, reading it and the consumer revoking the delivery token
+const cubbyholeDeliveryUses = 3
+
+// DeliverToCubbyhole creates a short lived, limited use child token of token and writes payload into that token's
+// cubbyhole at path. Only the holder of the returned delivery token can read the payload, with
+// ReceiveFromCubbyhole, and the cubbyhole is destroyed with the token once it is read or ttl expires.
+func (c *Client) DeliverToCubbyhole(ctx context.Context, token string, path string, payload map[string]string, ttl time.Duration) (string, error) {
+	if len(payload) == 0 {
+		return "", pkg.NewErrSecretStore("cubbyhole payload cannot be empty")
+	}
+	if ttl < time.Second {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("cubbyhole delivery TTL must be at least one second, got %v", ttl))
+	}
+
+	response := LoginResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken: token,
+		Method:    http.MethodPost,
+		Path:      CreateTokenAPI,
+		JSONObject: map[string]interface{}{
+			// the default policy grants access to the token's own cubbyhole and revoke-self
+			"policies":     []string{"default"},
+			"num_uses":     cubbyholeDeliveryUses,
+			"ttl":          fmt.Sprintf("%ds", int(ttl.Seconds())),
+			"renewable":    false,
+			"display_name": "cubbyhole-delivery",
+		},
+		BodyReader:           nil,
+		OperationDescription: "create cubbyhole delivery token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return "", err
+	}
+	deliveryToken := response.Auth.ClientToken.Reveal()
+	if deliveryToken == "" {
+		return "", pkg.NewErrSecretStore("create token response does not contain a client token")
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            deliveryToken,
+		Method:               http.MethodPost,
+		Path:                 cubbyholePath(path),
+		JSONObject:           payload,
+		BodyReader:           nil,
+		OperationDescription: "write cubbyhole payload",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+	if err != nil {
+		// don't leave an unusable delivery token behind
+		_ = c.RevokeToken(ctx, deliveryToken)
+		return "", err
+	}
+
+	return deliveryToken, nil
+}
+
+// ReceiveFromCubbyhole reads the payload delivered at path in the delivery token's cubbyhole by DeliverToCubbyhole,
+// then revokes the delivery token, which destroys the cubbyhole
+func (c *Client) ReceiveFromCubbyhole(ctx context.Context, deliveryToken string, path string) (map[string]string, error) {
+	response := CubbyholeReadResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            deliveryToken,
+		Method:               http.MethodGet,
+		Path:                 cubbyholePath(path),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "read cubbyhole payload",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.RevokeToken(ctx, deliveryToken); err != nil {
+		// the payload was read, and the token expires with its TTL regardless
+		c.lc.Warnf("unable to revoke the cubbyhole delivery token: %v", err)
+	}
+
+	if len(response.Data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present in the cubbyhole at: '%s'", path))
+	}
+
+	return response.Data, nil
+}
+
+func cubbyholePath(path string) string {
+	return fmt.Sprintf(CubbyholeAPI, strings.Trim(path, "/"))
+}
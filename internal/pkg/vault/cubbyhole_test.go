@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockCubbyholeServer is a stub Vault issuing limited use tokens, each with its own cubbyhole
+type mockCubbyholeServer struct {
+	mutex      sync.Mutex
+	uses       map[string]int
+	cubbyholes map[string]map[string]map[string]string
+	created    map[string]interface{}
+	failWrite  bool
+}
+
+func (m *mockCubbyholeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	token := r.Header.Get(AuthTypeHeader)
+	if r.URL.Path == CreateTokenAPI {
+		if token != expectedToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&m.created)
+		m.uses["delivery-token"] = int(m.created["num_uses"].(float64))
+		m.cubbyholes["delivery-token"] = make(map[string]map[string]string)
+		_ = json.NewEncoder(w).Encode(LoginResponse{Auth: LoginAuth{ClientToken: "delivery-token"}})
+		return
+	}
+
+	if m.uses[token] <= 0 {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	m.uses[token]--
+	defer func() {
+		// the token is revoked along with its cubbyhole once its uses are exhausted
+		if m.uses[token] == 0 {
+			delete(m.cubbyholes, token)
+		}
+	}()
+
+	switch {
+	case r.URL.Path == RevokeSelfAPI:
+		m.uses[token] = 0
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/cubbyhole/") && r.Method == http.MethodPost:
+		if m.failWrite {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data := make(map[string]string)
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		m.cubbyholes[token][r.URL.Path] = data
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/cubbyhole/") && r.Method == http.MethodGet:
+		data, ok := m.cubbyholes[token][r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(CubbyholeReadResponse{Data: data})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newMockCubbyholeServer() *mockCubbyholeServer {
+	return &mockCubbyholeServer{
+		uses:       make(map[string]int),
+		cubbyholes: make(map[string]map[string]map[string]string),
+	}
+}
+
+func TestCubbyholeDelivery(t *testing.T) {
+	mock := newMockCubbyholeServer()
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	payload := map[string]string{"role_id": "role", "secret_id": "secret"}
+
+	deliveryToken, err := client.DeliverToCubbyhole(context.Background(), expectedToken, "/bootstrap/core-data", payload, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "delivery-token", deliveryToken)
+	assert.Equal(t, "60s", mock.created["ttl"])
+	assert.Equal(t, false, mock.created["renewable"])
+
+	received, err := client.ReceiveFromCubbyhole(context.Background(), deliveryToken, "bootstrap/core-data")
+	require.NoError(t, err)
+	assert.Equal(t, payload, received)
+
+	// the delivery token and its cubbyhole are destroyed after the payload is read
+	assert.Empty(t, mock.cubbyholes)
+	_, err = client.ReceiveFromCubbyhole(context.Background(), deliveryToken, "bootstrap/core-data")
+	require.Error(t, err)
+}
+
+func TestCubbyholeDeliveryFailure(t *testing.T) {
+	mock := newMockCubbyholeServer()
+	mock.failWrite = true
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	_, err := client.DeliverToCubbyhole(context.Background(), expectedToken, "bootstrap", map[string]string{"key": "value"}, time.Minute)
+	require.Error(t, err)
+	assert.Empty(t, mock.cubbyholes, "the delivery token must be revoked when the payload can't be written")
+
+	_, err = client.DeliverToCubbyhole(context.Background(), expectedToken, "bootstrap", nil, time.Minute)
+	require.Error(t, err)
+	_, err = client.DeliverToCubbyhole(context.Background(), expectedToken, "bootstrap", map[string]string{"key": "value"}, 0)
+	require.Error(t, err)
+}
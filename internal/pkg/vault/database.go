@@ -0,0 +1,149 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableDatabaseSecretEngine mounts the database secrets engine at mountPoint
+func (c *Client) EnableDatabaseSecretEngine(ctx context.Context, token string, mountPoint string) error {
+	parameters := EnableSecretsEngineRequest{
+		Type:        Database,
+		Description: "dynamic database credentials",
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "update mounts for database",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ConfigureDatabaseConnection writes the connection named name of the database secrets engine at mountPoint
+func (c *Client) ConfigureDatabaseConnection(ctx context.Context, token string, mountPoint string, name string, connection types.DatabaseConnection) error {
+	if name == "" || connection.PluginName == "" {
+		return pkg.NewErrSecretStore("database connection name and plugin name cannot be empty")
+	}
+
+	// the plugin settings are top level parameters alongside the common ones
+	encoded, err := json.Marshal(connection)
+	if err != nil {
+		return err
+	}
+	parameters := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &parameters); err != nil {
+		return err
+	}
+	for key, value := range connection.PluginSettings {
+		parameters[key] = value
+	}
+
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 databasePath(DatabaseConfigAPI, mountPoint, name),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "configure database connection " + name,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// CreateDatabaseRole creates or replaces the role named roleName of the database secrets engine at mountPoint
+func (c *Client) CreateDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string, role types.DatabaseRole) error {
+	if roleName == "" || role.DBName == "" {
+		return pkg.NewErrSecretStore("database role name and connection name cannot be empty")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 databasePath(DatabaseRoleAPI, mountPoint, roleName),
+		JSONObject:           role,
+		BodyReader:           nil,
+		OperationDescription: "create database role " + roleName,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// DeleteDatabaseRole deletes the role named roleName of the database secrets engine at mountPoint. Credentials
+// already created for the role are revoked when their lease expires.
+func (c *Client) DeleteDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodDelete,
+		Path:                 databasePath(DatabaseRoleAPI, mountPoint, roleName),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "delete database role " + roleName,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// GetDatabaseCredentials creates new credentials for the database role using the client's token. The credentials
+// are revoked by the secret store when their lease expires, unless the lease is renewed.
+func (c *Client) GetDatabaseCredentials(ctx context.Context, role string) (types.DatabaseCredentials, error) {
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return types.DatabaseCredentials{}, pkg.NewErrSecretStore("role cannot be empty for getting database credentials")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodGet, fmt.Sprintf(DatabaseCredentialsAPI, url.PathEscape(role)), nil)
+	if err != nil {
+		return types.DatabaseCredentials{}, err
+	}
+
+	var response DatabaseCredentialsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.DatabaseCredentials{}, err
+	}
+	c.trackLease(response.Lease)
+
+	return types.DatabaseCredentials{
+		Username: response.Data.Username,
+		Password: response.Data.Password,
+		Lease:    response.Lease,
+	}, nil
+}
+
+func databasePath(apiFmt string, mountPoint string, name string) string {
+	return fmt.Sprintf(apiFmt, strings.Trim(mountPoint, "/"), url.PathEscape(name))
+}
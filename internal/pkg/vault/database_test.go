@@ -0,0 +1,145 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockDatabaseServer is a stub Vault with the database secrets engine mounted at "database"
+type mockDatabaseServer struct {
+	mutex       sync.Mutex
+	mounted     bool
+	connections map[string]map[string]interface{}
+	roles       map[string]types.DatabaseRole
+}
+
+func (m *mockDatabaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+		return
+	}
+
+	switch {
+	case r.URL.Path == MountsAPI+"/database" && r.Method == http.MethodPost:
+		var request EnableSecretsEngineRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.mounted = request.Type == Database
+		w.WriteHeader(http.StatusNoContent)
+	case !m.mounted:
+		w.WriteHeader(http.StatusNotFound)
+	case strings.HasPrefix(r.URL.Path, "/v1/database/config/") && r.Method == http.MethodPost:
+		connection := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&connection)
+		m.connections[strings.TrimPrefix(r.URL.Path, "/v1/database/config/")] = connection
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/database/roles/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/database/roles/")
+		if r.Method == http.MethodDelete {
+			delete(m.roles, name)
+		} else {
+			var role types.DatabaseRole
+			_ = json.NewDecoder(r.Body).Decode(&role)
+			m.roles[name] = role
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/database/creds/") && r.Method == http.MethodGet:
+		name := strings.TrimPrefix(r.URL.Path, "/v1/database/creds/")
+		role, ok := m.roles[name]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {`unknown role: ` + name}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"lease_id":       "database/creds/" + name + "/lease1",
+			"lease_duration": role.DefaultTTL,
+			"renewable":      true,
+			"data":           map[string]string{"username": "v-" + name + "-user", "password": "generated"},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestDatabaseSecretEngine(t *testing.T) {
+	mock := &mockDatabaseServer{
+		connections: make(map[string]map[string]interface{}),
+		roles:       make(map[string]types.DatabaseRole),
+	}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	require.NoError(t, client.EnableDatabaseSecretEngine(context.Background(), expectedToken, "database"))
+
+	verify := false
+	connection := types.DatabaseConnection{
+		PluginName:       "redis-database-plugin",
+		Username:         "admin",
+		Password:         "password",
+		AllowedRoles:     []string{"core-data"},
+		VerifyConnection: &verify,
+		PluginSettings:   map[string]interface{}{"host": "edgex-redis", "port": 6379},
+	}
+	require.NoError(t, client.ConfigureDatabaseConnection(context.Background(), expectedToken, "database", "redis", connection))
+	assert.Equal(t, "redis-database-plugin", mock.connections["redis"]["plugin_name"])
+	assert.Equal(t, "edgex-redis", mock.connections["redis"]["host"])
+	assert.Equal(t, false, mock.connections["redis"]["verify_connection"])
+	require.Error(t, client.ConfigureDatabaseConnection(context.Background(), expectedToken, "database", "redis", types.DatabaseConnection{}))
+
+	role := types.DatabaseRole{
+		DBName:             "redis",
+		CreationStatements: []string{`["~*", "+@all"]`},
+		DefaultTTL:         3600,
+		MaxTTL:             86400,
+	}
+	require.NoError(t, client.CreateDatabaseRole(context.Background(), expectedToken, "database", "core-data", role))
+	assert.Equal(t, role, mock.roles["core-data"])
+	require.Error(t, client.CreateDatabaseRole(context.Background(), expectedToken, "database", "core-data", types.DatabaseRole{}))
+
+	credentials, err := client.GetDatabaseCredentials(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, "v-core-data-user", credentials.Username)
+	assert.Equal(t, "generated", credentials.Password.Reveal())
+	assert.Equal(t, types.Lease{LeaseId: "database/creds/core-data/lease1", LeaseDuration: 3600, Renewable: true}, credentials.Lease)
+
+	require.NoError(t, client.DeleteDatabaseRole(context.Background(), expectedToken, "database", "core-data"))
+	_, err = client.GetDatabaseCredentials(context.Background(), "core-data")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown role")
+
+	_, err = client.GetDatabaseCredentials(context.Background(), " ")
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+// destroyer is implemented by the TokenProviders caching credentials
+type destroyer interface {
+	destroy()
+}
+
+// Destroy stops the renewal of the token and the token file watch, wipes the buffer holding the token and forgets the
+// credentials cached by the auth method, after which the client must not be used. The token is not revoked, it
+// remains valid until it expires. The configured AuthToken and the copies of the token made for the requests are Go
+// strings, which cannot be overwritten and are left for the garbage collector to reclaim.
+func (c *Client) Destroy() {
+	if c.stopRenewal != nil {
+		c.stopRenewal()
+	}
+	if provider, ok := c.tokenProvider.(destroyer); ok {
+		provider.destroy()
+	}
+	c.destroyToken()
+}
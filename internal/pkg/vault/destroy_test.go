@@ -0,0 +1,60 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestDestroy(t *testing.T) {
+	client := &Client{lc: logger.NewMockClient()}
+	client.Config.Authentication.AuthToken = expectedToken
+	provider := &appRoleProvider{client: client, secretId: "unwrapped-secret-id"}
+	client.tokenProvider = provider
+	ctx, cancel := context.WithCancel(context.Background())
+	client.stopRenewal = cancel
+
+	client.Destroy()
+	assert.Empty(t, client.authToken())
+	assert.Empty(t, provider.secretId)
+	assert.Error(t, ctx.Err(), "token renewal not stopped")
+
+	// a client without renewal or auth method is destroyed as well
+	client = &Client{lc: logger.NewMockClient()}
+	client.Config.Authentication.AuthToken = expectedToken
+	client.Destroy()
+	assert.Empty(t, client.authToken())
+}
+
+func TestDestroyWipesToken(t *testing.T) {
+	client := &Client{lc: logger.NewMockClient()}
+	client.setAuthToken("first-token")
+	swappedOut := client.token.Bytes()
+	client.setAuthToken(expectedToken)
+	assert.Equal(t, make([]byte, len("first-token")), swappedOut, "the swapped out token is wiped")
+
+	cached := client.token.Bytes()
+	require.Equal(t, expectedToken, string(cached))
+	client.Destroy()
+	assert.Equal(t, make([]byte, len(expectedToken)), cached, "the token is wiped")
+	assert.True(t, client.token.Destroyed())
+	assert.Empty(t, client.authToken())
+}
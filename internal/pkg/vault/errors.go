@@ -16,9 +16,13 @@
 package vault
 
 import (
+	"errors"
 	"fmt"
 )
 
+// errTokenMaxTTLReached is the renewal failure when the token expires before the next renewal as it reached its max TTL
+var errTokenMaxTTLReached = errors.New("token has reached its max TTL")
+
 // ErrCaRootCert error when the provided CA Root certificate is invalid.
 type ErrCaRootCert struct {
 	path        string
@@ -28,12 +32,3 @@ type ErrCaRootCert struct {
 func (e ErrCaRootCert) Error() string {
 	return fmt.Sprintf("Unable to use the certificate '%s': %s", e.path, e.description)
 }
-
-type ErrHTTPResponse struct {
-	StatusCode int
-	ErrMsg     string
-}
-
-func (err ErrHTTPResponse) Error() string {
-	return fmt.Sprintf("HTTP response with status code %d, message: %s", err.StatusCode, err.ErrMsg)
-}
@@ -0,0 +1,136 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockFailingVault responds to every request with status and the Vault error strings
+type mockFailingVault struct {
+	status int
+	body   string
+}
+
+func (m *mockFailingVault) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(m.status)
+	_, _ = w.Write([]byte(m.body))
+}
+
+func TestTypedErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		vault    *mockFailingVault
+		expected error
+	}{
+		{"Not found", &mockFailingVault{http.StatusNotFound, `{"errors":[]}`}, pkg.ErrSecretNotFound{}},
+		{"Permission denied", &mockFailingVault{http.StatusForbidden, `{"errors":["permission denied"]}`}, pkg.ErrPermissionDenied{}},
+		{"Token expired", &mockFailingVault{http.StatusForbidden, `{"errors":["invalid token"]}`}, pkg.ErrTokenExpired{}},
+		{"Sealed", &mockFailingVault{http.StatusServiceUnavailable, `{"errors":["Vault is sealed"]}`}, pkg.ErrSealed{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(test.vault)
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.NewMockClient())
+			client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+			// a management request, a secrets request and a KV listing
+			err := client.InstallPolicy(context.Background(), expectedToken, "edgex", `path "secret/*" { capabilities = ["read"] }`)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			_, err = client.GetSecrets(context.Background(), "/redisdb")
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretStore{}, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			if _, notFound := test.expected.(pkg.ErrSecretNotFound); notFound {
+				// nothing to list is not an error
+				return
+			}
+			_, err = client.ListSecretPaths(context.Background(), "/")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			// the token requests
+			_, err = client.GenerateConsulToken(context.Background(), "core-data")
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+			_, err = client.getTokenDetails(context.Background())
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+			_, err = client.renewToken(context.Background())
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+			assert.Equal(t, errors.Is(test.expected, pkg.ErrPermissionDenied{}), isForbidden(err))
+		})
+	}
+}
+
+func TestTypedErrorsKVv2(t *testing.T) {
+	tests := []struct {
+		name     string
+		vault    *mockFailingVault
+		expected error
+	}{
+		{"Not found", &mockFailingVault{http.StatusNotFound, `{"errors":[]}`}, pkg.ErrSecretNotFound{}},
+		{"Permission denied", &mockFailingVault{http.StatusForbidden, `{"errors":["permission denied"]}`}, pkg.ErrPermissionDenied{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(test.vault)
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.NewMockClient())
+			client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+			client.Config.Path = "/v1/secret/edgex/core-data"
+			client.Config.KVVersion = KVVersion2
+
+			_, err := client.GetSecretVersion(context.Background(), "/redisdb", 0)
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretStore{}, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			_, err = client.PatchSecrets(context.Background(), "/redisdb", map[string]string{"password": "new"})
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretStore{}, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			_, err = client.GetSecretMetadata(context.Background(), "/redisdb")
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretStore{}, err)
+			assert.True(t, errors.Is(err, test.expected), err.Error())
+
+			var notFound pkg.ErrSecretNotFound
+			assert.Equal(t, errors.Is(test.expected, pkg.ErrSecretNotFound{}), errors.As(err, &notFound))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	// defaultChildTokenTTL is the TTL of exchanged child tokens when the request doesn't set one
+	defaultChildTokenTTL = time.Hour
+	rootPolicy           = "root"
+)
+
+// ExchangeToken creates a short-lived, renewable child token of parentToken narrowed to the requested policies,
+// which must be held by the parent token, implementing least privilege for a service started with a broader
+// bootstrap token. The parent token is revoked when the request asks to, after which only the child token is valid.
+func (c *Client) ExchangeToken(ctx context.Context, parentToken string, request types.TokenExchangeRequest) (types.TokenCreateResponse, error) {
+	return c.exchangeToken(ctx, parentToken, request)
+}
+
+func (c *Client) exchangeToken(ctx context.Context, parentToken string, request types.TokenExchangeRequest) (types.TokenCreateResponse, error) {
+	var lookup TokenLookupResponse
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            parentToken,
+		Method:               http.MethodGet,
+		Path:                 LookupSelfAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "lookup parent token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &lookup,
+	})
+	if err != nil {
+		return types.TokenCreateResponse{}, err
+	}
+
+	policies, err := narrowPolicies(lookup.Data.Policies, request.Policies)
+	if err != nil {
+		return types.TokenCreateResponse{}, err
+	}
+
+	ttl := request.TTL
+	if ttl <= 0 {
+		ttl = defaultChildTokenTTL
+	}
+	renewable := true
+	child, err := c.createToken(ctx, parentToken, types.TokenCreateRequest{
+		Policies:       policies,
+		DisplayName:    request.DisplayName,
+		Type:           TokenTypeService,
+		Renewable:      &renewable,
+		TTL:            ttl,
+		ExplicitMaxTTL: request.ExplicitMaxTTL,
+	})
+	if err != nil {
+		return types.TokenCreateResponse{}, err
+	}
+	if child.ClientToken == "" {
+		return types.TokenCreateResponse{}, pkg.NewErrSecretStore("creating the child token did not return a client token")
+	}
+
+	if request.RevokeParent {
+		if err := c.revokeOrphan(ctx, parentToken); err != nil {
+			// the exchange is all or nothing, so the child token is not left behind either
+			if revokeErr := c.RevokeToken(ctx, child.ClientToken.Reveal()); revokeErr != nil {
+				c.lc.Warnf("unable to revoke the child token %s after failing to revoke its parent: %v", child.Accessor, revokeErr)
+			}
+			return types.TokenCreateResponse{}, err
+		}
+	}
+
+	c.lc.Infof("exchanged the token for a child token with accessor %s and policies %v", child.Accessor, child.Policies)
+	return child, nil
+}
+
+// revokeOrphan revokes the token while keeping its child tokens valid
+func (c *Client) revokeOrphan(ctx context.Context, token string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 RevokeOrphanAPI,
+		JSONObject:           map[string]string{"token": token},
+		BodyReader:           nil,
+		OperationDescription: "revoke parent token",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// narrowPolicies returns the requested policies, which must all be held by a non-root parent, or the parent's policies
+// when none are requested. A child token is never given the root policy, so a root parent must be narrowed.
+func narrowPolicies(parent []string, requested []string) ([]string, error) {
+	held := make(map[string]bool, len(parent))
+	for _, policy := range parent {
+		held[policy] = true
+	}
+
+	if len(requested) == 0 {
+		if held[rootPolicy] {
+			return nil, pkg.NewErrSecretStore("the policies of the child token are required when exchanging a root token")
+		}
+		return parent, nil
+	}
+
+	for _, policy := range requested {
+		// a root token can create tokens with any policy
+		if policy == rootPolicy || !(held[policy] || held[rootPolicy]) {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("the child token can't be given policy '%s', which the parent token doesn't hold", policy))
+		}
+	}
+	return requested, nil
+}
+
+// exchangeChildToken exchanges the token for the configured child token, returning the child token and its expiry
+func (c *Client) exchangeChildToken(ctx context.Context, token string) (string, time.Time, error) {
+	child, err := c.exchangeToken(ctx, token, c.Config.Authentication.ChildToken)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	auth := LoginAuth{LeaseDuration: child.LeaseDuration}
+	return child.ClientToken.Reveal(), auth.expiry(time.Now()), nil
+}
@@ -0,0 +1,153 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testParentToken = "test-parent-token"
+	testChildToken  = "test-child-token"
+)
+
+// mockExchangeServer is a stub Vault serving a parent token which creates a child token and a secret readable with
+// the child token only
+type mockExchangeServer struct {
+	mutex          sync.Mutex
+	parentPolicies []string
+	created        map[string]interface{}
+	parentRevoked  bool
+	childRevoked   bool
+	// revokeStatus is the status of revoking the parent, defaults to 204
+	revokeStatus int
+}
+
+func (m *mockExchangeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	token := r.Header.Get(AuthTypeHeader)
+	switch {
+	case r.URL.Path == LookupSelfAPI && token == testParentToken && !m.parentRevoked:
+		_ = json.NewEncoder(w).Encode(TokenLookupResponse{Data: types.TokenMetadata{Policies: m.parentPolicies}})
+	case r.URL.Path == LookupSelfAPI && token == testChildToken:
+		_ = json.NewEncoder(w).Encode(TokenLookupResponse{Data: types.TokenMetadata{Renewable: true, Ttl: 3600}})
+	case r.URL.Path == CreateTokenAPI && token == testParentToken:
+		_ = json.NewDecoder(r.Body).Decode(&m.created)
+		_ = json.NewEncoder(w).Encode(TokenCreateResponse{Auth: types.TokenCreateResponse{ClientToken: testChildToken,
+			Accessor: "child-accessor", LeaseDuration: 3600, Renewable: true}})
+	case r.URL.Path == RevokeOrphanAPI && token == testParentToken:
+		var request map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if m.revokeStatus != 0 || request["token"] != testParentToken {
+			w.WriteHeader(m.revokeStatus)
+			return
+		}
+		m.parentRevoked = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == RevokeSelfAPI && token == testChildToken:
+		m.childRevoked = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == testPath+"/redisdb" && token == testChildToken:
+		_, _ = w.Write([]byte(`{"data":{"password":"password"}}`))
+	default:
+		w.WriteHeader(http.StatusForbidden)
+	}
+}
+
+func TestExchangeToken(t *testing.T) {
+	tests := []struct {
+		name           string
+		parentPolicies []string
+		request        types.TokenExchangeRequest
+		revokeStatus   int
+		expected       []interface{}
+		expectError    bool
+	}{
+		{"Valid - parent policies", []string{"default", "edgex-service-core-data"}, types.TokenExchangeRequest{},
+			0, []interface{}{"default", "edgex-service-core-data"}, false},
+		{"Valid - narrowed", []string{"default", "edgex-service-core-data", "bootstrap"},
+			types.TokenExchangeRequest{Policies: []string{"edgex-service-core-data"}, RevokeParent: true},
+			0, []interface{}{"edgex-service-core-data"}, false},
+		{"Valid - narrowed root", []string{"root"}, types.TokenExchangeRequest{Policies: []string{"edgex-service-core-data"}},
+			0, []interface{}{"edgex-service-core-data"}, false},
+		{"Invalid - policy not held", []string{"default"},
+			types.TokenExchangeRequest{Policies: []string{"edgex-service-core-data"}}, 0, nil, true},
+		{"Invalid - root not narrowed", []string{"root"}, types.TokenExchangeRequest{}, 0, nil, true},
+		{"Invalid - root requested", []string{"root"}, types.TokenExchangeRequest{Policies: []string{"root"}}, 0, nil, true},
+		{"Invalid - parent not revoked", []string{"default"}, types.TokenExchangeRequest{RevokeParent: true},
+			http.StatusForbidden, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockExchangeServer{parentPolicies: test.parentPolicies, revokeStatus: test.revokeStatus}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			client, err := NewClient(newAuthTestConfig(t, server, types.AuthenticationInfo{}), WithLogger(logger.NewMockClient()))
+			require.NoError(t, err)
+
+			child, err := client.ExchangeToken(context.Background(), testParentToken, test.request)
+			if test.expectError {
+				require.Error(t, err)
+				// a child token created before the parent failed to be revoked is revoked in turn
+				assert.Equal(t, mock.created != nil, mock.childRevoked)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testChildToken, child.ClientToken.Reveal())
+			assert.Equal(t, test.expected, mock.created["policies"])
+			assert.Equal(t, true, mock.created["renewable"])
+			assert.Equal(t, "3600s", mock.created["ttl"])
+			assert.Equal(t, test.request.RevokeParent, mock.parentRevoked)
+		})
+	}
+}
+
+func TestExchangeChildToken(t *testing.T) {
+	mock := &mockExchangeServer{parentPolicies: []string{"default", "edgex-service-core-data"}}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{AuthToken: testParentToken,
+		ExchangeChildToken: true, ChildToken: types.TokenExchangeRequest{RevokeParent: true}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+	defer client.Destroy()
+
+	assert.True(t, mock.parentRevoked)
+	assert.Equal(t, testChildToken, client.authToken())
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+}
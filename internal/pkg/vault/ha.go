@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// GetLeader returns the HA leadership as seen by the queried node, which does not require a token
+func (c *Client) GetLeader(ctx context.Context) (types.LeaderStatus, error) {
+	response := types.LeaderStatus{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodGet,
+		Path:                 LeaderAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "get HA leader",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response, err
+}
+
+// GetHAStatus returns the nodes of the HA cluster
+func (c *Client) GetHAStatus(ctx context.Context, token string) ([]types.HANode, error) {
+	response := HAStatusResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 HAStatusAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "get HA status",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Nodes, nil
+}
+
+// StepDown makes the active node step down. The request must reach the active node, a standby node forwards it.
+func (c *Client) StepDown(ctx context.Context, token string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 StepDownAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "step down active node",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockHAServer is a stub Vault HA cluster of two nodes, the queried node being active until it steps down
+type mockHAServer struct {
+	mutex  sync.Mutex
+	active int
+}
+
+func (m *mockHAServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	addresses := []string{"https://vault-1:8200", "https://vault-2:8200"}
+
+	if r.URL.Path == LeaderAPI && r.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"ha_enabled":     true,
+			"is_self":        m.active == 0,
+			"active_time":    "0001-01-01T00:00:00Z",
+			"leader_address": addresses[m.active],
+		})
+		return
+	}
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == HAStatusAPI && r.Method == http.MethodGet:
+		var nodes []types.HANode
+		for i, address := range addresses {
+			nodes = append(nodes, types.HANode{APIAddress: address, ActiveNode: i == m.active, Version: "1.9.3"})
+		}
+		_ = json.NewEncoder(w).Encode(HAStatusResponse{Nodes: nodes})
+	case r.URL.Path == StepDownAPI && r.Method == http.MethodPut:
+		m.active = (m.active + 1) % len(addresses)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestHAStatus(t *testing.T) {
+	mock := &mockHAServer{}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	leader, err := client.GetLeader(context.Background())
+	require.NoError(t, err)
+	assert.True(t, leader.HAEnabled)
+	assert.True(t, leader.IsSelf)
+	assert.Equal(t, "https://vault-1:8200", leader.LeaderAddress)
+
+	nodes, err := client.GetHAStatus(context.Background(), expectedToken)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.True(t, nodes[0].ActiveNode)
+	assert.False(t, nodes[1].ActiveNode)
+	_, err = client.GetHAStatus(context.Background(), "bad-token")
+	require.Error(t, err)
+
+	require.NoError(t, client.StepDown(context.Background(), expectedToken))
+	require.Error(t, client.StepDown(context.Background(), "bad-token"))
+
+	leader, err = client.GetLeader(context.Background())
+	require.NoError(t, err)
+	assert.False(t, leader.IsSelf)
+	assert.Equal(t, "https://vault-2:8200", leader.LeaderAddress)
+}
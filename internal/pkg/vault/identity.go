@@ -0,0 +1,197 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const internalGroupType = "internal"
+
+// CreateEntity creates the identity entity named entity.Name, or updates its policies, metadata and disabled state
+// when it exists, and returns its id
+func (c *Client) CreateEntity(ctx context.Context, token string, entity types.Entity) (string, error) {
+	if entity.Name == "" {
+		return "", pkg.NewErrSecretStore("entity name cannot be empty")
+	}
+
+	request := EntityRequest{
+		Policies: entity.Policies,
+		Metadata: entity.Metadata,
+		Disabled: entity.Disabled,
+	}
+
+	response := EntityResponse{}
+	status, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 fmt.Sprintf(EntityByNameAPI, url.PathEscape(entity.Name)),
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: "create entity " + entity.Name,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	// an existing entity is updated without returning it, so its id is looked up
+	if status == http.StatusNoContent {
+		existing, err := c.LookupEntity(ctx, token, entity.Name)
+		return existing.Id, err
+	}
+
+	return response.Data.Id, err
+}
+
+// CreateEntityAlias creates the alias of an auth method identity to the entity with the id alias.CanonicalId and
+// returns the alias id
+func (c *Client) CreateEntityAlias(ctx context.Context, token string, alias types.EntityAlias) (string, error) {
+	if alias.Name == "" || alias.CanonicalId == "" || alias.MountAccessor == "" {
+		return "", pkg.NewErrSecretStore("entity alias name, canonical id and mount accessor cannot be empty")
+	}
+
+	request := EntityAliasRequest{
+		Name:          alias.Name,
+		CanonicalId:   alias.CanonicalId,
+		MountAccessor: alias.MountAccessor,
+	}
+
+	response := EntityAliasResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 EntityAliasAPI,
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: "create entity alias " + alias.Name,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response.Data.Id, err
+}
+
+// LookupEntity returns the identity entity named name with its aliases
+func (c *Client) LookupEntity(ctx context.Context, token string, name string) (types.Entity, error) {
+	response := EntityResponse{}
+	err := c.identityLookup(ctx, token, fmt.Sprintf(EntityByNameAPI, url.PathEscape(name)), "entity", name, &response)
+	return response.Data, err
+}
+
+// CreateGroup creates the internal identity group named group.Name, or replaces its policies, metadata and members
+// when it exists, and returns its id
+func (c *Client) CreateGroup(ctx context.Context, token string, group types.Group) (string, error) {
+	if group.Name == "" {
+		return "", pkg.NewErrSecretStore("group name cannot be empty")
+	}
+
+	request := GroupRequest{
+		Type:            internalGroupType,
+		Policies:        group.Policies,
+		Metadata:        group.Metadata,
+		MemberEntityIds: group.MemberEntityIds,
+		MemberGroupIds:  group.MemberGroupIds,
+	}
+
+	response := GroupResponse{}
+	status, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 fmt.Sprintf(GroupByNameAPI, url.PathEscape(group.Name)),
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: "create group " + group.Name,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if status == http.StatusNoContent {
+		existing, err := c.LookupGroup(ctx, token, group.Name)
+		return existing.Id, err
+	}
+
+	return response.Data.Id, err
+}
+
+// LookupGroup returns the identity group named name with its members
+func (c *Client) LookupGroup(ctx context.Context, token string, name string) (types.Group, error) {
+	response := GroupResponse{}
+	err := c.identityLookup(ctx, token, fmt.Sprintf(GroupByNameAPI, url.PathEscape(name)), "group", name, &response)
+	return response.Data, err
+}
+
+// AddGroupMember adds the entity with the id entityId to the members of the group named groupName
+func (c *Client) AddGroupMember(ctx context.Context, token string, groupName string, entityId string) error {
+	return c.updateGroupMembers(ctx, token, groupName, func(members []string) []string {
+		for _, member := range members {
+			if member == entityId {
+				return members
+			}
+		}
+		return append(members, entityId)
+	})
+}
+
+// RemoveGroupMember removes the entity with the id entityId from the members of the group named groupName
+func (c *Client) RemoveGroupMember(ctx context.Context, token string, groupName string, entityId string) error {
+	return c.updateGroupMembers(ctx, token, groupName, func(members []string) []string {
+		remaining := make([]string, 0, len(members))
+		for _, member := range members {
+			if member != entityId {
+				remaining = append(remaining, member)
+			}
+		}
+		return remaining
+	})
+}
+
+// updateGroupMembers replaces the member entities of the group with the result of update. The secret store has no
+// API to add or remove a single member, so concurrent updates of the same group may overwrite each other.
+func (c *Client) updateGroupMembers(ctx context.Context, token string, groupName string, update func(members []string) []string) error {
+	group, err := c.LookupGroup(ctx, token, groupName)
+	if err != nil {
+		return err
+	}
+
+	group.MemberEntityIds = update(group.MemberEntityIds)
+	_, err = c.CreateGroup(ctx, token, group)
+	return err
+}
+
+// identityLookup reads the identity object at apiPath, which the secret store reports as missing with no content
+func (c *Client) identityLookup(ctx context.Context, token string, apiPath string, kind string, name string, response interface{}) error {
+	if name == "" {
+		return pkg.NewErrSecretStore(kind + " name cannot be empty")
+	}
+
+	status, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 apiPath,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: fmt.Sprintf("lookup %s %s", kind, name),
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       response,
+	})
+	if status == http.StatusNoContent || status == http.StatusNotFound {
+		return pkg.NewErrSecretStore(fmt.Sprintf("No %s exists with the name: '%s'", kind, name))
+	}
+
+	return err
+}
@@ -0,0 +1,194 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockIdentityServer is a stub Vault identity secrets engine. Like Vault, it only returns the entity or group
+// when it is created and responds with no content when it is updated or not found.
+type mockIdentityServer struct {
+	mutex    sync.Mutex
+	nextId   int
+	entities map[string]*types.Entity
+	groups   map[string]*types.Group
+}
+
+func (m *mockIdentityServer) newId() string {
+	m.nextId++
+	return fmt.Sprintf("id-%d", m.nextId)
+}
+
+func (m *mockIdentityServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/identity/entity/name/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/identity/entity/name/")
+		entity, exists := m.entities[name]
+		if r.Method == http.MethodPost {
+			var request EntityRequest
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			if !exists {
+				entity = &types.Entity{Id: m.newId(), Name: name}
+				m.entities[name] = entity
+			}
+			entity.Policies, entity.Metadata, entity.Disabled = request.Policies, request.Metadata, request.Disabled
+		}
+		if !exists {
+			if r.Method == http.MethodPost {
+				_ = json.NewEncoder(w).Encode(EntityResponse{Data: *entity})
+				return
+			}
+		} else if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(EntityResponse{Data: *entity})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == EntityAliasAPI:
+		var request EntityAliasRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		for _, entity := range m.entities {
+			if entity.Id == request.CanonicalId {
+				alias := types.EntityAlias{Id: m.newId(), Name: request.Name, CanonicalId: entity.Id, MountAccessor: request.MountAccessor}
+				entity.Aliases = append(entity.Aliases, alias)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": alias.Id, "canonical_id": entity.Id}})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	case strings.HasPrefix(r.URL.Path, "/v1/identity/group/name/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/identity/group/name/")
+		group, exists := m.groups[name]
+		switch {
+		case r.Method == http.MethodGet && exists:
+			_ = json.NewEncoder(w).Encode(GroupResponse{Data: *group})
+		case r.Method == http.MethodPost:
+			var request GroupRequest
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			if request.Type != internalGroupType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if !exists {
+				group = &types.Group{Id: m.newId(), Name: name}
+				m.groups[name] = group
+			}
+			group.Policies, group.MemberEntityIds, group.MemberGroupIds = request.Policies, request.MemberEntityIds, request.MemberGroupIds
+			if !exists {
+				_ = json.NewEncoder(w).Encode(GroupResponse{Data: *group})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestIdentityEntities(t *testing.T) {
+	mock := &mockIdentityServer{entities: make(map[string]*types.Entity), groups: make(map[string]*types.Group)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	entity := types.Entity{Name: "core-data", Policies: []string{"edgex-service-core-data"}, Metadata: map[string]string{"service": "core-data"}}
+	id, err := client.CreateEntity(context.Background(), expectedToken, entity)
+	require.NoError(t, err)
+	assert.Equal(t, "id-1", id)
+
+	// updating an existing entity returns its id too
+	entity.Policies = append(entity.Policies, "edgex-common")
+	id, err = client.CreateEntity(context.Background(), expectedToken, entity)
+	require.NoError(t, err)
+	assert.Equal(t, "id-1", id)
+
+	for _, accessor := range []string{"auth_token_1234", "auth_jwt_5678"} {
+		_, err := client.CreateEntityAlias(context.Background(), expectedToken, types.EntityAlias{Name: "core-data", CanonicalId: id, MountAccessor: accessor})
+		require.NoError(t, err)
+	}
+	_, err = client.CreateEntityAlias(context.Background(), expectedToken, types.EntityAlias{Name: "core-data", CanonicalId: id})
+	require.Error(t, err)
+	_, err = client.CreateEntityAlias(context.Background(), expectedToken, types.EntityAlias{Name: "core-data", CanonicalId: "unknown", MountAccessor: "auth_cert_1"})
+	require.Error(t, err)
+
+	found, err := client.LookupEntity(context.Background(), expectedToken, "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"edgex-service-core-data", "edgex-common"}, found.Policies)
+	require.Len(t, found.Aliases, 2)
+	assert.Equal(t, "auth_jwt_5678", found.Aliases[1].MountAccessor)
+
+	_, err = client.LookupEntity(context.Background(), expectedToken, "unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No entity exists with the name: 'unknown'")
+	_, err = client.CreateEntity(context.Background(), expectedToken, types.Entity{})
+	require.Error(t, err)
+}
+
+func TestIdentityGroups(t *testing.T) {
+	mock := &mockIdentityServer{entities: make(map[string]*types.Entity), groups: make(map[string]*types.Group)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	groupId, err := client.CreateGroup(context.Background(), expectedToken, types.Group{Name: "edgex-core", Policies: []string{"edgex-core"}})
+	require.NoError(t, err)
+	id, err := client.CreateGroup(context.Background(), expectedToken, types.Group{Name: "edgex-core", Policies: []string{"edgex-core"}})
+	require.NoError(t, err)
+	assert.Equal(t, groupId, id)
+
+	require.NoError(t, client.AddGroupMember(context.Background(), expectedToken, "edgex-core", "entity-1"))
+	require.NoError(t, client.AddGroupMember(context.Background(), expectedToken, "edgex-core", "entity-2"))
+	require.NoError(t, client.AddGroupMember(context.Background(), expectedToken, "edgex-core", "entity-1"))
+
+	group, err := client.LookupGroup(context.Background(), expectedToken, "edgex-core")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"entity-1", "entity-2"}, group.MemberEntityIds)
+	assert.Equal(t, []string{"edgex-core"}, group.Policies, "the group policies are expected to be kept")
+
+	require.NoError(t, client.RemoveGroupMember(context.Background(), expectedToken, "edgex-core", "entity-1"))
+	group, err = client.LookupGroup(context.Background(), expectedToken, "edgex-core")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"entity-2"}, group.MemberEntityIds)
+
+	require.Error(t, client.AddGroupMember(context.Background(), expectedToken, "unknown", "entity-1"))
+	_, err = client.CreateGroup(context.Background(), expectedToken, types.Group{})
+	require.Error(t, err)
+}
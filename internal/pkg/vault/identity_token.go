@@ -0,0 +1,96 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// CreateIdentityTokenKey creates or updates the named key which signs identity tokens
+func (c *Client) CreateIdentityTokenKey(ctx context.Context, token string, keyName string, key types.IdentityTokenKey) error {
+	if keyName == "" {
+		return pkg.NewErrSecretStore("identity token key name cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(IdentityTokenKeyAPI, url.PathEscape(keyName)), key,
+		"create identity token key "+keyName)
+}
+
+// CreateIdentityTokenRole creates or updates the named role which identity tokens are generated for
+func (c *Client) CreateIdentityTokenRole(ctx context.Context, token string, roleName string, role types.IdentityTokenRole) error {
+	if roleName == "" || role.Key == "" {
+		return pkg.NewErrSecretStore("identity token role name and key cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(IdentityTokenRoleAPI, url.PathEscape(roleName)), role,
+		"create identity token role "+roleName)
+}
+
+// GetIdentityToken generates an identity token of the client token's entity for the role. The client's token must
+// belong to an entity, tokens created directly from the token auth method don't.
+func (c *Client) GetIdentityToken(ctx context.Context, role string) (types.IdentityToken, error) {
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return types.IdentityToken{}, pkg.NewErrSecretStore("role cannot be empty for getting an identity token")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodGet, fmt.Sprintf(IdentityTokenAPI, url.PathEscape(role)), nil)
+	if err != nil {
+		return types.IdentityToken{}, err
+	}
+
+	var response IdentityTokenResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.IdentityToken{}, err
+	}
+
+	return types.IdentityToken{
+		Token:    response.Data.Token.Reveal(),
+		ClientId: response.Data.ClientId,
+		TTL:      time.Duration(response.Data.TTL) * time.Second,
+	}, nil
+}
+
+// VerifyIdentityToken verifies the signature, expiry and, when clientId isn't empty, the audience of the identity
+// token using the client's token. An invalid token is reported as false without an error.
+func (c *Client) VerifyIdentityToken(ctx context.Context, token string, clientId string) (bool, error) {
+	if token == "" {
+		return false, pkg.NewErrSecretStore("identity token cannot be empty for verifying")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodPost, IdentityIntrospectAPI, IntrospectRequest{Token: types.SecretString(token), ClientId: clientId})
+	if err != nil {
+		return false, err
+	}
+
+	var response IntrospectResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return false, err
+	}
+
+	if !response.Active {
+		c.lc.Debugf("identity token is not valid: %s", response.Error)
+	}
+	return response.Active, nil
+}
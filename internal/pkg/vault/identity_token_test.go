@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockIdentityTokenServer is a stub Vault OIDC identity token provider. Its tokens are the role's client id
+// followed by the role name.
+type mockIdentityTokenServer struct {
+	mutex sync.Mutex
+	keys  map[string]types.IdentityTokenKey
+	roles map[string]types.IdentityTokenRole
+}
+
+func (m *mockIdentityTokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/identity/oidc/key/"):
+		var key types.IdentityTokenKey
+		_ = json.NewDecoder(r.Body).Decode(&key)
+		m.keys[strings.TrimPrefix(r.URL.Path, "/v1/identity/oidc/key/")] = key
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/identity/oidc/role/"):
+		var role types.IdentityTokenRole
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		if _, ok := m.keys[role.Key]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		role.ClientId = "client-" + role.Key
+		m.roles[strings.TrimPrefix(r.URL.Path, "/v1/identity/oidc/role/")] = role
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/identity/oidc/token/"):
+		name := strings.TrimPrefix(r.URL.Path, "/v1/identity/oidc/token/")
+		role, ok := m.roles[name]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"role " + name + " not found"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"token": role.ClientId + "." + name, "client_id": role.ClientId, "ttl": role.TTL},
+		})
+	case r.URL.Path == IdentityIntrospectAPI:
+		var request IntrospectRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		response := IntrospectResponse{Active: strings.HasPrefix(request.Token.Reveal(), request.ClientId)}
+		if !response.Active {
+			response.Error = "invalid audience"
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestIdentityTokens(t *testing.T) {
+	mock := &mockIdentityTokenServer{keys: make(map[string]types.IdentityTokenKey), roles: make(map[string]types.IdentityTokenRole)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	ctx := context.Background()
+
+	key := types.IdentityTokenKey{Algorithm: "ES256", RotationPeriod: 86400, AllowedClientIds: []string{"*"}}
+	require.NoError(t, client.CreateIdentityTokenKey(context.Background(), expectedToken, "edgex", key))
+	assert.Equal(t, key, mock.keys["edgex"])
+	require.NoError(t, client.CreateIdentityTokenRole(context.Background(), expectedToken, "core-data", types.IdentityTokenRole{Key: "edgex", TTL: 300}))
+	require.Error(t, client.CreateIdentityTokenRole(context.Background(), expectedToken, "core-data", types.IdentityTokenRole{}))
+	require.Error(t, client.CreateIdentityTokenKey(context.Background(), expectedToken, "", key))
+
+	token, err := client.GetIdentityToken(ctx, "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, types.IdentityToken{Token: "client-edgex.core-data", ClientId: "client-edgex", TTL: 5 * time.Minute}, token)
+
+	valid, err := client.VerifyIdentityToken(ctx, token.Token, token.ClientId)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = client.VerifyIdentityToken(ctx, token.Token, "client-other")
+	require.NoError(t, err)
+	assert.False(t, valid)
+	_, err = client.VerifyIdentityToken(ctx, "", "")
+	require.Error(t, err)
+
+	_, err = client.GetIdentityToken(ctx, "unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,391 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	kvDataSegment     = "data"
+	kvMetadataSegment = "metadata"
+
+	// mergePatchContentType is required by the KV v2 engine for PATCH requests
+	mergePatchContentType = "application/merge-patch+json"
+	// casMismatchError is reported by the KV v2 engine when the cas option isn't the current version
+	casMismatchError = "check-and-set parameter did not match the current version"
+)
+
+// GetSecretVersion retrieves the given version of the KV v2 secret at the provided sub-path along with the
+// version's metadata. Version 0 retrieves the latest version.
+func (c *Client) GetSecretVersion(ctx context.Context, subPath string, version int) (types.SecretVersion, error) {
+	secretVersion, found, err := c.secretVersion(ctx, subPath, version)
+	if err == nil && !found {
+		err = pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	return secretVersion, err
+}
+
+// secretVersion reads the version of the KV v2 secret, returning false when the version doesn't exist. A deleted
+// or destroyed version is found with only its metadata.
+func (c *Client) secretVersion(ctx context.Context, subPath string, version int) (types.SecretVersion, bool, error) {
+	if version < 0 {
+		return types.SecretVersion{}, false, pkg.NewErrSecretStore(fmt.Sprintf("invalid secret version %d", version))
+	}
+
+	kvPath, err := c.kvV2Path(kvDataSegment, subPath)
+	if err != nil {
+		return types.SecretVersion{}, false, err
+	}
+	if version > 0 {
+		kvPath += "?version=" + strconv.Itoa(version)
+	}
+
+	var response struct {
+		Data struct {
+			Data     map[string]interface{}      `json:"data"`
+			Metadata types.SecretVersionMetadata `json:"metadata"`
+		} `json:"data"`
+	}
+	statusCode, body, err := c.kvRequest(ctx, http.MethodGet, kvPath, nil)
+	if err != nil && statusCode != http.StatusNotFound {
+		return types.SecretVersion{}, false, err
+	}
+	if len(body) > 0 {
+		if jsonErr := json.Unmarshal(body, &response); jsonErr != nil && err == nil {
+			return types.SecretVersion{}, false, jsonErr
+		}
+	}
+
+	metadata := response.Data.Metadata
+	if statusCode == http.StatusNotFound {
+		// a deleted or destroyed version is reported as not found, but still includes its metadata
+		if metadata.Version == 0 || (metadata.DeletionTime == "" && !metadata.Destroyed) {
+			return types.SecretVersion{}, false, nil
+		}
+		return types.SecretVersion{Metadata: metadata}, true, nil
+	}
+
+	data := make(map[string]string, len(response.Data.Data))
+	for k, v := range response.Data.Data {
+		value, ok := v.(string)
+		if !ok {
+			return types.SecretVersion{}, false, pkg.NewErrSecretStore(fmt.Sprintf("secret value of key '%s' is not a string", k))
+		}
+		data[k] = value
+	}
+
+	return types.SecretVersion{Data: data, Metadata: metadata}, true, nil
+}
+
+// StoreSecretsCAS stores the secrets as a new version of the KV v2 secret at the provided sub-path, provided cas is
+// the current version of the secret. A cas of 0 only stores the secrets when the secret does not exist yet.
+// The metadata of the new version is returned, or an ErrSecretVersionConflict when the secret is at another version.
+func (c *Client) StoreSecretsCAS(ctx context.Context, subPath string, secrets map[string]string, cas int) (types.SecretVersionMetadata, error) {
+	metadata, err := c.storeSecretsCAS(ctx, subPath, secrets, cas)
+	if err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+	c.updateCallbacks.Notify(subPath)
+	return metadata, nil
+}
+
+// storeSecretsCAS stores the secrets like StoreSecretsCAS without notifying the callbacks
+func (c *Client) storeSecretsCAS(ctx context.Context, subPath string, secrets map[string]string, cas int) (types.SecretVersionMetadata, error) {
+	if len(secrets) == 0 {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretStore("no secrets to store")
+	}
+	if cas < 0 {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretStore(fmt.Sprintf("invalid check-and-set version %d", cas))
+	}
+
+	kvPath, err := c.kvV2Path(kvDataSegment, subPath)
+	if err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+
+	request := map[string]interface{}{
+		"options": map[string]int{"cas": cas},
+		"data":    secrets,
+	}
+	statusCode, body, err := c.kvRequest(ctx, http.MethodPost, kvPath, request)
+	if statusCode == http.StatusBadRequest && strings.Contains(string(body), casMismatchError) {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretVersionConflict(subPath, cas)
+	}
+	if err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+
+	var response struct {
+		Data types.SecretVersionMetadata `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+
+	return response.Data, nil
+}
+
+// PatchSecrets merges the secrets into the latest version of the KV v2 secret at the provided sub-path, storing the
+// result as a new version. Keys which are not in secrets keep their current value, unlike StoreSecrets which replaces
+// the whole secret. The secret must already exist. The metadata of the new version is returned.
+func (c *Client) PatchSecrets(ctx context.Context, subPath string, secrets map[string]string) (types.SecretVersionMetadata, error) {
+	if len(secrets) == 0 {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretStore("no secrets to patch")
+	}
+
+	kvPath, err := c.kvV2Path(kvDataSegment, subPath)
+	if err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+
+	request := map[string]interface{}{
+		"data": secrets,
+	}
+	statusCode, body, err := c.kvRequest(ctx, http.MethodPatch, kvPath, request)
+	if statusCode == http.StatusNotFound {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	if err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+	c.updateCallbacks.Notify(subPath)
+
+	var response struct {
+		Data types.SecretVersionMetadata `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.SecretVersionMetadata{}, err
+	}
+
+	return response.Data, nil
+}
+
+// StoreSecretsMulti stores the secrets of several sub-paths, keyed by sub-path, as new versions of the KV v2 secrets.
+// Each write is a check-and-set against the version recorded before writing. When a write fails the sub-paths
+// already written are rolled back on a best effort basis, restoring the recorded version's secrets or deleting the
+// new version of a secret which didn't exist. The returned error reports the failed write and any failed rollback.
+// The update callbacks are notified once every write succeeded, so a write which is rolled back notifies nothing;
+// only the sub-paths which failed to roll back are notified after a failed write, as they were left changed.
+func (c *Client) StoreSecretsMulti(ctx context.Context, secrets map[string]map[string]string) error {
+	subPaths := make([]string, 0, len(secrets))
+	for subPath := range secrets {
+		subPaths = append(subPaths, subPath)
+	}
+	sort.Strings(subPaths)
+
+	previous := make(map[string]types.SecretVersion, len(subPaths))
+	for _, subPath := range subPaths {
+		version, _, err := c.secretVersion(ctx, subPath, 0)
+		if err != nil {
+			return err
+		}
+		previous[subPath] = version
+	}
+
+	var written []string
+	writtenVersions := make(map[string]types.SecretVersionMetadata, len(subPaths))
+	for _, subPath := range subPaths {
+		metadata, err := c.storeSecretsCAS(ctx, subPath, secrets[subPath], previous[subPath].Metadata.Version)
+		if err == nil {
+			written = append(written, subPath)
+			writtenVersions[subPath] = metadata
+			continue
+		}
+
+		c.lc.Errorf("failed to store secrets at '%s', rolling back %d written sub-paths: %s", subPath, len(written), err.Error())
+		var rollbackFailures []string
+		for index := len(written) - 1; index >= 0; index-- {
+			rolledBack := written[index]
+			if rollbackErr := c.rollbackSecret(rolledBack, previous[rolledBack], writtenVersions[rolledBack]); rollbackErr != nil {
+				rollbackFailures = append(rollbackFailures, fmt.Sprintf("'%s': %s", rolledBack, rollbackErr.Error()))
+				c.updateCallbacks.Notify(rolledBack)
+			}
+		}
+		if len(rollbackFailures) > 0 {
+			return pkg.NewErrSecretStore(fmt.Sprintf("failed to store secrets at '%s': %s, and failed to roll back %s",
+				subPath, err.Error(), strings.Join(rollbackFailures, "; ")))
+		}
+		return err
+	}
+
+	for _, subPath := range written {
+		c.updateCallbacks.Notify(subPath)
+	}
+	return nil
+}
+
+// rollbackSecret restores the previous version of the secret at the sub-path, provided the secret is still at the
+// version written. The rollback isn't cancelled with the context of the failed write, which may be why it failed.
+func (c *Client) rollbackSecret(subPath string, previous types.SecretVersion, written types.SecretVersionMetadata) error {
+	if previous.Data != nil {
+		_, err := c.storeSecretsCAS(context.Background(), subPath, previous.Data, written.Version)
+		return err
+	}
+
+	// the secret didn't exist or its latest version was deleted, so delete the version written
+	kvPath, err := c.kvV2Path(kvDataSegment, subPath)
+	if err != nil {
+		return err
+	}
+	_, _, err = c.kvRequest(context.Background(), http.MethodDelete, kvPath, nil)
+	return err
+}
+
+// kvV2Path returns the API path of the sub-path in the KV v2 data or metadata segment of the KVMount
+func (c *Client) kvV2Path(segment string, subPath string) (string, error) {
+	fullPath := strings.TrimPrefix(path.Join("/", c.Config.Path, subPath), "/v1/")
+
+	mount := strings.Trim(c.Config.KVMount, "/")
+	if mount == "" {
+		mount = strings.SplitN(fullPath, "/", 2)[0]
+	}
+
+	secretPath := strings.TrimPrefix(fullPath, mount+"/")
+	if secretPath == fullPath || secretPath == "" {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("secret path '%s' is not a secret in the KV mount '%s'", fullPath, mount))
+	}
+
+	return "/v1/" + mount + "/" + segment + "/" + secretPath, nil
+}
+
+// kvRequest makes a request to the KV secrets engine with the client's token, returning the status code and body.
+// An error is returned for non 2xx responses, which includes the errors reported by the secret store.
+func (c *Client) kvRequest(ctx context.Context, method string, apiPath string, request interface{}) (int, []byte, error) {
+	url, err := c.Config.BuildURL(apiPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var bodyReader io.Reader
+	if request != nil {
+		payload, err := json.Marshal(request)
+		if err != nil {
+			return 0, nil, err
+		}
+		bodyReader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	c.setTokenHeader(req)
+	c.setNamespaceHeader(req)
+	if method == http.MethodPatch {
+		req.Header.Set("Content-Type", mergePatchContentType)
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		vaultErrors := readErrors(bytes.NewReader(body))
+		return resp.StatusCode, body, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from the secret store: %s",
+			resp.StatusCode, strings.Join(vaultErrors, "; ")), resp.StatusCode, vaultErrors)
+	}
+
+	c.handleWarnings(apiPath, body)
+	return resp.StatusCode, body, nil
+}
+
+// GetSecretMetadata retrieves the metadata of the KV v2 secret at the provided sub-path, including the metadata of
+// every version
+func (c *Client) GetSecretMetadata(ctx context.Context, subPath string) (types.SecretMetadata, error) {
+	kvPath, err := c.kvV2Path(kvMetadataSegment, subPath)
+	if err != nil {
+		return types.SecretMetadata{}, err
+	}
+
+	statusCode, body, err := c.kvRequest(ctx, http.MethodGet, kvPath, nil)
+	if statusCode == http.StatusNotFound {
+		return types.SecretMetadata{}, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	if err != nil {
+		return types.SecretMetadata{}, err
+	}
+
+	var response struct {
+		Data types.SecretMetadata `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.SecretMetadata{}, err
+	}
+
+	// the version number is only the key of the versions map
+	for version, metadata := range response.Data.Versions {
+		metadata.Version = version
+		response.Data.Versions[version] = metadata
+	}
+
+	return response.Data, nil
+}
+
+// UpdateSecretMetadata updates the metadata of the KV v2 secret at the provided sub-path, which is created when the
+// secret does not exist yet
+func (c *Client) UpdateSecretMetadata(ctx context.Context, subPath string, update types.SecretMetadataUpdate) error {
+	kvPath, err := c.kvV2Path(kvMetadataSegment, subPath)
+	if err != nil {
+		return err
+	}
+
+	request := make(map[string]interface{})
+	if update.MaxVersions != nil {
+		if *update.MaxVersions < 0 {
+			return pkg.NewErrSecretStore("MaxVersions can't be negative")
+		}
+		request["max_versions"] = *update.MaxVersions
+	}
+	if update.CasRequired != nil {
+		request["cas_required"] = *update.CasRequired
+	}
+	if update.DeleteVersionAfter != nil {
+		request["delete_version_after"] = update.DeleteVersionAfter.String()
+	}
+	if update.CustomMetadata != nil {
+		request["custom_metadata"] = update.CustomMetadata
+	}
+	if len(request) == 0 {
+		// nothing to update
+		return nil
+	}
+
+	_, _, err = c.kvRequest(ctx, http.MethodPost, kvPath, request)
+	return err
+}
@@ -0,0 +1,456 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const kvTestToken = "kv-token"
+
+// mockKVServer is a stub KV v2 secrets engine mounted at "secret", keeping every version of the secrets in memory
+type mockKVServer struct {
+	mutex   sync.Mutex
+	secrets map[string][]types.SecretVersion
+	// metadata holds the settings written to the metadata of each secret
+	metadata map[string]map[string]interface{}
+	// rejectWrites is a secret path whose writes are refused
+	rejectWrites string
+}
+
+func newMockKVServer() *mockKVServer {
+	return &mockKVServer{
+		secrets:  make(map[string][]types.SecretVersion),
+		metadata: make(map[string]map[string]interface{}),
+	}
+}
+
+// put stores data as a new version of the secret at secretPath, returning its metadata
+func (m *mockKVServer) put(secretPath string, data map[string]string) types.SecretVersionMetadata {
+	metadata := types.SecretVersionMetadata{
+		Version:     len(m.secrets[secretPath]) + 1,
+		CreatedTime: time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	m.secrets[secretPath] = append(m.secrets[secretPath], types.SecretVersion{Data: data, Metadata: metadata})
+	return metadata
+}
+
+func (m *mockKVServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != kvTestToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	writeErrors := func(statusCode int, errors ...string) {
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": errors})
+	}
+
+	if strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/") {
+		m.serveMetadata(w, r, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, "/v1/secret/data/") {
+		writeErrors(http.StatusNotFound)
+		return
+	}
+	secretPath := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+	versions := m.secrets[secretPath]
+
+	switch r.Method {
+	case http.MethodGet:
+		index := len(versions) - 1
+		if query := r.URL.Query().Get("version"); query != "" {
+			version, _ := strconv.Atoi(query)
+			index = version - 1
+		}
+		if index < 0 || index >= len(versions) {
+			writeErrors(http.StatusNotFound)
+			return
+		}
+		version := versions[index]
+		statusCode := http.StatusOK
+		if version.Data == nil {
+			statusCode = http.StatusNotFound
+		}
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"data": version.Data, "metadata": version.Metadata}})
+	case http.MethodPost:
+		if secretPath == m.rejectWrites {
+			writeErrors(http.StatusForbidden, "permission denied")
+			return
+		}
+		var request struct {
+			Options map[string]int    `json:"options"`
+			Data    map[string]string `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if cas, ok := request.Options["cas"]; ok && cas != len(versions) {
+			writeErrors(http.StatusBadRequest, "check-and-set parameter did not match the current version")
+			return
+		}
+		metadata := m.put(secretPath, request.Data)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": metadata})
+	case http.MethodDelete:
+		if len(versions) > 0 {
+			versions[len(versions)-1].Data = nil
+			versions[len(versions)-1].Metadata.DeletionTime = time.Now().UTC().Format(time.RFC3339Nano)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPatch:
+		if r.Header.Get("Content-Type") != mergePatchContentType {
+			writeErrors(http.StatusUnsupportedMediaType)
+			return
+		}
+		if len(versions) == 0 || versions[len(versions)-1].Data == nil {
+			writeErrors(http.StatusNotFound)
+			return
+		}
+		var request struct {
+			Data map[string]string `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		merged := make(map[string]string)
+		for k, v := range versions[len(versions)-1].Data {
+			merged[k] = v
+		}
+		for k, v := range request.Data {
+			merged[k] = v
+		}
+		metadata := m.put(secretPath, merged)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": metadata})
+	default:
+		writeErrors(http.StatusMethodNotAllowed)
+	}
+}
+
+func (m *mockKVServer) serveMetadata(w http.ResponseWriter, r *http.Request, secretPath string) {
+	settings, exists := m.metadata[secretPath]
+	versions := m.secrets[secretPath]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists && len(versions) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data := map[string]interface{}{
+			"current_version":      len(versions),
+			"oldest_version":       1,
+			"max_versions":         0,
+			"cas_required":         false,
+			"delete_version_after": "0s",
+		}
+		for key, value := range settings {
+			data[key] = value
+		}
+		versionMetadata := make(map[string]interface{})
+		for _, version := range versions {
+			versionMetadata[strconv.Itoa(version.Metadata.Version)] = map[string]interface{}{
+				"created_time": version.Metadata.CreatedTime, "deletion_time": version.Metadata.DeletionTime,
+				"destroyed": version.Metadata.Destroyed}
+		}
+		data["versions"] = versionMetadata
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	case http.MethodPost:
+		if !exists {
+			settings = make(map[string]interface{})
+			m.metadata[secretPath] = settings
+		}
+		update := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&update)
+		for key, value := range update {
+			settings[key] = value
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newKVTestClient(t *testing.T, server *httptest.Server) *Client {
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host, port, _ := net.SplitHostPort(serverURL.Host)
+	portNum, _ := strconv.Atoi(port)
+
+	config := types.SecretConfig{
+		Protocol: "http",
+		Host:     host,
+		Port:     portNum,
+		Path:     "/v1/secret/edgex/core-data/",
+		Authentication: types.AuthenticationInfo{
+			AuthType:  AuthTypeHeader,
+			AuthToken: kvTestToken,
+		},
+	}
+
+	client, err := NewClient(config, WithHTTPClient(http.DefaultClient), WithLogger(logger.NewMockClient()), WithAuthTokenRequired())
+	require.NoError(t, err)
+	return client
+}
+
+func TestKVv2Path(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		mount       string
+		subPath     string
+		expected    string
+		expectError bool
+	}{
+		{"Default mount", "/v1/secret/edgex/core-data/", "", "redisdb", "/v1/secret/data/edgex/core-data/redisdb", false},
+		{"Sub-path with slashes", "/v1/secret/edgex/core-data", "", "/redisdb/", "/v1/secret/data/edgex/core-data/redisdb", false},
+		{"Nested mount", "/v1/edgex/kv/core-data/", "/edgex/kv/", "redisdb", "/v1/edgex/kv/data/core-data/redisdb", false},
+		{"Invalid - path outside mount", "/v1/secret/edgex/", "kv", "redisdb", "", true},
+		{"Invalid - mount only", "/v1/secret/", "", "", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := Client{Config: types.SecretConfig{Path: test.path, KVMount: test.mount}}
+			actual, err := client.kvV2Path(kvDataSegment, test.subPath)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSecretVersions(t *testing.T) {
+	mock := newMockKVServer()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := newKVTestClient(t, server)
+	ctx := context.Background()
+
+	// a cas of 0 only creates the secret
+	metadata, err := client.StoreSecretsCAS(ctx, "redisdb", map[string]string{"password": "first"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, metadata.Version)
+	assert.NotEmpty(t, metadata.CreatedTime)
+
+	_, err = client.StoreSecretsCAS(ctx, "redisdb", map[string]string{"password": "clobbered"}, 0)
+	require.Error(t, err)
+	require.IsType(t, pkg.ErrSecretVersionConflict{}, err)
+	assert.Equal(t, 0, err.(pkg.ErrSecretVersionConflict).ExpectedVersion())
+
+	metadata, err = client.StoreSecretsCAS(ctx, "redisdb", map[string]string{"password": "second"}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, metadata.Version)
+
+	latest, err := client.GetSecretVersion(ctx, "redisdb", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "second"}, latest.Data)
+	assert.Equal(t, 2, latest.Metadata.Version)
+
+	first, err := client.GetSecretVersion(ctx, "redisdb", 1)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "first"}, first.Data)
+
+	// a deleted version still reports its metadata
+	mock.mutex.Lock()
+	mock.secrets["edgex/core-data/redisdb"][0].Data = nil
+	mock.secrets["edgex/core-data/redisdb"][0].Metadata.DeletionTime = time.Now().UTC().Format(time.RFC3339Nano)
+	mock.mutex.Unlock()
+	deleted, err := client.GetSecretVersion(ctx, "redisdb", 1)
+	require.NoError(t, err)
+	assert.Nil(t, deleted.Data)
+	assert.NotEmpty(t, deleted.Metadata.DeletionTime)
+
+	_, err = client.GetSecretVersion(ctx, "redisdb", 3)
+	require.Error(t, err)
+	_, err = client.GetSecretVersion(ctx, "unknown", 0)
+	require.Error(t, err)
+	_, err = client.GetSecretVersion(ctx, "redisdb", -1)
+	require.Error(t, err)
+	_, err = client.StoreSecretsCAS(ctx, "redisdb", nil, 2)
+	require.Error(t, err)
+}
+
+func TestPatchSecrets(t *testing.T) {
+	mock := newMockKVServer()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := newKVTestClient(t, server)
+	ctx := context.Background()
+
+	_, err := client.PatchSecrets(ctx, "redisdb", map[string]string{"password": "patched"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No secret exists")
+
+	mock.mutex.Lock()
+	mock.put("edgex/core-data/redisdb", map[string]string{"username": "redis5", "password": "first"})
+	mock.mutex.Unlock()
+
+	metadata, err := client.PatchSecrets(ctx, "redisdb", map[string]string{"password": "patched", "token": "added"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, metadata.Version)
+
+	latest, err := client.GetSecretVersion(ctx, "redisdb", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis5", "password": "patched", "token": "added"}, latest.Data)
+
+	_, err = client.PatchSecrets(ctx, "redisdb", nil)
+	require.Error(t, err)
+}
+
+func TestKVSecretUpdatedCallback(t *testing.T) {
+	mock := newMockKVServer()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := newKVTestClient(t, server)
+	ctx := context.Background()
+
+	updates := 0
+	client.RegisterSecretUpdatedCallback("redisdb", func(string) { updates++ })
+
+	_, err := client.StoreSecretsCAS(ctx, "redisdb", map[string]string{"password": "first"}, 0)
+	require.NoError(t, err)
+	_, err = client.PatchSecrets(ctx, "redisdb", map[string]string{"password": "patched"})
+	require.NoError(t, err)
+	_, err = client.StoreSecretsCAS(ctx, "redisdb", map[string]string{"password": "conflict"}, 0)
+	require.Error(t, err)
+
+	assert.Equal(t, 2, updates)
+}
+
+func TestStoreSecretsMulti(t *testing.T) {
+	mock := newMockKVServer()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := newKVTestClient(t, server)
+	ctx := context.Background()
+
+	mock.mutex.Lock()
+	mock.put("edgex/core-data/redisdb", map[string]string{"password": "original"})
+	mock.mutex.Unlock()
+
+	var updated []string
+	for _, subPath := range []string{"redisdb", "mqtt", "modbus", "zigbee"} {
+		client.RegisterSecretUpdatedCallback(subPath, func(subPath string) {
+			// the callbacks are only notified once every sub-path is written
+			latest, err := client.GetSecretVersion(ctx, "redisdb", 0)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "seeded"}, latest.Data)
+			updated = append(updated, subPath)
+		})
+	}
+
+	err := client.StoreSecretsMulti(ctx, map[string]map[string]string{
+		"redisdb": {"password": "seeded"},
+		"mqtt":    {"password": "seeded"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt", "redisdb"}, updated)
+	updated = nil
+	latest, err := client.GetSecretVersion(ctx, "redisdb", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "seeded"}, latest.Data)
+	assert.Equal(t, 2, latest.Metadata.Version)
+
+	// the failed write to "zigbee" rolls back the sub-paths written before it
+	mock.mutex.Lock()
+	mock.rejectWrites = "edgex/core-data/zigbee"
+	mock.mutex.Unlock()
+	err = client.StoreSecretsMulti(ctx, map[string]map[string]string{
+		"redisdb": {"password": "partial"},
+		"modbus":  {"password": "partial"},
+		"zigbee":  {"password": "partial"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+	assert.Empty(t, updated, "rolled back writes must not notify")
+
+	latest, err = client.GetSecretVersion(ctx, "redisdb", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "seeded"}, latest.Data)
+	assert.Equal(t, 4, latest.Metadata.Version)
+
+	created, err := client.GetSecretVersion(ctx, "modbus", 0)
+	require.NoError(t, err)
+	assert.Nil(t, created.Data)
+	assert.NotEmpty(t, created.Metadata.DeletionTime)
+}
+
+func TestSecretMetadata(t *testing.T) {
+	mock := newMockKVServer()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := newKVTestClient(t, server)
+	ctx := context.Background()
+
+	_, err := client.GetSecretMetadata(ctx, "redisdb")
+	require.Error(t, err)
+
+	mock.put("edgex/core-data/redisdb", map[string]string{"password": "first"})
+	mock.put("edgex/core-data/redisdb", map[string]string{"password": "second"})
+
+	maxVersions := 5
+	deleteAfter := 30 * 24 * time.Hour
+	err = client.UpdateSecretMetadata(ctx, "redisdb", types.SecretMetadataUpdate{
+		MaxVersions:        &maxVersions,
+		DeleteVersionAfter: &deleteAfter,
+		CustomMetadata:     map[string]string{"owner": "core-data", "rotation": "monthly"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "720h0m0s", mock.metadata["edgex/core-data/redisdb"]["delete_version_after"])
+
+	metadata, err := client.GetSecretMetadata(ctx, "redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, 2, metadata.CurrentVersion)
+	assert.Equal(t, 5, metadata.MaxVersions)
+	assert.Equal(t, "720h0m0s", metadata.DeleteVersionAfter)
+	assert.Equal(t, map[string]string{"owner": "core-data", "rotation": "monthly"}, metadata.CustomMetadata)
+	require.Len(t, metadata.Versions, 2)
+	assert.Equal(t, 2, metadata.Versions[2].Version)
+	assert.NotEmpty(t, metadata.Versions[1].CreatedTime)
+
+	// an empty update changes nothing
+	require.NoError(t, client.UpdateSecretMetadata(ctx, "redisdb", types.SecretMetadataUpdate{}))
+
+	negative := -1
+	require.Error(t, client.UpdateSecretMetadata(ctx, "redisdb", types.SecretMetadataUpdate{MaxVersions: &negative}))
+}
@@ -0,0 +1,104 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"path"
+	"strings"
+)
+
+// secretsKVVersion returns the version of the KV secrets engine holding Path, either 1 or 2. The version comes from
+// the KVVersion config, or is detected from the mount on first use when it is "auto". A mount of another engine,
+// i.e. a cubbyhole, is read and written like a KV v1 mount.
+func (c *Client) secretsKVVersion(ctx context.Context) (int, error) {
+	if c.Config.KVVersion != KVVersionAuto {
+		if c.Config.KVVersion == KVVersion2 {
+			return 2, nil
+		}
+		return 1, nil
+	}
+
+	c.kvVersionMutex.Lock()
+	defer c.kvVersionMutex.Unlock()
+
+	if c.kvVersion != 0 {
+		return c.kvVersion, nil
+	}
+
+	mountPoint := c.Config.KVMount
+	if mountPoint == "" {
+		mountPoint = c.Config.Path
+	}
+	info, err := c.GetMountInfo(ctx, mountPoint)
+	if err != nil {
+		// detection is attempted again on the next call
+		return 0, err
+	}
+
+	c.kvVersion = info.KVVersion
+	if c.kvVersion != 2 {
+		c.kvVersion = 1
+	}
+	c.lc.Infof("detected KV v%d secrets engine at mount '%s'", c.kvVersion, info.Path)
+
+	return c.kvVersion, nil
+}
+
+// secretsURL returns the URL GetSecrets and StoreSecrets use for the sub-path. For a KV v2 mount it is the URL of
+// the path in the data segment and the returned bool is true.
+func (c *Client) secretsURL(ctx context.Context, subPath string) (string, bool, error) {
+	version, err := c.secretsKVVersion(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	if version != 2 {
+		url, err := c.Config.BuildSecretsPathURL(subPath)
+		return url, false, err
+	}
+
+	apiPath, err := c.kvV2Path(kvDataSegment, subPath)
+	if err != nil {
+		return "", false, err
+	}
+	url, err := c.Config.BuildURL(apiPath)
+	return url, true, err
+}
+
+// listPath returns the API path ListSecretPaths lists for the sub-path, which is in the metadata segment for a KV
+// v2 mount. Unlike the secrets, the root of the mount can be listed.
+func (c *Client) listPath(ctx context.Context, subPath string) (string, error) {
+	version, err := c.secretsKVVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if version != 2 {
+		return c.Config.Path + subPath, nil
+	}
+
+	fullPath := strings.Trim(strings.TrimPrefix(path.Join("/", c.Config.Path, subPath), "/v1/"), "/")
+	mount := strings.Trim(c.Config.KVMount, "/")
+	if mount == "" {
+		mount = strings.SplitN(fullPath, "/", 2)[0]
+	}
+
+	secretPath := ""
+	if fullPath != mount {
+		secretPath = strings.TrimPrefix(fullPath, mount+"/")
+	}
+	return "/v1/" + mount + "/" + kvMetadataSegment + "/" + secretPath, nil
+}
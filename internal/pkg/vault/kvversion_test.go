@@ -0,0 +1,126 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockKVv2Vault is a stub Vault with a KV v2 secrets engine mounted at "secret", keeping the latest version of
+// each secret. Paths outside the data and metadata segments are rejected, as a KV v2 mount does.
+type mockKVv2Vault struct {
+	mutex          sync.Mutex
+	secrets        map[string]map[string]string
+	mountInfoCalls int
+}
+
+func (m *mockKVv2Vault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.URL.Path == MountInfoAPI+"/secret/edgex/core-data" {
+		m.mountInfoCalls++
+		_, _ = w.Write([]byte(`{"data":{"path":"secret/","type":"kv","options":{"version":"2"}}}`))
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/") && r.Method == http.MethodGet:
+		data, ok := m.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": data}})
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/") && r.Method == http.MethodPost:
+		var request struct {
+			Data map[string]string `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")] = request.Data
+		_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/") && r.Method == "LIST":
+		prefix := strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")
+		var names []string
+		for name := range m.secrets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		keys := pkg.ChildKeys(strings.Trim(prefix, "/"), names)
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string][]string{"keys": keys}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[],"warnings":["Invalid path for a versioned K/V secrets engine."]}`))
+	}
+}
+
+func TestKVv2Secrets(t *testing.T) {
+	for _, version := range []string{KVVersion2, KVVersionAuto} {
+		t.Run(version, func(t *testing.T) {
+			vault := &mockKVv2Vault{secrets: map[string]map[string]string{
+				"edgex/core-data/redisdb": {"username": "redis5", "password": "password"},
+			}}
+			ts := httptest.NewTLSServer(vault)
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.NewMockClient())
+			client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+			client.Config.Path = "/v1/secret/edgex/core-data/"
+			client.Config.KVVersion = version
+
+			secrets, err := client.GetSecrets(context.Background(), "redisdb", "password")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+			require.NoError(t, client.StoreSecrets(context.Background(), "mqtt", map[string]string{"password": "mqtt"}))
+			assert.Equal(t, map[string]string{"password": "mqtt"}, vault.secrets["edgex/core-data/mqtt"])
+
+			keys, err := client.ListSecretPaths(context.Background(), "")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"mqtt", "redisdb"}, keys)
+
+			_, err = client.GetSecrets(context.Background(), "unknown")
+			require.Error(t, err)
+
+			if version == KVVersionAuto {
+				assert.Equal(t, 1, vault.mountInfoCalls, "the detected version is kept")
+			}
+		})
+	}
+}
+
+func TestInvalidKVVersion(t *testing.T) {
+	_, err := NewClient(types.SecretConfig{Host: "localhost", KVVersion: "3"}, WithLogger(logger.NewMockClient()))
+	require.Error(t, err)
+}
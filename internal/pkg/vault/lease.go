@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// RenewLease renews the lease of a dynamic secret read with the client's token, requesting it be extended by
+// increment. The secret store may grant less than the increment, i.e. when the lease nears its max TTL.
+func (c *Client) RenewLease(ctx context.Context, leaseId string, increment time.Duration) (types.Lease, error) {
+	if leaseId == "" {
+		return types.Lease{}, pkg.NewErrSecretStore("lease id cannot be empty for renewing a lease")
+	}
+
+	request := LeaseRequest{LeaseId: leaseId, Increment: int(increment.Seconds())}
+	_, body, err := c.kvRequest(ctx, http.MethodPut, LeaseRenewAPI, request)
+	if err != nil {
+		return types.Lease{}, err
+	}
+
+	var lease types.Lease
+	if err := json.Unmarshal(body, &lease); err != nil {
+		return types.Lease{}, err
+	}
+
+	return lease, nil
+}
+
+// RevokeLease revokes the lease of a dynamic secret read with the client's token, which invalidates the secret
+func (c *Client) RevokeLease(ctx context.Context, leaseId string) error {
+	if leaseId == "" {
+		return pkg.NewErrSecretStore("lease id cannot be empty for revoking a lease")
+	}
+
+	_, _, err := c.kvRequest(ctx, http.MethodPut, LeaseRevokeAPI, LeaseRequest{LeaseId: leaseId})
+	if err == nil {
+		c.untrackLease(leaseId)
+	}
+	return err
+}
+
+// LookupLease returns the details of the lease
+func (c *Client) LookupLease(ctx context.Context, token string, leaseId string) (types.LeaseInfo, error) {
+	response := LeaseLookupResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 LeaseLookupAPI,
+		JSONObject:           LeaseRequest{LeaseId: leaseId},
+		BodyReader:           nil,
+		OperationDescription: "lookup lease",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response.Data, err
+}
+
+// RevokeLeasePrefix revokes all the leases whose id starts with prefix, i.e. "database/creds/core-data" revokes
+// every credential created for the role
+func (c *Client) RevokeLeasePrefix(ctx context.Context, token string, prefix string) error {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return pkg.NewErrSecretStore("lease prefix cannot be empty, revoking every lease is not supported")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 fmt.Sprintf(LeaseRevokePrefixAPI, prefix),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "revoke leases with prefix " + prefix,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
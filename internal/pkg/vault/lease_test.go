@@ -0,0 +1,112 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockLeaseServer is a stub Vault keeping the TTL, in seconds, of each lease
+type mockLeaseServer struct {
+	mutex  sync.Mutex
+	leases map[string]int
+}
+
+func (m *mockLeaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken || r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var request LeaseRequest
+	_ = json.NewDecoder(r.Body).Decode(&request)
+	ttl, ok := m.leases[request.LeaseId]
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/sys/leases/revoke-prefix/"):
+		prefix := strings.TrimPrefix(r.URL.Path, "/v1/sys/leases/revoke-prefix/")
+		for id := range m.leases {
+			if strings.HasPrefix(id, prefix) {
+				delete(m.leases, id)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case !ok && r.URL.Path != LeaseRevokeAPI:
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"invalid lease"}})
+	case r.URL.Path == LeaseRenewAPI:
+		m.leases[request.LeaseId] = request.Increment
+		_ = json.NewEncoder(w).Encode(types.Lease{LeaseId: request.LeaseId, LeaseDuration: request.Increment, Renewable: true})
+	case r.URL.Path == LeaseRevokeAPI:
+		delete(m.leases, request.LeaseId)
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == LeaseLookupAPI:
+		_ = json.NewEncoder(w).Encode(LeaseLookupResponse{Data: types.LeaseInfo{Id: request.LeaseId, Renewable: true, TTL: ttl}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestLeases(t *testing.T) {
+	mock := &mockLeaseServer{leases: map[string]int{
+		"database/creds/core-data/lease1":    60,
+		"database/creds/core-data/lease2":    60,
+		"database/creds/core-command/lease1": 60,
+	}}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	lease, err := client.RenewLease(context.Background(), "database/creds/core-data/lease1", 2*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, types.Lease{LeaseId: "database/creds/core-data/lease1", LeaseDuration: 120, Renewable: true}, lease)
+
+	info, err := client.LookupLease(context.Background(), expectedToken, "database/creds/core-data/lease1")
+	require.NoError(t, err)
+	assert.Equal(t, types.LeaseInfo{Id: "database/creds/core-data/lease1", Renewable: true, TTL: 120}, info)
+
+	_, err = client.RenewLease(context.Background(), "database/creds/unknown", time.Minute)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid lease")
+	_, err = client.RenewLease(context.Background(), "", time.Minute)
+	require.Error(t, err)
+
+	require.NoError(t, client.RevokeLease(context.Background(), "database/creds/core-data/lease1"))
+	_, err = client.LookupLease(context.Background(), expectedToken, "database/creds/core-data/lease1")
+	require.Error(t, err)
+
+	require.NoError(t, client.RevokeLeasePrefix(context.Background(), expectedToken, "/database/creds/core-data/"))
+	assert.Equal(t, map[string]int{"database/creds/core-command/lease1": 60}, mock.leases)
+	require.Error(t, client.RevokeLeasePrefix(context.Background(), expectedToken, "/"))
+}
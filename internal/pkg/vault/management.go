@@ -16,16 +16,20 @@
 package vault
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
 
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 )
 
-func (c *Client) HealthCheck() (int, error) {
-	code, err := c.doRequest(RequestArgs{
+func (c *Client) HealthCheck(ctx context.Context) (int, error) {
+	code, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            "",
 		Method:               http.MethodGet,
 		Path:                 HealthAPI,
@@ -44,7 +48,10 @@ func (c *Client) HealthCheck() (int, error) {
 	return code, err
 }
 
-func (c *Client) Init(secretThreshold int, secretShares int) (types.InitResponse, error) {
+// Init initializes the secret store, returning the key shares and the root token. An already initialized secret
+// store gives a pkg.ErrAlreadyExists, as its key shares cannot be returned again, so re-runs of a bootstrap can tell
+// it apart from a failure.
+func (c *Client) Init(ctx context.Context, secretThreshold int, secretShares int) (types.InitResponse, error) {
 	c.lc.Infof("vault init strategy (SSS parameters): shares=%d threshold=%d",
 		secretShares,
 		secretThreshold)
@@ -55,7 +62,7 @@ func (c *Client) Init(secretThreshold int, secretShares int) (types.InitResponse
 	}
 
 	response := types.InitResponse{}
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            "",
 		Method:               http.MethodPost,
 		Path:                 InitAPI,
@@ -65,21 +72,38 @@ func (c *Client) Init(secretThreshold int, secretShares int) (types.InitResponse
 		ExpectedStatusCode:   http.StatusOK,
 		ResponseObject:       &response,
 	})
+	if errors.Is(err, pkg.ErrAlreadyExists{}) {
+		c.lc.Info("Vault is already initialized")
+	}
 
 	return response, err
 }
 
-func (c *Client) Unseal(keysBase64 []string) error {
-	c.lc.Infof("Vault unsealing Process. Applying key shares.")
+// Unseal applies the key shares until the secret store is unsealed. The shares may be base64 or hex encoded, as in
+// the keys_base64 and keys of the init response. The seal status is checked first, so nothing is applied when the
+// secret store is already unsealed, i.e. by an earlier partial attempt which reached the threshold. A share which
+// fails to apply is logged and the remaining shares are tried. The final state is verified with the seal status and
+// an ErrUnsealIncomplete is returned when the secret store is still sealed after all the shares are tried.
+func (c *Client) Unseal(ctx context.Context, keysBase64 []string) error {
+	status, err := c.SealStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if !status.Sealed {
+		c.lc.Info("Vault is already unsealed, no key shares applied.")
+		return nil
+	}
 
 	secretShares := len(keysBase64)
+	c.lc.Infof("Vault unsealing Process. Applying key shares, %d of %d submitted.", status.Progress, status.T)
 
-	keyCounter := 1
-	for _, key := range keysBase64 {
-		request := UnsealRequest{Key: key}
+	applied := 0
+	var lastErr error
+	for keyCounter, key := range keysBase64 {
+		request := UnsealRequest{Key: types.SecretString(key)}
 		response := UnsealResponse{}
 
-		_, err := c.doRequest(RequestArgs{
+		_, err := c.doRequest(ctx, RequestArgs{
 			AuthToken:            "",
 			Method:               http.MethodPost,
 			Path:                 UnsealAPI,
@@ -91,23 +115,38 @@ func (c *Client) Unseal(keysBase64 []string) error {
 		})
 
 		if err != nil {
-			c.lc.Error(fmt.Sprintf("Error applying key share %d/%d: %s", keyCounter, secretShares, err.Error()))
-			return err
+			c.lc.Error(fmt.Sprintf("Error applying key share %d/%d, trying the remaining shares: %s",
+				keyCounter+1, secretShares, err.Error()))
+			lastErr = err
+			continue
 		}
 
-		c.lc.Info(fmt.Sprintf("Vault key share %d/%d successfully applied.", keyCounter, secretShares))
+		applied++
+		c.lc.Info(fmt.Sprintf("Vault key share %d/%d successfully applied.", keyCounter+1, secretShares))
 		if !response.Sealed {
-			c.lc.Info("Vault key share threshold reached. Unsealing complete.")
-			return nil
+			c.lc.Info("Vault key share threshold reached.")
+			break
 		}
-		keyCounter++
 	}
 
-	return fmt.Errorf("%d", 1)
+	if status, err = c.SealStatus(ctx); err != nil {
+		return err
+	}
+	if !status.Sealed {
+		c.lc.Info("Vault unsealing complete.")
+		return nil
+	}
+	if applied == 0 && lastErr != nil {
+		return lastErr
+	}
+
+	c.lc.Errorf("Vault is still sealed after applying %d key shares, progress %d/%d", applied, status.Progress, status.T)
+	return pkg.NewErrUnsealIncomplete(applied, status.T, status.Progress)
 }
 
-func (c *Client) InstallPolicy(token string, policyName string, policyDocument string) error {
-	_, err := c.doRequest(RequestArgs{
+// InstallPolicy creates or replaces the ACL policy, so installing the same policy again succeeds
+func (c *Client) InstallPolicy(ctx context.Context, token string, policyName string, policyDocument string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPut,
 		Path:                 fmt.Sprintf(CreatePolicyPath, url.PathEscape(policyName)),
@@ -121,7 +160,9 @@ func (c *Client) InstallPolicy(token string, policyName string, policyDocument s
 	return err
 }
 
-func (c *Client) EnableKVSecretEngine(token string, mountPoint string, kvVersion string) error {
+// EnableKVSecretEngine mounts a KV secrets engine of kvVersion at mountPoint. It succeeds when a KV secrets engine
+// is already mounted there, i.e. when a bootstrap is re-run.
+func (c *Client) EnableKVSecretEngine(ctx context.Context, token string, mountPoint string, kvVersion string) error {
 	urlPath := path.Join(MountsAPI, mountPoint)
 	parameters := EnableSecretsEngineRequest{
 		Type:        KeyValue,
@@ -131,7 +172,7 @@ func (c *Client) EnableKVSecretEngine(token string, mountPoint string, kvVersion
 		},
 	}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 urlPath,
@@ -142,10 +183,12 @@ func (c *Client) EnableKVSecretEngine(token string, mountPoint string, kvVersion
 		ResponseObject:       nil,
 	})
 
-	return err
+	return c.mountExisting(ctx, token, mountPoint, KeyValue, err)
 }
 
-func (c *Client) EnableConsulSecretEngine(token string, mountPoint string, defaultLeaseTTL string) error {
+// EnableConsulSecretEngine mounts a Consul secrets engine at mountPoint. It succeeds when a Consul secrets engine is
+// already mounted there.
+func (c *Client) EnableConsulSecretEngine(ctx context.Context, token string, mountPoint string, defaultLeaseTTL string) error {
 	urlPath := path.Join(MountsAPI, mountPoint)
 	parameters := EnableSecretsEngineRequest{
 		Type:        Consul,
@@ -155,7 +198,7 @@ func (c *Client) EnableConsulSecretEngine(token string, mountPoint string, defau
 		},
 	}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 urlPath,
@@ -166,30 +209,55 @@ func (c *Client) EnableConsulSecretEngine(token string, mountPoint string, defau
 		ResponseObject:       nil,
 	})
 
-	return err
+	return c.mountExisting(ctx, token, mountPoint, Consul, err)
 }
 
-func (c *Client) CheckSecretEngineInstalled(token string, mountPoint string, engine string) (bool, error) {
+// CheckSecretEngineInstalled returns the metadata of the secrets engine mounted at mountPoint when it is of the
+// engine type. A pkg.ErrSecretEngineNotFound is returned when nothing is mounted there, or an engine of another type
+// is, together with its metadata. Any other error means the mounts could not be queried.
+func (c *Client) CheckSecretEngineInstalled(ctx context.Context, token string, mountPoint string, engine string) (types.SecretEngineMount, error) {
 	var response ListSecretEnginesResponse
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodGet,
 		Path:                 MountsAPI,
 		JSONObject:           nil,
 		BodyReader:           nil,
-		OperationDescription: "query mounts for" + engine,
+		OperationDescription: "query mounts for " + engine,
 		ExpectedStatusCode:   http.StatusOK,
 		ResponseObject:       &response,
 	})
 
 	if err != nil {
-		return false, err
+		return types.SecretEngineMount{}, err
+	}
+
+	// the mounts are keyed by their path with a trailing slash
+	mountPoint = strings.Trim(mountPoint, "/") + "/"
+	mount, ok := response.Data[mountPoint]
+	if !ok {
+		return types.SecretEngineMount{}, pkg.NewErrSecretEngineNotFound(mountPoint, engine, "")
+	}
+	mount.Path = mountPoint
+	if mount.Type != engine {
+		return mount, pkg.NewErrSecretEngineNotFound(mountPoint, engine, mount.Type)
+	}
+
+	return mount, nil
+}
+
+// mountExisting returns nil when err reports the mount point is in use by a secrets engine of the requested type,
+// otherwise err. An engine of another type at the mount point stays an error.
+func (c *Client) mountExisting(ctx context.Context, token string, mountPoint string, engine string, err error) error {
+	if !errors.Is(err, pkg.ErrAlreadyExists{}) {
+		return err
 	}
 
-	if mountData := response.Data[mountPoint]; mountData.Type == engine {
-		return true, nil
+	if _, checkErr := c.CheckSecretEngineInstalled(ctx, token, mountPoint, engine); checkErr != nil {
+		return err
 	}
 
-	return false, nil
+	c.lc.Infof("%s secrets engine is already mounted at %s", engine, mountPoint)
+	return nil
 }
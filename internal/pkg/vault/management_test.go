@@ -17,12 +17,18 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	url2 "net/url"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -50,11 +56,80 @@ func TestHealthCheck(t *testing.T) {
 
 	client := createClient(t, ts.URL, mockLogger)
 
-	code, err := client.HealthCheck()
+	code, err := client.HealthCheck(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusOK, code)
 }
 
+func TestManagementIgnoresSecretsPath(t *testing.T) {
+	var paths []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		switch r.URL.EscapedPath() {
+		case testPath + "/service":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"username":"admin"}}`))
+		case "/v1/sys/policies/acl/policy-name":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = testPath
+
+	_, err := client.HealthCheck(context.Background())
+	require.NoError(t, err)
+	err = client.InstallPolicy(context.Background(), expectedToken, "policy-name", "policydoc")
+	require.NoError(t, err)
+	secrets, err := client.GetSecrets(context.Background(), "/service", "username")
+	require.NoError(t, err)
+
+	assert.Equal(t, "admin", secrets["username"])
+	assert.Equal(t, []string{HealthAPI, "/v1/sys/policies/acl/policy-name", testPath + "/service"}, paths)
+}
+
+func TestManagementCancelledContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// hang until the client gives up on the request, which the server notices once the body is read
+		_, _ = ioutil.ReadAll(r.Body)
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	calls := map[string]func(ctx context.Context) error{
+		"HealthCheck": func(ctx context.Context) error {
+			_, err := client.HealthCheck(ctx)
+			return err
+		},
+		"InstallPolicy": func(ctx context.Context) error {
+			return client.InstallPolicy(ctx, expectedToken, "edgex", `path "secret/*" {}`)
+		},
+		"CreateTransitKey": func(ctx context.Context) error {
+			return client.CreateTransitKey(ctx, expectedToken, "transit", "edgex", types.TransitKey{})
+		},
+		"ExchangeToken": func(ctx context.Context) error {
+			_, err := client.ExchangeToken(ctx, expectedToken, types.TokenExchangeRequest{})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			err := call(ctx)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded), err.Error())
+		})
+	}
+}
+
 func TestHealthCheckUninitialized(t *testing.T) {
 	mockLogger := logger.MockLogger{}
 
@@ -65,7 +140,7 @@ func TestHealthCheckUninitialized(t *testing.T) {
 
 	client := createClient(t, ts.URL, mockLogger)
 
-	code, err := client.HealthCheck()
+	code, err := client.HealthCheck(context.Background())
 	require.Error(t, err)
 	assert.Equal(t, http.StatusNotImplemented, code)
 }
@@ -80,7 +155,7 @@ func TestHealthCheckSealed(t *testing.T) {
 
 	client := createClient(t, ts.URL, mockLogger)
 
-	code, err := client.HealthCheck()
+	code, err := client.HealthCheck(context.Background())
 	require.Error(t, err)
 	assert.Equal(t, http.StatusServiceUnavailable, code)
 }
@@ -108,27 +183,97 @@ func TestInit(t *testing.T) {
 
 	client := createClient(t, ts.URL, mockLogger)
 
-	initResp, err := client.Init(1, 2)
+	initResp, err := client.Init(context.Background(), 1, 2)
 	require.NoError(t, err)
 	assert.NotNil(t, initResp)
 }
 
-func TestUnseal(t *testing.T) {
-	mockLogger := logger.MockLogger{}
+// mockUnsealVault is a stub Vault counting the accepted key shares towards the threshold. Shares starting with "bad"
+// are rejected.
+type mockUnsealVault struct {
+	mutex     sync.Mutex
+	threshold int
+	progress  int
+	sealed    bool
+	submitted []string
+}
 
-	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, err := w.Write([]byte(`{"sealed": false, "t": 1, "n": 1, "progress": 100}`))
-		require.NoError(t, err)
-		require.Equal(t, http.MethodPost, r.Method)
-		require.Equal(t, UnsealAPI, r.URL.EscapedPath())
-	}))
-	defer ts.Close()
+func (m *mockUnsealVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch r.URL.EscapedPath() {
+	case UnsealAPI:
+		var request UnsealRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.submitted = append(m.submitted, request.Key.Reveal())
+		if strings.HasPrefix(request.Key.Reveal(), "bad") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid key"]}`))
+			return
+		}
+		if m.sealed {
+			m.progress++
+			if m.progress >= m.threshold {
+				m.sealed = false
+				m.progress = 0
+			}
+		}
+		_ = json.NewEncoder(w).Encode(UnsealResponse{Sealed: m.sealed, T: m.threshold, Progress: m.progress})
+	case SealStatusAPI:
+		_ = json.NewEncoder(w).Encode(types.SealStatus{
+			Initialized: true, Sealed: m.sealed, T: m.threshold, Progress: m.progress})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
 
-	client := createClient(t, ts.URL, mockLogger)
+func TestUnseal(t *testing.T) {
+	tests := []struct {
+		name              string
+		sealed            bool
+		progress          int
+		keys              []string
+		expectedSubmitted []string
+		expectIncomplete  bool
+		expectError       bool
+	}{
+		{"Unseal", true, 0, []string{"key-1", "key-2", "key-3"}, []string{"key-1", "key-2"}, false, false},
+		{"Already unsealed", false, 0, []string{"key-1", "key-2"}, nil, false, false},
+		{"Partially unsealed", true, 1, []string{"key-1", "key-2"}, []string{"key-1"}, false, false},
+		{"Share failure", true, 0, []string{"bad-1", "key-2", "key-3"}, []string{"bad-1", "key-2", "key-3"}, false, false},
+		{"Incomplete", true, 0, []string{"key-1", "bad-2"}, []string{"key-1", "bad-2"}, true, false},
+		{"No shares", true, 0, nil, nil, true, false},
+		{"All shares fail", true, 0, []string{"bad-1", "bad-2"}, []string{"bad-1", "bad-2"}, false, true},
+	}
 
-	err := client.Unseal([]string{"test-keys-base64"})
-	require.NoError(t, err)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vault := &mockUnsealVault{threshold: 2, progress: test.progress, sealed: test.sealed}
+			ts := httptest.NewTLSServer(vault)
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.MockLogger{})
+
+			err := client.Unseal(context.Background(), test.keys)
+			assert.Equal(t, test.expectedSubmitted, vault.submitted)
+
+			var incomplete pkg.ErrUnsealIncomplete
+			switch {
+			case test.expectIncomplete:
+				require.True(t, errors.As(err, &incomplete), err)
+				assert.Equal(t, 2, incomplete.Threshold())
+				assert.Equal(t, vault.progress, incomplete.Applied())
+				assert.Equal(t, vault.progress, incomplete.Progress())
+			case test.expectError:
+				require.Error(t, err)
+				assert.False(t, errors.As(err, &incomplete))
+			default:
+				require.NoError(t, err)
+				assert.False(t, vault.sealed)
+			}
+		})
+	}
 }
 
 func TestInstallPolicy(t *testing.T) {
@@ -153,7 +298,7 @@ func TestInstallPolicy(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	err := client.InstallPolicy(expectedToken, "policy-name", expected)
+	err := client.InstallPolicy(context.Background(), expectedToken, "policy-name", expected)
 
 	// Assert
 	require.NoError(t, err)
@@ -261,11 +406,13 @@ func TestCheckSecretEngineInstalled(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			// Act
-			installed, err := client.CheckSecretEngineInstalled("fake-token", test.mountPath, test.engineType)
+			mount, err := client.CheckSecretEngineInstalled(context.Background(), "fake-token", test.mountPath, test.engineType)
 
 			// Assert
 			require.NoError(t, err)
-			require.True(t, installed)
+			assert.Equal(t, test.engineType, mount.Type)
+			assert.Equal(t, test.mountPath, mount.Path)
+			assert.NotEmpty(t, mount.Accessor)
 		})
 	}
 }
@@ -345,26 +492,46 @@ func TestCheckSecretEngineNotInstalled(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	tests := []struct {
-		name       string
-		mountPath  string
-		engineType string
+		name        string
+		mountPath   string
+		engineType  string
+		mountedType string
 	}{
-		{"kv v1 secret storage not installed", "secret/", KeyValue},
-		{"consul secret storage not installed", "consul/", Consul},
+		{"kv v1 secret storage not installed", "secret/", KeyValue, ""},
+		{"consul secret storage not installed", "consul/", Consul, ""},
+		{"other secret storage installed", "kv/", Consul, KeyValue},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			// Act
-			installed, err := client.CheckSecretEngineInstalled("fake-token", test.mountPath, test.engineType)
+			_, err := client.CheckSecretEngineInstalled(context.Background(), "fake-token", test.mountPath, test.engineType)
 
 			// Assert
-			require.NoError(t, err)
-			require.False(t, installed)
+			var notFound pkg.ErrSecretEngineNotFound
+			require.True(t, errors.As(err, &notFound), err)
+			assert.Equal(t, test.mountPath, notFound.MountPoint())
+			assert.Equal(t, test.engineType, notFound.Engine())
+			assert.Equal(t, test.mountedType, notFound.MountedType())
 		})
 	}
 }
 
+func TestCheckSecretEngineInstalledError(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.MockLogger{})
+
+	_, err := client.CheckSecretEngineInstalled(context.Background(), "fake-token", "secret/", KeyValue)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &pkg.ErrSecretEngineNotFound{}), "a failed query is not a missing engine")
+	assert.True(t, errors.Is(err, pkg.ErrPermissionDenied{}))
+}
+
 func TestEnableKVSecretEngine(t *testing.T) {
 	// Arrange
 	mockLogger := logger.MockLogger{}
@@ -391,12 +558,43 @@ func TestEnableKVSecretEngine(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	err := client.EnableKVSecretEngine(expectedToken, expectedMountPoint+"/", expectedVersion)
+	err := client.EnableKVSecretEngine(context.Background(), expectedToken, expectedMountPoint+"/", expectedVersion)
 
 	// Assert
 	require.NoError(t, err)
 }
 
+func TestManagementRerun(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.EscapedPath() == InitAPI:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["Vault is already initialized"]}`))
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == MountsAPI:
+			_, _ = w.Write([]byte(`{"data":{"secret/":{"type":"kv"},"consul/":{"type":"kv"}}}`))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["path is already in use at ` + strings.TrimPrefix(r.URL.Path, MountsAPI+"/") + `/"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.MockLogger{})
+
+	_, err := client.Init(context.Background(), 1, 1)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pkg.ErrAlreadyExists{}))
+
+	err = client.EnableKVSecretEngine(context.Background(), expectedToken, "secret/", "1")
+	require.NoError(t, err, "the KV secrets engine is already mounted")
+
+	err = client.EnableConsulSecretEngine(context.Background(), expectedToken, "consul", "1h")
+	require.Error(t, err, "a secrets engine of another type is mounted")
+	assert.True(t, errors.Is(err, pkg.ErrAlreadyExists{}))
+}
+
 func TestEnableConsulSecretEngine(t *testing.T) {
 	// Arrange
 	mockLogger := logger.MockLogger{}
@@ -423,7 +621,7 @@ func TestEnableConsulSecretEngine(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	err := client.EnableConsulSecretEngine(expectedToken, expectedMountPoint+"/", expectedTTL)
+	err := client.EnableConsulSecretEngine(context.Background(), expectedToken, expectedMountPoint+"/", expectedTTL)
 
 	// Assert
 	require.NoError(t, err)
@@ -442,7 +640,7 @@ func createClient(t *testing.T, url string, lc logger.LoggingClient) *Client {
 		Port:     port,
 	}
 
-	client, err := NewClient(config, pkg.NewMockRequester().Insecure(), false, lc)
+	client, err := NewClient(config, WithHTTPClient(pkg.NewMockRequester().Insecure()), WithLogger(lc))
 	require.NoError(t, err)
 
 	return client
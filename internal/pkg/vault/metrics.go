@@ -0,0 +1,60 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// SetMetrics records the requests and token renewals of the client to metrics, replacing those set WithMetrics.
+// A nil metrics stops the recording.
+func (c *Client) SetMetrics(metrics pkg.Metrics) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.metrics = metrics
+}
+
+func (c *Client) getMetrics() pkg.Metrics {
+	c.tokenMutex.RLock()
+	defer c.tokenMutex.RUnlock()
+	return c.metrics
+}
+
+// getInstrumentation returns the metrics and the tracer of the requests, either of which may be nil
+func (c *Client) getInstrumentation() (pkg.Metrics, pkg.Tracer) {
+	c.tokenMutex.RLock()
+	defer c.tokenMutex.RUnlock()
+	return c.metrics, c.tracer
+}
+
+// requestOperation names the operation of a request by its method and the API path up to the endpoint, i.e.
+// "GET sys/health" or "POST auth/approle", leaving out the secret sub-paths so the operations stay few. The
+// requests to secrets engines are named by their mount, i.e. "GET secret".
+func requestOperation(method string, path string) string {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), "v1/")
+	segments := strings.SplitN(path, "/", 3)
+
+	length := 1
+	switch segments[0] {
+	case "sys", "auth", "identity", "agent":
+		length = 2
+	}
+	if len(segments) < length {
+		length = len(segments)
+	}
+	return method + " " + strings.Join(segments[:length], "/")
+}
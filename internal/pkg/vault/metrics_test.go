@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/metrics"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestRequestOperation(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodGet, "/v1/sys/health", "GET sys/health"},
+		{http.MethodPost, "/v1/sys/policies/acl/edgex", "POST sys/policies"},
+		{http.MethodPost, "/v1/auth/approle/login", "POST auth/approle"},
+		{http.MethodPost, "/v1/auth/token/renew-self", "POST auth/token"},
+		{http.MethodGet, "/v1/secret/edgex/core-data/redisdb", "GET secret"},
+		{http.MethodGet, "/v1/identity/oidc/token/edgex", "GET identity/oidc"},
+		{http.MethodGet, "/agent/v1/metrics", "GET agent/v1"},
+		{http.MethodGet, "/v1/sys", "GET sys"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			assert.Equal(t, test.expected, requestOperation(test.method, test.path))
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/edgex/redisdb" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"password": "password"}}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = "/v1/secret/edgex/"
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	collector := metrics.NewCollector()
+	client.SetMetrics(collector)
+
+	_, err := client.GetSecrets(context.Background(), "redisdb")
+	require.NoError(t, err)
+	_, err = client.GetSecrets(context.Background(), "unknown")
+	require.Error(t, err)
+
+	snapshot := collector.Snapshot()
+	assert.Equal(t, map[int]uint64{http.StatusOK: 1, http.StatusNotFound: 1}, snapshot.Requests["GET secret"])
+	assert.Equal(t, uint64(2), snapshot.Latencies["GET secret"].Count)
+
+	// nothing is recorded once the metrics are unset
+	client.SetMetrics(nil)
+	_, err = client.GetSecrets(context.Background(), "redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), collector.Snapshot().Requests["GET secret"][http.StatusOK])
+}
@@ -0,0 +1,35 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import "github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+
+// Use adds the middleware around the HTTP client issuing the requests, inside the middleware added before, so
+// the middleware added first sees each request first
+func (c *Client) Use(middleware ...pkg.Middleware) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// caller returns the HttpCaller wrapped by the middleware
+func (c *Client) caller() pkg.Caller {
+	c.tokenMutex.RLock()
+	defer c.tokenMutex.RUnlock()
+	if len(c.middleware) == 0 {
+		return c.HttpCaller
+	}
+	return pkg.Chain(c.HttpCaller, c.middleware...)
+}
@@ -0,0 +1,81 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestMiddleware(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request-Source") != "edgex" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"password": "password"}}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = "/v1/secret/edgex/"
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	client.retryPolicy = types.RetryPolicy{MaxAttempts: 2, InitialInterval: time.Millisecond}
+
+	var order []string
+	header := func(next pkg.Caller) pkg.Caller {
+		return pkg.CallerFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "header")
+			req.Header.Set("X-Request-Source", "edgex")
+			return next.Do(req)
+		})
+	}
+	// chaos fails every other attempt without reaching the secret store
+	attempts := 0
+	chaos := func(next pkg.Caller) pkg.Caller {
+		return pkg.CallerFunc(func(req *http.Request) (*http.Response, error) {
+			order = append(order, "chaos")
+			attempts++
+			if attempts%2 == 1 {
+				return nil, errors.New("injected failure")
+			}
+			return next.Do(req)
+		})
+	}
+
+	client.Use(header, chaos)
+	secrets, err := client.GetSecrets(context.Background(), "redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+	// the middleware wraps each attempt, so the injected failure was retried
+	assert.Equal(t, []string{"header", "chaos", "header", "chaos"}, order)
+
+	client.retryPolicy = types.RetryPolicy{}
+	_, err = client.GetSecrets(context.Background(), "redisdb")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "injected failure")
+}
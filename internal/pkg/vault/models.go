@@ -15,12 +15,20 @@
 package vault
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 )
 
 const (
 	KeyValue = "kv"
 	Consul   = "consul"
+	Database = "database"
+	Transit  = "transit"
+	PKI      = "pki"
+	SSH      = "ssh"
+	RabbitMQ = "rabbitmq"
 )
 
 // InitRequest contains a Vault init request regarding the Shamir Secret Sharing (SSS) parameters
@@ -36,28 +44,28 @@ type UpdateACLPolicyRequest struct {
 
 // RootTokenControlResponse is the response to /v1/sys/generate-root/attempt
 type RootTokenControlResponse struct {
-	Complete bool   `json:"complete"`
-	Nonce    string `json:"nonce"`
-	Otp      string `json:"otp"`
+	Complete bool               `json:"complete"`
+	Nonce    string             `json:"nonce"`
+	Otp      types.SecretString `json:"otp"`
 }
 
 // RootTokenRetrievalRequest is the request to /v1/sys/generate-root/update
 type RootTokenRetrievalRequest struct {
-	Key   string `json:"key"`
-	Nonce string `json:"nonce"`
+	Key   types.SecretString `json:"key"`
+	Nonce string             `json:"nonce"`
 }
 
 // RootTokenRetrievalResponse is the response to /v1/sys/generate-root/update
 type RootTokenRetrievalResponse struct {
-	Complete     bool   `json:"complete"`
-	EncodedToken string `json:"encoded_token"`
+	Complete     bool               `json:"complete"`
+	EncodedToken types.SecretString `json:"encoded_token"`
 }
 
 type TokenLookupResponse struct {
 	Data types.TokenMetadata
 }
 
-// ListTokenAccessorsResponse is the response to the list accessors API
+// ListTokenAccessorsResponse is the response to the list accessors API, and to the other token list APIs
 type ListTokenAccessorsResponse struct {
 	Data struct {
 		Keys []string `json:"keys"`
@@ -76,15 +84,13 @@ type LookupAccessorRequest struct {
 
 // ListSecretEnginesResponse is the response to GET /v1/sys/mounts
 type ListSecretEnginesResponse struct {
-	Data map[string]struct {
-		Type string `json:"type"`
-	} `json:"data"`
+	Data map[string]types.SecretEngineMount `json:"data"`
 }
 
 // UnsealRequest contains a Vault unseal request
 type UnsealRequest struct {
-	Key   string `json:"key"`
-	Reset bool   `json:"reset"`
+	Key   types.SecretString `json:"key"`
+	Reset bool               `json:"reset"`
 }
 
 // UnsealResponse contains a Vault unseal response
@@ -102,6 +108,7 @@ type SecretsEngineOptions struct {
 // SecretsEngineConfig is config for /v1/sys/mounts
 type SecretsEngineConfig struct {
 	DefaultLeaseTTLDuration string `json:"default_lease_ttl"`
+	MaxLeaseTTLDuration     string `json:"max_lease_ttl,omitempty"`
 }
 
 // EnableSecretsEngineRequest is the POST request to /v1/sys/mounts
@@ -111,3 +118,451 @@ type EnableSecretsEngineRequest struct {
 	Options     *SecretsEngineOptions `json:"options,omitempty"`
 	Config      *SecretsEngineConfig  `json:"config,omitempty"`
 }
+
+// HealthResponse is the response to GET /v1/sys/health
+type HealthResponse struct {
+	Initialized bool   `json:"initialized"`
+	Sealed      bool   `json:"sealed"`
+	Standby     bool   `json:"standby"`
+	Version     string `json:"version"`
+	ClusterName string `json:"cluster_name"`
+}
+
+// LoginResponse is the response to an auth method login, i.e. POST /v1/auth/approle/login, and to a token renewal
+type LoginResponse struct {
+	Auth LoginAuth `json:"auth"`
+}
+
+// LoginAuth is the token issued by an auth method login
+type LoginAuth struct {
+	ClientToken   types.SecretString `json:"client_token"`
+	Accessor      string             `json:"accessor"`
+	Policies      []string           `json:"policies"`
+	LeaseDuration int                `json:"lease_duration"`
+	Renewable     bool               `json:"renewable"`
+}
+
+// expiry returns when the token issued at now expires, the zero time for tokens without a lease duration
+func (a LoginAuth) expiry(now time.Time) time.Time {
+	if a.LeaseDuration <= 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(a.LeaseDuration) * time.Second)
+}
+
+// AppRoleLoginRequest is the request to the AppRole login API
+type AppRoleLoginRequest struct {
+	RoleId   string             `json:"role_id"`
+	SecretId types.SecretString `json:"secret_id"`
+}
+
+// KubernetesLoginRequest is the request to the Kubernetes auth method login API
+type KubernetesLoginRequest struct {
+	Role string             `json:"role"`
+	JWT  types.SecretString `json:"jwt"`
+}
+
+// CertLoginRequest is the request to the cert auth method login API
+type CertLoginRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// JWTLoginRequest is the request to the JWT auth method login API
+type JWTLoginRequest struct {
+	Role string             `json:"role,omitempty"`
+	JWT  types.SecretString `json:"jwt"`
+}
+
+// UserpassLoginRequest is the request to the userpass auth method login API, the username is part of the login path
+type UserpassLoginRequest struct {
+	Password types.SecretString `json:"password"`
+}
+
+// AWSIAMLoginRequest is the request to the AWS auth method login API for the iam login type.
+// The signed sts:GetCallerIdentity request is base64 encoded so Vault can replay it to AWS.
+type AWSIAMLoginRequest struct {
+	Role              string `json:"role,omitempty"`
+	IAMRequestMethod  string `json:"iam_http_request_method"`
+	IAMRequestURL     string `json:"iam_request_url"`
+	IAMRequestBody    string `json:"iam_request_body"`
+	IAMRequestHeaders string `json:"iam_request_headers"`
+}
+
+// TokenCreateResponse is the response to the token create APIs
+type TokenCreateResponse struct {
+	Auth types.TokenCreateResponse `json:"auth"`
+}
+
+// TokenRoleResponse is the response to reading /v1/auth/token/roles/<role>
+type TokenRoleResponse struct {
+	Data types.TokenRole `json:"data"`
+}
+
+// CubbyholeReadResponse is the response to reading /v1/cubbyhole/<path>
+type CubbyholeReadResponse struct {
+	Data map[string]string `json:"data"`
+}
+
+// WrapResponse is the response to /v1/sys/wrapping/wrap
+type WrapResponse struct {
+	WrapInfo types.WrapInfo `json:"wrap_info"`
+}
+
+// WrapLookupRequest is the request to /v1/sys/wrapping/lookup
+type WrapLookupRequest struct {
+	Token types.SecretString `json:"token"`
+}
+
+// WrapLookupResponse is the response to /v1/sys/wrapping/lookup
+type WrapLookupResponse struct {
+	Data struct {
+		CreationPath string `json:"creation_path"`
+		CreationTime string `json:"creation_time"`
+		CreationTTL  int    `json:"creation_ttl"`
+	} `json:"data"`
+}
+
+// UnwrapSecretIdResponse is the response to /v1/sys/wrapping/unwrap for a wrapped AppRole secret id
+type UnwrapSecretIdResponse struct {
+	Data struct {
+		SecretId         types.SecretString `json:"secret_id"`
+		SecretIdAccessor string             `json:"secret_id_accessor"`
+	} `json:"data"`
+}
+
+// DatabaseCredentialsResponse is the response to reading /v1/database/creds/<role>
+type DatabaseCredentialsResponse struct {
+	types.Lease
+	Data struct {
+		Username string             `json:"username"`
+		Password types.SecretString `json:"password"`
+	} `json:"data"`
+}
+
+// LeaseRequest is the request to renew, revoke or look up a lease
+type LeaseRequest struct {
+	LeaseId string `json:"lease_id"`
+	// Increment is the requested extension in seconds when renewing
+	Increment int `json:"increment,omitempty"`
+}
+
+// LeaseLookupResponse is the response to /v1/sys/leases/lookup
+type LeaseLookupResponse struct {
+	Data types.LeaseInfo `json:"data"`
+}
+
+// TransitRequest is the request to encrypt, decrypt or rewrap with a transit key. The plaintext is base64 encoded.
+type TransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+// TransitResponse is the response to encrypting, decrypting or rewrapping with a transit key
+type TransitResponse struct {
+	Data TransitRequest `json:"data"`
+}
+
+// TransitSignRequest is the request to sign, HMAC or verify with a transit key. The inputs are base64 encoded and
+// BatchInput replaces Input to process several inputs in one request.
+type TransitSignRequest struct {
+	Input               string             `json:"input,omitempty"`
+	BatchInput          []TransitBatchItem `json:"batch_input,omitempty"`
+	Signature           string             `json:"signature,omitempty"`
+	HMAC                string             `json:"hmac,omitempty"`
+	HashAlgorithm       string             `json:"hash_algorithm,omitempty"`
+	Prehashed           bool               `json:"prehashed,omitempty"`
+	SignatureAlgorithm  string             `json:"signature_algorithm,omitempty"`
+	MarshalingAlgorithm string             `json:"marshaling_algorithm,omitempty"`
+	KeyVersion          int                `json:"key_version,omitempty"`
+}
+
+// TransitBatchItem is an input of a batch request
+type TransitBatchItem struct {
+	Input string `json:"input"`
+}
+
+// TransitBatchResult is the result for the input of a batch request at the same index
+type TransitBatchResult struct {
+	Signature string `json:"signature"`
+	HMAC      string `json:"hmac"`
+	Error     string `json:"error"`
+}
+
+// TransitSignResponse is the response to signing, HMACing or verifying with a transit key
+type TransitSignResponse struct {
+	Data struct {
+		Signature    string               `json:"signature"`
+		HMAC         string               `json:"hmac"`
+		Valid        bool                 `json:"valid"`
+		BatchResults []TransitBatchResult `json:"batch_results"`
+	} `json:"data"`
+}
+
+// TransitKeyResponse is the response to reading a transit key. Keys maps each available key version to its
+// creation time, or to its public key details for asymmetric keys.
+type TransitKeyResponse struct {
+	Data struct {
+		Name                 string                     `json:"name"`
+		Type                 string                     `json:"type"`
+		LatestVersion        int                        `json:"latest_version"`
+		MinDecryptionVersion int                        `json:"min_decryption_version"`
+		MinEncryptionVersion int                        `json:"min_encryption_version"`
+		MinAvailableVersion  int                        `json:"min_available_version"`
+		DeletionAllowed      bool                       `json:"deletion_allowed"`
+		Exportable           bool                       `json:"exportable"`
+		AllowPlaintextBackup bool                       `json:"allow_plaintext_backup"`
+		AutoRotatePeriod     int                        `json:"auto_rotate_period"`
+		Keys                 map[string]json.RawMessage `json:"keys"`
+	} `json:"data"`
+}
+
+// TransitTrimRequest is the request to trim the versions of a transit key
+type TransitTrimRequest struct {
+	MinAvailableVersion int `json:"min_available_version"`
+}
+
+// PKICARequest is the request to generate a root CA, an intermediate CA's CSR or to sign an intermediate CA
+type PKICARequest struct {
+	CommonName string `json:"common_name"`
+	TTL        int    `json:"ttl,omitempty"`
+	KeyType    string `json:"key_type,omitempty"`
+	KeyBits    int    `json:"key_bits,omitempty"`
+	CSR        string `json:"csr,omitempty"`
+	Format     string `json:"format,omitempty"`
+}
+
+// PKISetSignedRequest is the request to import the signed certificate of an intermediate CA
+type PKISetSignedRequest struct {
+	Certificate string `json:"certificate"`
+}
+
+// PKIIssueRequest is the request to issue a certificate for a PKI role. The SANs are comma separated.
+type PKIIssueRequest struct {
+	CommonName string `json:"common_name"`
+	AltNames   string `json:"alt_names,omitempty"`
+	IPSANs     string `json:"ip_sans,omitempty"`
+	TTL        int    `json:"ttl,omitempty"`
+}
+
+// PKICertificateResponse is the response to generating a CA, signing an intermediate CA or issuing a certificate
+type PKICertificateResponse struct {
+	Data struct {
+		Certificate    string             `json:"certificate"`
+		IssuingCA      string             `json:"issuing_ca"`
+		CAChain        []string           `json:"ca_chain"`
+		PrivateKey     types.SecretString `json:"private_key"`
+		PrivateKeyType string             `json:"private_key_type"`
+		SerialNumber   string             `json:"serial_number"`
+		Expiration     int64              `json:"expiration"`
+		CSR            string             `json:"csr"`
+	} `json:"data"`
+}
+
+// ConsulCredentialsResponse is the response to reading /v1/consul/creds/<role>
+type ConsulCredentialsResponse struct {
+	types.Lease
+	Data struct {
+		Token    types.SecretString `json:"token"`
+		Accessor string             `json:"accessor"`
+		Local    bool               `json:"local"`
+	} `json:"data"`
+}
+
+// SSHCARequest is the request to configure the CA of the SSH secrets engine
+type SSHCARequest struct {
+	GenerateSigningKey bool   `json:"generate_signing_key"`
+	KeyType            string `json:"key_type,omitempty"`
+}
+
+// SSHCAResponse is the response to configuring the CA of the SSH secrets engine
+type SSHCAResponse struct {
+	Data struct {
+		PublicKey string `json:"public_key"`
+	} `json:"data"`
+}
+
+// SSHRoleRequest is the request to create a role of the SSH secrets engine, which only signs certificates
+type SSHRoleRequest struct {
+	types.SSHRole
+	KeyType string `json:"key_type"`
+}
+
+// SSHSignRequest is the request to sign an SSH public key. The principals are comma separated.
+type SSHSignRequest struct {
+	PublicKey       string `json:"public_key"`
+	ValidPrincipals string `json:"valid_principals,omitempty"`
+	CertType        string `json:"cert_type,omitempty"`
+	KeyId           string `json:"key_id,omitempty"`
+	TTL             int    `json:"ttl,omitempty"`
+}
+
+// SSHSignResponse is the response to signing an SSH public key
+type SSHSignResponse struct {
+	Data struct {
+		SerialNumber string `json:"serial_number"`
+		SignedKey    string `json:"signed_key"`
+	} `json:"data"`
+}
+
+// RabbitMQRoleRequest is the request to create a role of the RabbitMQ secrets engine. The tags are comma separated
+// and the permissions are JSON encoded.
+type RabbitMQRoleRequest struct {
+	Tags        string `json:"tags,omitempty"`
+	VHosts      string `json:"vhosts,omitempty"`
+	VHostTopics string `json:"vhost_topics,omitempty"`
+}
+
+// RabbitMQCredentialsResponse is the response to reading /v1/rabbitmq/creds/<role>
+type RabbitMQCredentialsResponse struct {
+	types.Lease
+	Data struct {
+		Username string             `json:"username"`
+		Password types.SecretString `json:"password"`
+	} `json:"data"`
+}
+
+// EntityRequest is the request to create or update an identity entity by name
+type EntityRequest struct {
+	Policies []string          `json:"policies"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Disabled bool              `json:"disabled"`
+}
+
+// EntityResponse is the response to reading, or creating, an identity entity
+type EntityResponse struct {
+	Data types.Entity `json:"data"`
+}
+
+// EntityAliasRequest is the request to create an alias of an identity entity
+type EntityAliasRequest struct {
+	Name          string `json:"name"`
+	CanonicalId   string `json:"canonical_id"`
+	MountAccessor string `json:"mount_accessor"`
+}
+
+// EntityAliasResponse is the response to creating an alias of an identity entity
+type EntityAliasResponse struct {
+	Data struct {
+		Id          string `json:"id"`
+		CanonicalId string `json:"canonical_id"`
+	} `json:"data"`
+}
+
+// GroupRequest is the request to create or update an internal identity group by name
+type GroupRequest struct {
+	Type            string            `json:"type"`
+	Policies        []string          `json:"policies"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	MemberEntityIds []string          `json:"member_entity_ids"`
+	MemberGroupIds  []string          `json:"member_group_ids"`
+}
+
+// GroupResponse is the response to reading, or creating, an identity group
+type GroupResponse struct {
+	Data types.Group `json:"data"`
+}
+
+// IdentityTokenResponse is the response to generating an identity token for a role
+type IdentityTokenResponse struct {
+	Data struct {
+		Token    types.SecretString `json:"token"`
+		ClientId string             `json:"client_id"`
+		TTL      int                `json:"ttl"`
+	} `json:"data"`
+}
+
+// IntrospectRequest is the request to verify an identity token, optionally issued for the role with ClientId
+type IntrospectRequest struct {
+	Token    types.SecretString `json:"token"`
+	ClientId string             `json:"client_id,omitempty"`
+}
+
+// IntrospectResponse is the response to verifying an identity token
+type IntrospectResponse struct {
+	Active bool   `json:"active"`
+	Error  string `json:"error"`
+}
+
+// CapabilitiesRequest is the request for the capabilities of the client's token on the paths
+type CapabilitiesRequest struct {
+	Paths []string `json:"paths"`
+}
+
+// CapabilitiesResponse maps each requested path to the token's capabilities on it. The "capabilities" key holds
+// the capabilities on all the paths combined.
+type CapabilitiesResponse struct {
+	Data map[string][]string `json:"data"`
+}
+
+// ListAuthMethodsResponse is the response to GET /v1/sys/auth
+type ListAuthMethodsResponse struct {
+	Data map[string]types.AuthMount `json:"data"`
+}
+
+// MountInfoResponse is the response to GET /v1/sys/internal/ui/mounts/<path>, describing the mount holding the path
+type MountInfoResponse struct {
+	Data struct {
+		Path    string            `json:"path"`
+		Type    string            `json:"type"`
+		Options map[string]string `json:"options"`
+		Config  struct {
+			DefaultLeaseTTL int `json:"default_lease_ttl"`
+			MaxLeaseTTL     int `json:"max_lease_ttl"`
+		} `json:"config"`
+	} `json:"data"`
+}
+
+// RemountRequest is the POST request to /v1/sys/remount
+type RemountRequest struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ListAuditDevicesResponse is the response to GET /v1/sys/audit
+type ListAuditDevicesResponse struct {
+	Data map[string]types.AuditDevice `json:"data"`
+}
+
+// AuditHashRequest is the POST request to /v1/sys/audit-hash/{path}
+type AuditHashRequest struct {
+	Input string `json:"input"`
+}
+
+// AuditHashResponse is the response to POST /v1/sys/audit-hash/{path}
+type AuditHashResponse struct {
+	Hash string `json:"hash"`
+}
+
+// RekeyInitRequest is the PUT request to /v1/sys/rekey/init
+type RekeyInitRequest struct {
+	SecretShares        int      `json:"secret_shares"`
+	SecretThreshold     int      `json:"secret_threshold"`
+	PGPKeys             []string `json:"pgp_keys,omitempty"`
+	Backup              bool     `json:"backup,omitempty"`
+	RequireVerification bool     `json:"require_verification,omitempty"`
+}
+
+// RekeyShareRequest is the PUT request to /v1/sys/rekey/update and /v1/sys/rekey/verify
+type RekeyShareRequest struct {
+	Key   string `json:"key"`
+	Nonce string `json:"nonce"`
+}
+
+// RaftConfigurationResponse is the response to GET /v1/sys/storage/raft/configuration
+type RaftConfigurationResponse struct {
+	Data struct {
+		Config struct {
+			Servers []types.RaftPeer `json:"servers"`
+		} `json:"config"`
+	} `json:"data"`
+}
+
+// RaftRemovePeerRequest is the POST request to /v1/sys/storage/raft/remove-peer
+type RaftRemovePeerRequest struct {
+	ServerId string `json:"server_id"`
+}
+
+// HAStatusResponse is the response to GET /v1/sys/ha-status
+type HAStatusResponse struct {
+	Nodes []types.HANode `json:"nodes"`
+}
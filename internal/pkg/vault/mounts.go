@@ -0,0 +1,128 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// DisableSecretEngine disables the secrets engine at mountPoint
+func (c *Client) DisableSecretEngine(ctx context.Context, token string, mountPoint string) error {
+	mountPoint = strings.Trim(mountPoint, "/")
+	if mountPoint == "" {
+		return pkg.NewErrSecretStore("mount point cannot be empty")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodDelete,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "disable secrets engine at " + mountPoint,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// RemountSecretEngine moves the secrets engine at fromMountPoint to toMountPoint, keeping its secrets
+func (c *Client) RemountSecretEngine(ctx context.Context, token string, fromMountPoint string, toMountPoint string) error {
+	request := RemountRequest{
+		From: strings.Trim(fromMountPoint, "/"),
+		To:   strings.Trim(toMountPoint, "/"),
+	}
+	if request.From == "" || request.To == "" {
+		return pkg.NewErrSecretStore("remount requires both the current and the new mount point")
+	}
+
+	// Vault 1.10 and later migrate the mount in the background and respond with the migration id
+	return c.postConfig(ctx, token, RemountAPI, request, "remount "+request.From+" to "+request.To)
+}
+
+// TuneMount updates the lease TTLs and audit settings of the secrets engine at mountPoint
+func (c *Client) TuneMount(ctx context.Context, token string, mountPoint string, config types.MountConfig) error {
+	mountPoint = strings.Trim(mountPoint, "/")
+	if mountPoint == "" {
+		return pkg.NewErrSecretStore("mount point cannot be empty")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint, "tune"),
+		JSONObject:           config,
+		BodyReader:           nil,
+		OperationDescription: "tune mount " + mountPoint,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// GetMountInfo describes the secrets engine mount holding mountPoint, which can be any path in the mount, i.e. the
+// Path of the configuration when mountPoint is empty. The request is made with the client's token, which only needs
+// a capability on the path rather than access to sys/mounts, the way the Vault CLI detects the KV version.
+func (c *Client) GetMountInfo(ctx context.Context, mountPoint string) (types.MountInfo, error) {
+	if mountPoint == "" {
+		mountPoint = c.Config.Path
+	}
+	mountPoint = strings.Trim(strings.TrimPrefix(strings.TrimPrefix(mountPoint, "/"), "v1/"), "/")
+	if mountPoint == "" {
+		return types.MountInfo{}, pkg.NewErrSecretStore("mount point cannot be empty")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodGet, MountInfoAPI+"/"+mountPoint, nil)
+	if err != nil {
+		return types.MountInfo{}, err
+	}
+
+	var response MountInfoResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.MountInfo{}, err
+	}
+
+	info := types.MountInfo{
+		Path:            response.Data.Path,
+		Type:            response.Data.Type,
+		DefaultLeaseTTL: time.Duration(response.Data.Config.DefaultLeaseTTL) * time.Second,
+		MaxLeaseTTL:     time.Duration(response.Data.Config.MaxLeaseTTL) * time.Second,
+		Options:         response.Data.Options,
+	}
+	if info.Type == KeyValue {
+		// a KV mount without the version option is a KV v1 mount
+		info.KVVersion = 1
+		if version, ok := response.Data.Options["version"]; ok && version != "" {
+			if info.KVVersion, err = strconv.Atoi(version); err != nil {
+				return types.MountInfo{}, pkg.NewErrSecretStore(
+					fmt.Sprintf("mount '%s' has an invalid KV version '%s'", info.Path, version))
+			}
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,168 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockMountsServer is a stub Vault keeping the type and tuned config of each secrets engine mount
+type mockMountsServer struct {
+	mutex   sync.Mutex
+	mounts  map[string]string
+	configs map[string]types.MountConfig
+}
+
+func (m *mockMountsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if r.URL.Path == RemountAPI && r.Method == http.MethodPost {
+		var request RemountRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		engine, exists := m.mounts[request.From]
+		if !exists {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delete(m.mounts, request.From)
+		m.mounts[request.To] = engine
+		_ = json.NewEncoder(w).Encode(map[string]string{"migration_id": "1234"})
+		return
+	}
+
+	mountPoint := strings.TrimPrefix(r.URL.Path, MountsAPI+"/")
+	switch {
+	case strings.HasSuffix(mountPoint, "/tune") && r.Method == http.MethodPost:
+		mountPoint = strings.TrimSuffix(mountPoint, "/tune")
+		if _, exists := m.mounts[mountPoint]; !exists {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var config types.MountConfig
+		_ = json.NewDecoder(r.Body).Decode(&config)
+		m.configs[mountPoint] = config
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(m.mounts, mountPoint)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestMountLifecycle(t *testing.T) {
+	mock := &mockMountsServer{
+		mounts:  map[string]string{"secret": KeyValue, "consul": Consul},
+		configs: make(map[string]types.MountConfig),
+	}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	config := types.MountConfig{
+		DefaultLeaseTTL:         3600,
+		MaxLeaseTTL:             86400,
+		AuditNonHMACRequestKeys: []string{"version"},
+	}
+	require.NoError(t, client.TuneMount(context.Background(), expectedToken, "/secret/", config))
+	assert.Equal(t, config, mock.configs["secret"])
+	require.Error(t, client.TuneMount(context.Background(), expectedToken, "unknown", config))
+	require.Error(t, client.TuneMount(context.Background(), expectedToken, "", config))
+
+	require.NoError(t, client.RemountSecretEngine(context.Background(), expectedToken, "secret", "edgex/secret"))
+	assert.Equal(t, map[string]string{"edgex/secret": KeyValue, "consul": Consul}, mock.mounts)
+	require.Error(t, client.RemountSecretEngine(context.Background(), expectedToken, "secret", "other"), "not mounted")
+	require.Error(t, client.RemountSecretEngine(context.Background(), expectedToken, "consul", "/"), "missing target")
+
+	require.NoError(t, client.DisableSecretEngine(context.Background(), expectedToken, "consul"))
+	assert.NotContains(t, mock.mounts, "consul")
+	require.Error(t, client.DisableSecretEngine(context.Background(), expectedToken, "/"))
+	require.Error(t, client.DisableSecretEngine(context.Background(), "bad-token", "edgex/secret"))
+}
+
+func TestGetMountInfo(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(AuthTypeHeader) != expectedToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		secretPath := strings.TrimPrefix(r.URL.Path, MountInfoAPI+"/") + "/"
+		switch {
+		case strings.HasPrefix(secretPath, "secret/"):
+			_, _ = w.Write([]byte(`{"data":{"path":"secret/","type":"kv","options":{"version":"2"},
+				"config":{"default_lease_ttl":3600,"max_lease_ttl":86400}}}`))
+		case strings.HasPrefix(secretPath, "kv/"):
+			_, _ = w.Write([]byte(`{"data":{"path":"kv/","type":"kv","options":null,"config":{}}}`))
+		case strings.HasPrefix(secretPath, "consul/"):
+			_, _ = w.Write([]byte(`{"data":{"path":"consul/","type":"consul","config":{}}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["preflight capability check returned 403"]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	client.Config.Path = "/v1/secret/edgex/core-data/"
+
+	tests := []struct {
+		name        string
+		mountPoint  string
+		expected    types.MountInfo
+		expectError bool
+	}{
+		{"KV v2 from the configured path", "", types.MountInfo{Path: "secret/", Type: KeyValue, KVVersion: 2,
+			DefaultLeaseTTL: time.Hour, MaxLeaseTTL: 24 * time.Hour, Options: map[string]string{"version": "2"}}, false},
+		{"KV v1", "kv/edgex", types.MountInfo{Path: "kv/", Type: KeyValue, KVVersion: 1}, false},
+		{"Other engine", "/consul/", types.MountInfo{Path: "consul/", Type: Consul}, false},
+		{"No access", "other", types.MountInfo{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := client.GetMountInfo(context.Background(), test.mountPoint)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, info)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// namespaceRecorder records the namespace header of each request, "" when the header is missing
+type namespaceRecorder struct {
+	mutex      sync.Mutex
+	namespaces []string
+}
+
+func (n *namespaceRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.namespaces = append(n.namespaces, r.Header.Get(NamespaceHeader))
+	_, _ = w.Write([]byte(`{"data":{}}`))
+}
+
+func (n *namespaceRecorder) last() string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.namespaces[len(n.namespaces)-1]
+}
+
+func TestNamespaceHeader(t *testing.T) {
+	recorder := &namespaceRecorder{}
+	ts := httptest.NewTLSServer(recorder)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	// no namespace configured
+	_, err := client.GetCapabilities(context.Background(), "secret/edgex")
+	require.NoError(t, err)
+	assert.Equal(t, "", recorder.last())
+
+	client.Config.Namespace = "tenant-a"
+	_, err = client.GetCapabilities(context.Background(), "secret/edgex")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", recorder.last())
+
+	_, err = client.HealthCheck(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", recorder.last(), "doRequest")
+
+	_, err = client.GetCapabilities(pkg.WithNamespace(context.Background(), "tenant-b"), "secret/edgex")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-b", recorder.last(), "per-call override")
+
+	_, err = client.GetCapabilities(pkg.WithNamespace(context.Background(), ""), "secret/edgex")
+	require.NoError(t, err)
+	assert.Equal(t, "", recorder.last(), "root namespace override")
+}
@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// ClientOption configures a Client created by NewClient
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	requester         pkg.Caller
+	lc                logger.LoggingClient
+	retryPolicy       *types.RetryPolicy
+	namespace         *string
+	authTokenRequired bool
+	metrics           pkg.Metrics
+	tracer            pkg.Tracer
+	middleware        []pkg.Middleware
+}
+
+// WithHTTPClient makes the requests with requester. Without it, or when requester is nil, an HTTP client trusting
+// the RootCaCertPath of the configuration is created with its Transport timeouts and connection pooling.
+func WithHTTPClient(requester pkg.Caller) ClientOption {
+	return func(options *clientOptions) {
+		options.requester = requester
+	}
+}
+
+// WithLogger logs with lc. Without it the log messages are discarded.
+func WithLogger(lc logger.LoggingClient) ClientOption {
+	return func(options *clientOptions) {
+		if lc != nil {
+			options.lc = lc
+		}
+	}
+}
+
+// WithRetryPolicy retries the requests which fail transiently as described by policy, overriding the Retry of the
+// configuration
+func WithRetryPolicy(policy types.RetryPolicy) ClientOption {
+	return func(options *clientOptions) {
+		options.retryPolicy = &policy
+	}
+}
+
+// WithNamespace sends the requests to namespace, overriding the Namespace of the configuration
+func WithNamespace(namespace string) ClientOption {
+	return func(options *clientOptions) {
+		options.namespace = &namespace
+	}
+}
+
+// WithAuthTokenRequired makes NewClient fail when the configuration has neither an AuthToken nor an auth method
+// to log in with, as is required to access secrets
+func WithAuthTokenRequired() ClientOption {
+	return func(options *clientOptions) {
+		options.authTokenRequired = true
+	}
+}
+
+// WithMetrics records the requests and token renewals of the client to metrics
+func WithMetrics(metrics pkg.Metrics) ClientOption {
+	return func(options *clientOptions) {
+		options.metrics = metrics
+	}
+}
+
+// WithTracer starts a span with tracer for each request of the client, propagating its trace context to the secret
+// store
+func WithTracer(tracer pkg.Tracer) ClientOption {
+	return func(options *clientOptions) {
+		options.tracer = tracer
+	}
+}
+
+// WithMiddleware wraps the HTTP client issuing the requests with the middleware, the first being the outermost
+func WithMiddleware(middleware ...pkg.Middleware) ClientOption {
+	return func(options *clientOptions) {
+		options.middleware = append(options.middleware, middleware...)
+	}
+}
+
+func newClientOptions(options []ClientOption) *clientOptions {
+	settings := &clientOptions{lc: logger.NewMockClient()}
+	for _, option := range options {
+		option(settings)
+	}
+	return settings
+}
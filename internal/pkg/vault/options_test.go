@@ -0,0 +1,168 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// flakyCaller fails the first failures requests as if the secret store was unreachable, or responds to them with
+// status when set, recording the bodies received
+type flakyCaller struct {
+	mutex    sync.Mutex
+	failures int
+	status   int
+	calls    int
+	bodies   []string
+}
+
+func (f *flakyCaller) Do(req *http.Request) (*http.Response, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls++
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		f.bodies = append(f.bodies, string(body))
+	}
+	if f.calls <= f.failures {
+		if f.status != 0 {
+			return &http.Response{StatusCode: f.status, Status: http.StatusText(f.status),
+				Body: ioutil.NopCloser(strings.NewReader(`{"errors":["failed"]}`))}, nil
+		}
+		return nil, errors.New("connect: connection refused")
+	}
+	return &http.Response{StatusCode: http.StatusNoContent, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestNewClientOptions(t *testing.T) {
+	config := types.SecretConfig{Host: "localhost", Port: 8200, Protocol: "https", Namespace: "tenant-a"}
+	caller := &flakyCaller{}
+
+	client, err := NewClient(config, WithHTTPClient(caller), WithNamespace("tenant-b"))
+	require.NoError(t, err)
+	assert.Equal(t, caller, client.HttpCaller)
+	assert.Equal(t, "tenant-b", client.Config.Namespace)
+	assert.NotNil(t, client.lc, "discarding logger by default")
+
+	client, err = NewClient(config, WithLogger(nil))
+	require.NoError(t, err)
+	require.IsType(t, &http.Client{}, client.HttpCaller)
+	assert.NotEqual(t, http.DefaultClient, client.HttpCaller, "timeouts are configured")
+	assert.Equal(t, 30*time.Second, client.HttpCaller.(*http.Client).Timeout)
+	assert.Equal(t, "tenant-a", client.Config.Namespace)
+	assert.NotNil(t, client.lc)
+
+	_, err = NewClient(config, WithAuthTokenRequired())
+	require.Error(t, err)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	config := types.SecretConfig{Host: "localhost", Port: 8200, Protocol: "https"}
+	policy := types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	tests := []struct {
+		name          string
+		failures      int
+		status        int
+		policy        types.RetryPolicy
+		expectError   bool
+		expectedCalls int
+	}{
+		{"No retries by default", 1, 0, types.RetryPolicy{}, true, 1},
+		{"Succeeds on retry", 2, 0, policy, false, 3},
+		{"Attempts exhausted", 3, 0, policy, true, 3},
+		{"Retries rate limited", 2, http.StatusTooManyRequests, policy, false, 3},
+		{"Retries sealed", 1, http.StatusServiceUnavailable, policy, false, 2},
+		{"Does not retry client errors", 1, http.StatusBadRequest, policy, true, 1},
+		{"Custom retryable status codes", 1, http.StatusTooManyRequests,
+			types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, RetryableStatusCodes: []int{}}, true, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			caller := &flakyCaller{failures: test.failures, status: test.status}
+			client, err := NewClient(config, WithHTTPClient(caller), WithRetryPolicy(test.policy))
+			require.NoError(t, err)
+
+			err = client.InstallPolicy(context.Background(), expectedToken, "edgex", `path "secret/*" { capabilities = ["read"] }`)
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedCalls, caller.calls)
+			for _, body := range caller.bodies {
+				assert.Equal(t, caller.bodies[0], body, "body is sent again on retry")
+			}
+		})
+	}
+}
+
+func TestRetryPolicyOverride(t *testing.T) {
+	config := types.SecretConfig{
+		Host:           "localhost",
+		Port:           8200,
+		Protocol:       "https",
+		Authentication: types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken},
+		Retry:          types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond},
+	}
+
+	caller := &flakyCaller{failures: 1}
+	client, err := NewClient(config, WithHTTPClient(caller))
+	require.NoError(t, err)
+	_, _, err = client.kvRequest(context.Background(), http.MethodGet, "/v1/secret/data/edgex", nil)
+	require.NoError(t, err, "configured policy retries")
+	assert.Equal(t, 2, caller.calls)
+
+	caller = &flakyCaller{failures: 1}
+	client, err = NewClient(config, WithHTTPClient(caller))
+	require.NoError(t, err)
+	ctx := pkg.WithRetryPolicy(context.Background(), types.RetryPolicy{})
+	_, _, err = client.kvRequest(ctx, http.MethodGet, "/v1/secret/data/edgex", nil)
+	require.Error(t, err, "per-call override disables retries")
+	assert.Equal(t, 1, caller.calls)
+
+	caller = &flakyCaller{failures: 5}
+	client, err = NewClient(config, WithHTTPClient(caller),
+		WithRetryPolicy(types.RetryPolicy{MaxAttempts: 10, InitialInterval: time.Hour}))
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err = client.kvRequest(ctx, http.MethodGet, "/v1/secret/data/edgex", nil)
+	require.Error(t, err, "cancelled while waiting to retry")
+	assert.Equal(t, 1, caller.calls)
+}
+
+func TestJitter(t *testing.T) {
+	assert.Equal(t, time.Second, jitter(time.Second, 0))
+	for i := 0; i < 100; i++ {
+		wait := jitter(time.Second, 0.2)
+		assert.True(t, wait >= 800*time.Millisecond && wait <= 1200*time.Millisecond, wait)
+	}
+}
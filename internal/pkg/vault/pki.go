@@ -0,0 +1,178 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnablePKIEngine mounts the PKI secrets engine at mountPoint. maxLeaseTTL is the longest validity of the CA and
+// the certificates it issues, the mount's default applies when it is empty.
+func (c *Client) EnablePKIEngine(ctx context.Context, token string, mountPoint string, maxLeaseTTL string) error {
+	parameters := EnableSecretsEngineRequest{
+		Type:        PKI,
+		Description: "service TLS certificates",
+		Config: &SecretsEngineConfig{
+			MaxLeaseTTLDuration: maxLeaseTTL,
+		},
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "update mounts for PKI",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ConfigureCA generates the CA of the PKI secrets engine at mountPoint, whose private key never leaves the secret
+// store. A root CA is generated unless ca.IssuerMount is set, in which case an intermediate CA CSR is generated,
+// signed by the CA at ca.IssuerMount and imported. The returned certificate is the new CA certificate.
+func (c *Client) ConfigureCA(ctx context.Context, token string, mountPoint string, ca types.PKICAConfig) (types.Certificate, error) {
+	if ca.CommonName == "" {
+		return types.Certificate{}, pkg.NewErrSecretStore("common name cannot be empty for configuring a CA")
+	}
+
+	request := PKICARequest{
+		CommonName: ca.CommonName,
+		TTL:        ca.TTL,
+		KeyType:    ca.KeyType,
+		KeyBits:    ca.KeyBits,
+	}
+
+	if ca.IssuerMount == "" {
+		root, err := c.pkiRequest(ctx, token, pkiPath(PKIGenerateRootAPI, mountPoint), request, "generate root CA for "+mountPoint)
+		return root.certificate, err
+	}
+
+	csr, err := c.pkiRequest(ctx, token, pkiPath(PKIGenerateCSRAPI, mountPoint), request, "generate intermediate CA CSR for "+mountPoint)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+
+	signRequest := PKICARequest{
+		CommonName: ca.CommonName,
+		TTL:        ca.TTL,
+		CSR:        csr.csr,
+		// the bundle includes the issuer's chain, which the intermediate CA needs to build its own chain
+		Format: "pem_bundle",
+	}
+	signed, err := c.pkiRequest(ctx, token, pkiPath(PKISignIntermediateAPI, ca.IssuerMount), signRequest,
+		"sign intermediate CA for "+mountPoint)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+
+	err = c.postConfig(ctx, token, pkiPath(PKISetSignedAPI, mountPoint), PKISetSignedRequest{Certificate: signed.certificate.Certificate},
+		"set signed intermediate CA for "+mountPoint)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+
+	return signed.certificate, nil
+}
+
+// CreatePKIRole creates or replaces the role named roleName of the PKI secrets engine at mountPoint
+func (c *Client) CreatePKIRole(ctx context.Context, token string, mountPoint string, roleName string, role types.PKIRole) error {
+	if roleName == "" {
+		return pkg.NewErrSecretStore("PKI role name cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(PKIRoleAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)), role,
+		"create PKI role "+roleName)
+}
+
+// IssueCertificate issues a certificate and its private key for the role named roleName of the PKI secrets engine
+// at mountPoint. The private key is only returned once, the secret store doesn't keep it.
+func (c *Client) IssueCertificate(ctx context.Context, token string, mountPoint string, roleName string, request types.CertificateRequest) (types.Certificate, error) {
+	if roleName == "" || request.CommonName == "" {
+		return types.Certificate{}, pkg.NewErrSecretStore("PKI role name and common name cannot be empty for issuing a certificate")
+	}
+
+	issueRequest := PKIIssueRequest{
+		CommonName: request.CommonName,
+		AltNames:   strings.Join(request.AltNames, ","),
+		IPSANs:     strings.Join(request.IPSANs, ","),
+		TTL:        request.TTL,
+	}
+
+	issued, err := c.pkiRequest(ctx, token, fmt.Sprintf(PKIIssueAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)),
+		issueRequest, "issue certificate for "+request.CommonName)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+
+	return issued.certificate, nil
+}
+
+// pkiResult is a certificate returned by the PKI secrets engine, or the CSR of an intermediate CA
+type pkiResult struct {
+	certificate types.Certificate
+	csr         string
+}
+
+func (c *Client) pkiRequest(ctx context.Context, token string, apiPath string, request interface{}, description string) (pkiResult, error) {
+	response := PKICertificateResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 apiPath,
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: description,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return pkiResult{}, err
+	}
+
+	data := response.Data
+	result := pkiResult{
+		certificate: types.Certificate{
+			Certificate:    data.Certificate,
+			IssuingCA:      data.IssuingCA,
+			CAChain:        data.CAChain,
+			PrivateKey:     data.PrivateKey,
+			PrivateKeyType: data.PrivateKeyType,
+			SerialNumber:   data.SerialNumber,
+		},
+		csr: data.CSR,
+	}
+	if data.Expiration > 0 {
+		result.certificate.Expiration = time.Unix(data.Expiration, 0)
+	}
+
+	return result, nil
+}
+
+func pkiPath(apiFmt string, mountPoint string) string {
+	return fmt.Sprintf(apiFmt, strings.Trim(mountPoint, "/"))
+}
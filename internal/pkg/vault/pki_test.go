@@ -0,0 +1,188 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockPKIServer is a stub Vault with a root CA mounted at "pki" and an intermediate CA mounted at "pki_int".
+// Its PEM data are placeholders naming the certificate.
+type mockPKIServer struct {
+	mutex   sync.Mutex
+	mounts  map[string]SecretsEngineConfig
+	signed  map[string]string
+	roles   map[string]types.PKIRole
+	issued  []PKIIssueRequest
+	expires int64
+}
+
+func (m *mockPKIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var response PKICertificateResponse
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/v1/"), "/", 2)
+	switch {
+	case strings.HasPrefix(r.URL.Path, MountsAPI+"/"):
+		var request EnableSecretsEngineRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.mounts[strings.TrimPrefix(r.URL.Path, MountsAPI+"/")] = *request.Config
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case parts[1] == "root/generate/internal":
+		var request PKICARequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		response.Data.Certificate = "root:" + request.CommonName
+		response.Data.IssuingCA = response.Data.Certificate
+		response.Data.SerialNumber = "01"
+		response.Data.Expiration = m.expires
+	case parts[1] == "intermediate/generate/internal":
+		var request PKICARequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		response.Data.CSR = "csr:" + request.CommonName
+	case parts[1] == "root/sign-intermediate":
+		var request PKICARequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if !strings.HasPrefix(request.CSR, "csr:") || request.Format != "pem_bundle" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response.Data.Certificate = "intermediate:" + strings.TrimPrefix(request.CSR, "csr:")
+		response.Data.IssuingCA = "root:" + parts[0]
+		response.Data.CAChain = []string{response.Data.Certificate, response.Data.IssuingCA}
+		response.Data.SerialNumber = "02"
+	case parts[1] == "intermediate/set-signed":
+		var request PKISetSignedRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.signed[parts[0]] = request.Certificate
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case strings.HasPrefix(parts[1], "roles/"):
+		var role types.PKIRole
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		m.roles[strings.TrimPrefix(parts[1], "roles/")] = role
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case strings.HasPrefix(parts[1], "issue/"):
+		if _, ok := m.roles[strings.TrimPrefix(parts[1], "issue/")]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request PKIIssueRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.issued = append(m.issued, request)
+		response.Data.Certificate = "cert:" + request.CommonName
+		response.Data.IssuingCA = m.signed[parts[0]]
+		response.Data.CAChain = []string{m.signed[parts[0]], "root:edgex"}
+		response.Data.PrivateKey = types.SecretString("key:" + request.CommonName)
+		response.Data.PrivateKeyType = "rsa"
+		response.Data.SerialNumber = "03"
+		response.Data.Expiration = m.expires
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func TestPKISecretEngine(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	mock := &mockPKIServer{
+		mounts:  make(map[string]SecretsEngineConfig),
+		signed:  make(map[string]string),
+		roles:   make(map[string]types.PKIRole),
+		expires: expiration.Unix(),
+	}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	require.NoError(t, client.EnablePKIEngine(context.Background(), expectedToken, "pki", "87600h"))
+	require.NoError(t, client.EnablePKIEngine(context.Background(), expectedToken, "pki_int", "43800h"))
+	assert.Equal(t, "87600h", mock.mounts["pki"].MaxLeaseTTLDuration)
+
+	root, err := client.ConfigureCA(context.Background(), expectedToken, "pki", types.PKICAConfig{CommonName: "edgex", TTL: 315360000})
+	require.NoError(t, err)
+	assert.Equal(t, types.Certificate{
+		Certificate:  "root:edgex",
+		IssuingCA:    "root:edgex",
+		SerialNumber: "01",
+		Expiration:   expiration,
+	}, root)
+
+	intermediate, err := client.ConfigureCA(context.Background(), expectedToken, "pki_int", types.PKICAConfig{CommonName: "edgex-int", IssuerMount: "pki"})
+	require.NoError(t, err)
+	assert.Equal(t, "intermediate:edgex-int", intermediate.Certificate)
+	assert.Equal(t, []string{"intermediate:edgex-int", "root:pki"}, intermediate.CAChain)
+	assert.Equal(t, "intermediate:edgex-int", mock.signed["pki_int"])
+
+	_, err = client.ConfigureCA(context.Background(), expectedToken, "pki", types.PKICAConfig{})
+	require.Error(t, err)
+
+	role := types.PKIRole{AllowedDomains: []string{"edgex"}, AllowSubdomains: true, ServerFlag: true, MaxTTL: 86400}
+	require.NoError(t, client.CreatePKIRole(context.Background(), expectedToken, "pki_int", "edgex-services", role))
+	assert.Equal(t, role, mock.roles["edgex-services"])
+
+	request := types.CertificateRequest{
+		CommonName: "core-data.edgex",
+		AltNames:   []string{"edgex-core-data", "localhost"},
+		IPSANs:     []string{"127.0.0.1"},
+		TTL:        3600,
+	}
+	certificate, err := client.IssueCertificate(context.Background(), expectedToken, "pki_int", "edgex-services", request)
+	require.NoError(t, err)
+	assert.Equal(t, types.Certificate{
+		Certificate:    "cert:core-data.edgex",
+		IssuingCA:      "intermediate:edgex-int",
+		CAChain:        []string{"intermediate:edgex-int", "root:edgex"},
+		PrivateKey:     "key:core-data.edgex",
+		PrivateKeyType: "rsa",
+		SerialNumber:   "03",
+		Expiration:     expiration,
+	}, certificate)
+	assert.Equal(t, []PKIIssueRequest{{
+		CommonName: "core-data.edgex",
+		AltNames:   "edgex-core-data,localhost",
+		IPSANs:     "127.0.0.1",
+		TTL:        3600,
+	}}, mock.issued)
+
+	_, err = client.IssueCertificate(context.Background(), expectedToken, "pki_int", "unknown", request)
+	require.Error(t, err)
+	_, err = client.IssueCertificate(context.Background(), expectedToken, "pki_int", "edgex-services", types.CertificateRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,112 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableRabbitMQEngine mounts the RabbitMQ secrets engine at mountPoint
+func (c *Client) EnableRabbitMQEngine(ctx context.Context, token string, mountPoint string) error {
+	parameters := EnableSecretsEngineRequest{
+		Type:        RabbitMQ,
+		Description: "dynamic RabbitMQ users",
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "update mounts for RabbitMQ",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ConfigureRabbitMQConnection configures the connection of the RabbitMQ secrets engine at mountPoint to RabbitMQ
+func (c *Client) ConfigureRabbitMQConnection(ctx context.Context, token string, mountPoint string, connection types.RabbitMQConnection) error {
+	if connection.ConnectionURI == "" || connection.Username == "" {
+		return pkg.NewErrSecretStore("RabbitMQ connection URI and username cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(RabbitMQConnectionAPI, strings.Trim(mountPoint, "/")), connection,
+		"configure RabbitMQ connection for "+mountPoint)
+}
+
+// CreateRabbitMQRole creates or replaces the role named roleName of the RabbitMQ secrets engine at mountPoint
+func (c *Client) CreateRabbitMQRole(ctx context.Context, token string, mountPoint string, roleName string, role types.RabbitMQRole) error {
+	if roleName == "" {
+		return pkg.NewErrSecretStore("RabbitMQ role name cannot be empty")
+	}
+
+	// the secret store expects the permissions as JSON encoded strings
+	request := RabbitMQRoleRequest{Tags: strings.Join(role.Tags, ",")}
+	if len(role.VHosts) > 0 {
+		vhosts, err := json.Marshal(role.VHosts)
+		if err != nil {
+			return err
+		}
+		request.VHosts = string(vhosts)
+	}
+	if len(role.VHostTopics) > 0 {
+		topics, err := json.Marshal(role.VHostTopics)
+		if err != nil {
+			return err
+		}
+		request.VHostTopics = string(topics)
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(RabbitMQRoleAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)), request,
+		"create RabbitMQ role "+roleName)
+}
+
+// GetRabbitMQCredentials creates a RabbitMQ user for the role using the client's token. The user is deleted from
+// RabbitMQ when the lease expires or is revoked.
+func (c *Client) GetRabbitMQCredentials(ctx context.Context, role string) (types.RabbitMQCredentials, error) {
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return types.RabbitMQCredentials{}, pkg.NewErrSecretStore("role cannot be empty for getting RabbitMQ credentials")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodGet, fmt.Sprintf(RabbitMQCredentialsAPI, url.PathEscape(role)), nil)
+	if err != nil {
+		return types.RabbitMQCredentials{}, err
+	}
+
+	var response RabbitMQCredentialsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return types.RabbitMQCredentials{}, err
+	}
+	c.trackLease(response.Lease)
+
+	return types.RabbitMQCredentials{
+		Username: response.Data.Username,
+		Password: response.Data.Password,
+		Lease:    response.Lease,
+	}, nil
+}
@@ -0,0 +1,126 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockRabbitMQServer is a stub Vault with the RabbitMQ secrets engine mounted at "rabbitmq"
+type mockRabbitMQServer struct {
+	mutex      sync.Mutex
+	mounted    bool
+	connection types.RabbitMQConnection
+	roles      map[string]RabbitMQRoleRequest
+}
+
+func (m *mockRabbitMQServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == MountsAPI+"/rabbitmq" && r.Method == http.MethodPost:
+		var request EnableSecretsEngineRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.mounted = request.Type == RabbitMQ
+		w.WriteHeader(http.StatusNoContent)
+	case !m.mounted:
+		w.WriteHeader(http.StatusNotFound)
+	case r.URL.Path == "/v1/rabbitmq/config/connection" && r.Method == http.MethodPost:
+		_ = json.NewDecoder(r.Body).Decode(&m.connection)
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/rabbitmq/roles/") && r.Method == http.MethodPost:
+		var role RabbitMQRoleRequest
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		m.roles[strings.TrimPrefix(r.URL.Path, "/v1/rabbitmq/roles/")] = role
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/rabbitmq/creds/") && r.Method == http.MethodGet:
+		name := strings.TrimPrefix(r.URL.Path, "/v1/rabbitmq/creds/")
+		if _, ok := m.roles[name]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"unknown role: " + name}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"lease_id":       "rabbitmq/creds/" + name + "/lease1",
+			"lease_duration": 3600,
+			"renewable":      true,
+			"data":           map[string]string{"username": name + "-user", "password": "generated"},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRabbitMQSecretEngine(t *testing.T) {
+	mock := &mockRabbitMQServer{roles: make(map[string]RabbitMQRoleRequest)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	require.NoError(t, client.EnableRabbitMQEngine(context.Background(), expectedToken, "rabbitmq"))
+
+	connection := types.RabbitMQConnection{ConnectionURI: "http://edgex-rabbitmq:15672", Username: "admin", Password: "password"}
+	require.NoError(t, client.ConfigureRabbitMQConnection(context.Background(), expectedToken, "rabbitmq", connection))
+	assert.Equal(t, connection, mock.connection)
+	require.Error(t, client.ConfigureRabbitMQConnection(context.Background(), expectedToken, "rabbitmq", types.RabbitMQConnection{}))
+
+	role := types.RabbitMQRole{
+		Tags:        []string{"management", "monitoring"},
+		VHosts:      map[string]types.RabbitMQPermissions{"/": {Configure: "^edgex\\.", Write: ".*", Read: ".*"}},
+		VHostTopics: map[string]map[string]types.RabbitMQPermissions{"/": {"amq.topic": {Write: "^edgex\\.", Read: ".*"}}},
+	}
+	require.NoError(t, client.CreateRabbitMQRole(context.Background(), expectedToken, "rabbitmq", "core-data", role))
+	assert.Equal(t, RabbitMQRoleRequest{
+		Tags:        "management,monitoring",
+		VHosts:      `{"/":{"configure":"^edgex\\.","write":".*","read":".*"}}`,
+		VHostTopics: `{"/":{"amq.topic":{"write":"^edgex\\.","read":".*"}}}`,
+	}, mock.roles["core-data"])
+	require.Error(t, client.CreateRabbitMQRole(context.Background(), expectedToken, "rabbitmq", "", role))
+
+	credentials, err := client.GetRabbitMQCredentials(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, types.RabbitMQCredentials{
+		Username: "core-data-user",
+		Password: "generated",
+		Lease:    types.Lease{LeaseId: "rabbitmq/creds/core-data/lease1", LeaseDuration: 3600, Renewable: true},
+	}, credentials)
+
+	_, err = client.GetRabbitMQCredentials(context.Background(), "unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown role")
+	_, err = client.GetRabbitMQCredentials(context.Background(), " ")
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// TakeRaftSnapshot streams a snapshot of the integrated storage to snapshot. The snapshot is only complete when no
+// error is returned.
+func (c *Client) TakeRaftSnapshot(ctx context.Context, token string, snapshot io.Writer) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 RaftSnapshotAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "take raft snapshot",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       nil,
+		ResponseWriter:       snapshot,
+	})
+
+	return err
+}
+
+// RestoreRaftSnapshot restores the integrated storage from a snapshot taken of the same cluster. The storage is
+// replaced, including the unseal keys and tokens, by the ones at the time of the snapshot.
+func (c *Client) RestoreRaftSnapshot(ctx context.Context, token string, snapshot io.Reader) error {
+	if snapshot == nil {
+		return pkg.NewErrSecretStore("raft snapshot cannot be nil")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 RaftSnapshotAPI,
+		JSONObject:           nil,
+		BodyReader:           snapshot,
+		OperationDescription: "restore raft snapshot",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	}, WithHeader("Content-Type", "application/octet-stream"))
+
+	return err
+}
+
+// ListRaftPeers returns the nodes of the raft cluster
+func (c *Client) ListRaftPeers(ctx context.Context, token string) ([]types.RaftPeer, error) {
+	response := RaftConfigurationResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 RaftConfigurationAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "list raft peers",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Config.Servers, nil
+}
+
+// RemoveRaftPeer removes the node with nodeId from the raft cluster, i.e. a node which was decommissioned
+func (c *Client) RemoveRaftPeer(ctx context.Context, token string, nodeId string) error {
+	if nodeId == "" {
+		return pkg.NewErrSecretStore("raft node id cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, RaftRemovePeerAPI, RaftRemovePeerRequest{ServerId: nodeId}, "remove raft peer "+nodeId)
+}
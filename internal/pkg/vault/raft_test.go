@@ -0,0 +1,123 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockRaftServer is a stub Vault with integrated storage, the snapshot being the raw storage bytes
+type mockRaftServer struct {
+	mutex   sync.Mutex
+	storage []byte
+	peers   []types.RaftPeer
+}
+
+func (m *mockRaftServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == RaftSnapshotAPI && r.Method == http.MethodGet:
+		w.Header().Set("Content-Type", "application/gzip")
+		_, _ = w.Write(m.storage)
+	case r.URL.Path == RaftSnapshotAPI && r.Method == http.MethodPost:
+		if r.Header.Get("Content-Type") != "application/octet-stream" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.storage, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == RaftConfigurationAPI && r.Method == http.MethodGet:
+		response := RaftConfigurationResponse{}
+		response.Data.Config.Servers = m.peers
+		_ = json.NewEncoder(w).Encode(response)
+	case r.URL.Path == RaftRemovePeerAPI && r.Method == http.MethodPost:
+		var request RaftRemovePeerRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		for i, peer := range m.peers {
+			if peer.NodeId == request.ServerId {
+				m.peers = append(m.peers[:i], m.peers[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRaftSnapshot(t *testing.T) {
+	mock := &mockRaftServer{storage: []byte{0x1f, 0x8b, 0x08, 0x00, 0xde, 0xad, 0xbe, 0xef}}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	snapshot := &bytes.Buffer{}
+	require.NoError(t, client.TakeRaftSnapshot(context.Background(), expectedToken, snapshot))
+	assert.Equal(t, mock.storage, snapshot.Bytes())
+	require.Error(t, client.TakeRaftSnapshot(context.Background(), "bad-token", &bytes.Buffer{}))
+
+	restored := []byte{0x1f, 0x8b, 0x08, 0x00, 0xca, 0xfe}
+	require.NoError(t, client.RestoreRaftSnapshot(context.Background(), expectedToken, bytes.NewReader(restored)))
+	assert.Equal(t, restored, mock.storage)
+	require.Error(t, client.RestoreRaftSnapshot(context.Background(), expectedToken, nil))
+}
+
+func TestRaftPeers(t *testing.T) {
+	mock := &mockRaftServer{peers: []types.RaftPeer{
+		{NodeId: "node1", Address: "vault-1:8201", Leader: true, Voter: true, ProtocolVersion: "3"},
+		{NodeId: "node2", Address: "vault-2:8201", Voter: true, ProtocolVersion: "3"},
+	}}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	peers, err := client.ListRaftPeers(context.Background(), expectedToken)
+	require.NoError(t, err)
+	require.Len(t, peers, 2)
+	assert.True(t, peers[0].Leader)
+	assert.Equal(t, "vault-2:8201", peers[1].Address)
+
+	require.NoError(t, client.RemoveRaftPeer(context.Background(), expectedToken, "node2"))
+	require.Error(t, client.RemoveRaftPeer(context.Background(), expectedToken, "node3"))
+	require.Error(t, client.RemoveRaftPeer(context.Background(), expectedToken, ""))
+
+	peers, err = client.ListRaftPeers(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.Len(t, peers, 1)
+}
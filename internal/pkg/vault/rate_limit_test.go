@@ -0,0 +1,131 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockRateLimitedVault rejects the first limited requests with status and the rate limit headers
+type mockRateLimitedVault struct {
+	mutex      sync.Mutex
+	limited    int
+	status     int
+	retryAfter string
+	calls      int
+}
+
+func (m *mockRateLimitedVault) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.calls++
+	if m.calls <= m.limited {
+		if m.retryAfter != "" {
+			w.Header().Set(RetryAfterHeader, m.retryAfter)
+			w.Header().Set(RateLimitLimitHeader, "10")
+			w.Header().Set(RateLimitRemainingHeader, "0")
+		}
+		w.WriteHeader(m.status)
+		_, _ = w.Write([]byte(`{"errors":["request path \"sys/policies/acl/edgex\": rate limit quota exceeded"]}`))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestRateLimited(t *testing.T) {
+	policy := types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, MaxInterval: time.Second}
+
+	tests := []struct {
+		name          string
+		vault         *mockRateLimitedVault
+		policy        types.RetryPolicy
+		expectLimited bool
+		expectError   bool
+		expectedCalls int
+	}{
+		{"Rate limited without retries", &mockRateLimitedVault{limited: 1, status: http.StatusTooManyRequests, retryAfter: "1"}, types.RetryPolicy{}, true, true, 1},
+		{"Rate limited until retries run out", &mockRateLimitedVault{limited: 3, status: http.StatusTooManyRequests, retryAfter: "0"}, policy, true, true, 3},
+		{"Retry after the hint", &mockRateLimitedVault{limited: 1, status: http.StatusTooManyRequests, retryAfter: "0"}, policy, false, false, 2},
+		{"Hint longer than the policy allows", &mockRateLimitedVault{limited: 1, status: http.StatusTooManyRequests, retryAfter: "60"}, policy, true, true, 1},
+		{"Unavailable with rate limit headers", &mockRateLimitedVault{limited: 1, status: http.StatusServiceUnavailable, retryAfter: "1"}, types.RetryPolicy{}, true, true, 1},
+		{"Unavailable without rate limit headers", &mockRateLimitedVault{limited: 1, status: http.StatusServiceUnavailable}, types.RetryPolicy{}, false, true, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(test.vault)
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.NewMockClient())
+			client.retryPolicy = test.policy
+
+			err := client.InstallPolicy(context.Background(), expectedToken, "edgex", `path "secret/*" { capabilities = ["read"] }`)
+			assert.Equal(t, test.expectedCalls, test.vault.calls)
+			if !test.expectError {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			limited, ok := err.(pkg.ErrRateLimited)
+			require.Equal(t, test.expectLimited, ok)
+			if ok {
+				assert.Equal(t, test.vault.status, limited.StatusCode())
+				assert.Equal(t, 10, limited.Limit())
+				assert.Equal(t, 0, limited.Remaining())
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"Seconds", "120", 2 * time.Minute, true},
+		{"HTTP date", now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{"HTTP date in the past", now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"Missing", "", 0, false},
+		{"Negative", "-1", 0, false},
+		{"Invalid", "soon", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := parseRetryAfter(test.value, now)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
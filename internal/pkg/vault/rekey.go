@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// RekeyInit starts rekeying the unseal key shares. A rekey which is already in progress must be cancelled first.
+func (c *Client) RekeyInit(ctx context.Context, request types.RekeyRequest) (types.RekeyStatus, error) {
+	if request.SecretThreshold < 1 || request.SecretShares < request.SecretThreshold {
+		return types.RekeyStatus{}, pkg.NewErrSecretStore(fmt.Sprintf(
+			"invalid rekey parameters: shares=%d threshold=%d", request.SecretShares, request.SecretThreshold))
+	}
+
+	c.lc.Infof("vault rekey strategy (SSS parameters): shares=%d threshold=%d",
+		request.SecretShares,
+		request.SecretThreshold)
+
+	return c.rekeyRequest(ctx, http.MethodPut, RekeyInitAPI, RekeyInitRequest{
+		SecretShares:        request.SecretShares,
+		SecretThreshold:     request.SecretThreshold,
+		PGPKeys:             request.PGPKeys,
+		Backup:              request.Backup,
+		RequireVerification: request.RequireVerification,
+	}, "start rekey")
+}
+
+// RekeyStatus returns the progress of the rekey in progress, if any
+func (c *Client) RekeyStatus(ctx context.Context) (types.RekeyStatus, error) {
+	return c.rekeyRequest(ctx, http.MethodGet, RekeyInitAPI, nil, "get rekey status")
+}
+
+// RekeySubmitShare submits one of the current unseal key shares. Once enough key shares are submitted the returned
+// status is complete and holds the new key shares, which replace the current ones unless verification is required.
+func (c *Client) RekeySubmitShare(ctx context.Context, key string, nonce string) (types.RekeyStatus, error) {
+	status, err := c.rekeyRequest(ctx, http.MethodPut, RekeyUpdateAPI, RekeyShareRequest{Key: key, Nonce: nonce},
+		"submit rekey key share")
+	if err != nil {
+		return status, err
+	}
+
+	if status.Complete {
+		c.lc.Info("Vault rekey key share threshold reached. New key shares generated.")
+	} else {
+		c.lc.Infof("Vault rekey key share %d/%d successfully applied.", status.Progress, status.Required)
+	}
+
+	return status, nil
+}
+
+// RekeyCancel cancels the rekey in progress, discarding the submitted key shares
+func (c *Client) RekeyCancel(ctx context.Context) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodDelete,
+		Path:                 RekeyInitAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "cancel rekey",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// RekeyVerify submits one of the new key shares to verify it. The new key shares take effect once the returned
+// status is complete.
+func (c *Client) RekeyVerify(ctx context.Context, key string, verificationNonce string) (types.RekeyVerificationStatus, error) {
+	response := types.RekeyVerificationStatus{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodPut,
+		Path:                 RekeyVerifyAPI,
+		JSONObject:           RekeyShareRequest{Key: key, Nonce: verificationNonce},
+		BodyReader:           nil,
+		OperationDescription: "verify rekey key share",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	if response.Complete {
+		c.lc.Info("Vault rekey verification complete. New key shares are in effect.")
+	}
+
+	return response, nil
+}
+
+func (c *Client) rekeyRequest(ctx context.Context, method string, apiPath string, request interface{}, description string) (types.RekeyStatus, error) {
+	response := types.RekeyStatus{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               method,
+		Path:                 apiPath,
+		JSONObject:           request,
+		BodyReader:           nil,
+		OperationDescription: description,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response, err
+}
@@ -0,0 +1,145 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockRekeyServer is a stub Vault requiring two of the current key shares to rekey, with verification
+type mockRekeyServer struct {
+	mutex    sync.Mutex
+	status   types.RekeyStatus
+	verified int
+}
+
+func (m *mockRekeyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == RekeyInitAPI && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(m.status)
+	case r.URL.Path == RekeyInitAPI && r.Method == http.MethodPut:
+		var request RekeyInitRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.status = types.RekeyStatus{
+			Nonce:                "rekey-nonce",
+			Started:              true,
+			T:                    request.SecretThreshold,
+			N:                    request.SecretShares,
+			Required:             2,
+			VerificationRequired: request.RequireVerification,
+		}
+		_ = json.NewEncoder(w).Encode(m.status)
+	case r.URL.Path == RekeyInitAPI && r.Method == http.MethodDelete:
+		m.status = types.RekeyStatus{}
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == RekeyUpdateAPI && r.Method == http.MethodPut:
+		var request RekeyShareRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if !m.status.Started || request.Nonce != m.status.Nonce {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"no rekey in progress"}})
+			return
+		}
+		m.status.Progress++
+		if m.status.Progress == m.status.Required {
+			_ = json.NewEncoder(w).Encode(types.RekeyStatus{
+				Nonce:                m.status.Nonce,
+				Complete:             true,
+				KeysBase64:           []types.SecretString{"bmV3MQ==", "bmV3Mg==", "bmV3Mw=="},
+				VerificationRequired: true,
+				VerificationNonce:    "verify-nonce",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(m.status)
+	case r.URL.Path == RekeyVerifyAPI && r.Method == http.MethodPut:
+		var request RekeyShareRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.Nonce != "verify-nonce" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.verified++
+		_ = json.NewEncoder(w).Encode(types.RekeyVerificationStatus{
+			Nonce:    request.Nonce,
+			Started:  true,
+			T:        2,
+			N:        3,
+			Progress: m.verified,
+			Complete: m.verified == 2,
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRekey(t *testing.T) {
+	mock := &mockRekeyServer{}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	_, err := client.RekeyInit(context.Background(), types.RekeyRequest{SecretShares: 1, SecretThreshold: 2})
+	require.Error(t, err, "threshold greater than shares")
+
+	status, err := client.RekeyInit(context.Background(), types.RekeyRequest{SecretShares: 3, SecretThreshold: 2, RequireVerification: true})
+	require.NoError(t, err)
+	assert.True(t, status.Started)
+	assert.Equal(t, 2, status.Required)
+
+	status, err = client.RekeySubmitShare(context.Background(), "b2xkMQ==", status.Nonce)
+	require.NoError(t, err)
+	assert.False(t, status.Complete)
+	assert.Equal(t, 1, status.Progress)
+
+	status, err = client.RekeyStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Progress)
+
+	status, err = client.RekeySubmitShare(context.Background(), "b2xkMg==", status.Nonce)
+	require.NoError(t, err)
+	require.True(t, status.Complete)
+	assert.Len(t, status.KeysBase64, 3)
+	assert.True(t, status.VerificationRequired)
+
+	verification, err := client.RekeyVerify(context.Background(), status.KeysBase64[0].Reveal(), status.VerificationNonce)
+	require.NoError(t, err)
+	assert.False(t, verification.Complete)
+	verification, err = client.RekeyVerify(context.Background(), status.KeysBase64[1].Reveal(), status.VerificationNonce)
+	require.NoError(t, err)
+	assert.True(t, verification.Complete)
+	_, err = client.RekeyVerify(context.Background(), status.KeysBase64[2].Reveal(), "wrong-nonce")
+	require.Error(t, err)
+
+	require.NoError(t, client.RekeyCancel(context.Background()))
+	_, err = client.RekeySubmitShare(context.Background(), "b2xkMQ==", "rekey-nonce")
+	require.Error(t, err, "rekey cancelled")
+}
@@ -19,10 +19,17 @@ package vault
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 )
@@ -33,7 +40,7 @@ type RequestArgs struct {
 	AuthToken string
 	// HTTP method
 	Method string
-	// URL path
+	// Absolute API path of the request, not relative to Config.Path
 	Path string
 	// If non-nil, passed to JSON serializer and included in request
 	JSONObject interface{}
@@ -45,9 +52,15 @@ type RequestArgs struct {
 	ExpectedStatusCode int
 	// If non-nil and request succeeded, response body will be serialized here (must be a pointer)
 	ResponseObject interface{}
+	// If non-nil and request succeeded, the raw response body is copied here instead, i.e. for binary downloads
+	ResponseWriter io.Writer
 }
 
-func (c *Client) doRequest(params RequestArgs) (int, error) {
+// doRequest issues the HTTP request described by params, customized by options. The request is bound to ctx so
+// that callers are able to abort a request that is hung on the secret store.
+func (c *Client) doRequest(ctx context.Context, params RequestArgs, options ...RequestOption) (int, error) {
+	settings := newRequestOptions(params, options)
+
 	if params.JSONObject != nil {
 		body, err := json.Marshal(params.JSONObject)
 		if err != nil {
@@ -57,12 +70,18 @@ func (c *Client) doRequest(params RequestArgs) (int, error) {
 		params.BodyReader = bytes.NewReader(body)
 	}
 
-	targetUrl, err := c.Config.BuildSecretsPathURL(params.Path)
+	// params.Path is the absolute API path, i.e. /v1/sys/health or /v1/auth/approle/login. It is never
+	// prefixed with Config.Path: that is the secrets path of the client, which the secret operations join
+	// themselves, and prefixing it would send the management and login requests to a path under the mount.
+	targetUrl, err := c.Config.BuildURL(params.Path)
 	if err != nil {
 		return 0, err
 	}
+	if targetUrl, err = settings.addQuery(targetUrl); err != nil {
+		return 0, err
+	}
 
-	req, err := http.NewRequest(params.Method, targetUrl, params.BodyReader)
+	req, err := http.NewRequestWithContext(ctx, params.Method, targetUrl, params.BodyReader)
 	if err != nil {
 		c.lc.Error(fmt.Sprintf("failed to create request object: %s", err.Error()))
 		return 0, err
@@ -72,7 +91,11 @@ func (c *Client) doRequest(params RequestArgs) (int, error) {
 		req.Header.Set(AuthTypeHeader, params.AuthToken)
 	}
 	req.Header.Set("Content-Type", common.ContentTypeJSON)
-	resp, err := c.HttpCaller.Do(req)
+	c.setNamespaceHeader(req)
+	for name, value := range settings.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := c.send(req)
 
 	if err != nil {
 		c.lc.Error(fmt.Sprintf("unable to make request to %s failed: %s", params.OperationDescription, err.Error()))
@@ -80,20 +103,264 @@ func (c *Client) doRequest(params RequestArgs) (int, error) {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != params.ExpectedStatusCode {
-		err := fmt.Errorf("request to %s failed with status: %s", params.OperationDescription, resp.Status)
+	if !settings.expected(resp.StatusCode) {
+		err := pkg.NewErrFromResponse("request to "+params.OperationDescription, resp.StatusCode, readErrors(resp.Body))
 		c.lc.Error(err.Error())
 		return resp.StatusCode, err
 	}
 
-	if params.ResponseObject != nil {
-		err := json.NewDecoder(resp.Body).Decode(params.ResponseObject)
+	if params.ResponseWriter != nil {
+		if _, err := io.Copy(params.ResponseWriter, resp.Body); err != nil {
+			c.lc.Error(fmt.Sprintf("failed to read response body: %s", err.Error()))
+			return resp.StatusCode, err
+		}
+	} else if resp.StatusCode != http.StatusNoContent {
+		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
-			c.lc.Error(fmt.Sprintf("failed to parse response body: %s", err.Error()))
+			c.lc.Error(fmt.Sprintf("failed to read response body: %s", err.Error()))
 			return resp.StatusCode, err
 		}
+		if params.ResponseObject != nil {
+			if err := json.Unmarshal(body, params.ResponseObject); err != nil {
+				c.lc.Error(fmt.Sprintf("failed to parse response body: %s", err.Error()))
+				return resp.StatusCode, err
+			}
+		}
+		c.handleWarnings(params.Path, body)
 	}
 
 	c.lc.Info(fmt.Sprintf("successfully made request to %s", params.OperationDescription))
 	return resp.StatusCode, nil
 }
+
+// send issues req, retrying transient failures as the retry policy of its context, or else of the client,
+// describes. A request whose body cannot be read again, i.e. a streamed snapshot, is not retried.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	metrics, tracer := c.getInstrumentation()
+	if metrics == nil && tracer == nil {
+		return c.sendWithRetries(req)
+	}
+
+	operation := requestOperation(req.Method, req.URL.Path)
+	var span pkg.Span
+	if tracer != nil {
+		var ctx context.Context
+		ctx, span = tracer.Start(req.Context(), operation)
+		req = req.WithContext(ctx)
+		tracer.Inject(ctx, req.Header)
+		span.SetAttribute("http.method", req.Method)
+		span.SetAttribute("secretstore.path", redactPath(req.URL))
+	}
+
+	start := time.Now()
+	resp, err := c.sendWithRetries(req)
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	} else if limited, ok := err.(pkg.ErrRateLimited); ok {
+		status = limited.StatusCode()
+	}
+
+	if metrics != nil {
+		metrics.RequestCompleted(operation, status, time.Since(start))
+	}
+	if span != nil {
+		if status != 0 {
+			span.SetAttribute("http.status_code", status)
+		}
+		if _, limited := err.(pkg.ErrRateLimited); limited {
+			// the secret store responded, which the status code tells
+			span.End(nil)
+		} else {
+			span.End(err)
+		}
+	}
+	return resp, err
+}
+
+func (c *Client) sendWithRetries(req *http.Request) (*http.Response, error) {
+	policy, ok := pkg.RetryPolicyFromContext(req.Context())
+	if !ok {
+		policy = c.retryPolicy
+	}
+
+	wait := policy.InitialInterval
+	if wait <= 0 {
+		wait = defaultRetryInterval
+	}
+
+	caller := c.caller()
+	for attempt := 1; ; attempt++ {
+		resp, err := caller.Do(req)
+		transient := err != nil || policy.Retryable(resp.StatusCode)
+		limited, isLimited := rateLimit(resp)
+		// waiting out a hint beyond the longest interval of the policy is left to the caller
+		tooLong := isLimited && policy.MaxInterval > 0 && limited.RetryAfter() > policy.MaxInterval
+		if !transient || tooLong || attempt >= policy.MaxAttempts || (req.Body != nil && req.GetBody == nil) {
+			if isLimited {
+				_, _ = io.Copy(ioutil.Discard, resp.Body)
+				_ = resp.Body.Close()
+				return nil, limited
+			}
+			return resp, err
+		}
+
+		reason := "unable to reach the secret store"
+		if err == nil {
+			reason = "the secret store responded " + resp.Status
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		delay := jitter(wait, policy.Jitter)
+		// a quota limited store knows best when it will accept requests again
+		if isLimited && limited.RetryAfter() > delay {
+			delay = limited.RetryAfter()
+		}
+		c.lc.Warnf("request to %s failed as %s, retrying in %v (attempt %d of %d)", req.URL.Path, reason, delay,
+			attempt+1, policy.MaxAttempts)
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		retry := req.Clone(req.Context())
+		if req.GetBody != nil {
+			if retry.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		req = retry
+
+		wait *= 2
+		if policy.MaxInterval > 0 && wait > policy.MaxInterval {
+			wait = policy.MaxInterval
+		}
+	}
+}
+
+// rateLimit reports whether resp rejected the request as rate limited, returning the typed error with the hints of
+// the Retry-After and X-Ratelimit-* headers. A 503 is only treated as rate limited when it carries such headers, as
+// Vault also responds 503 when sealed.
+func rateLimit(resp *http.Response) (pkg.ErrRateLimited, bool) {
+	if resp == nil {
+		return pkg.ErrRateLimited{}, false
+	}
+
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get(RetryAfterHeader), time.Now())
+	limit, hasLimit := parseHeaderInt(resp.Header.Get(RateLimitLimitHeader))
+	remaining, _ := parseHeaderInt(resp.Header.Get(RateLimitRemainingHeader))
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+	case resp.StatusCode == http.StatusServiceUnavailable && (hasRetryAfter || hasLimit):
+	default:
+		return pkg.ErrRateLimited{}, false
+	}
+
+	return pkg.NewErrRateLimited(resp.StatusCode, retryAfter, limit, remaining), true
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+// parseHeaderInt parses a non-negative integer header value
+func parseHeaderInt(value string) (int, bool) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return 0, false
+	}
+	return number, true
+}
+
+// readErrors reads the error strings of a failed Vault response, which has a body of {"errors": [...]}
+func readErrors(body io.Reader) []string {
+	if body == nil {
+		return nil
+	}
+
+	var response struct {
+		Errors []string `json:"errors"`
+	}
+	_ = json.NewDecoder(io.LimitReader(body, maxErrorBodySize)).Decode(&response)
+	return response.Errors
+}
+
+// jitter randomizes wait by up to fraction of it in either direction
+func jitter(wait time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return wait
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	return time.Duration(float64(wait) * (1 - fraction + 2*fraction*rand.Float64()))
+}
+
+// setTokenHeader sets the token header of req to the token of the client. No header is set without a token, which
+// is the case in agent mode as the Vault Agent adds its auto-auth token instead.
+func (c *Client) setTokenHeader(req *http.Request) {
+	token := c.authToken()
+	if token == "" {
+		return
+	}
+
+	header := c.Config.Authentication.AuthType
+	if header == "" {
+		header = AuthTypeHeader
+	}
+	req.Header.Set(header, token)
+}
+
+// setNamespaceHeader sets the namespace header of req to the namespace of its context, when overridden with
+// pkg.WithNamespace, or else to the configured namespace
+func (c *Client) setNamespaceHeader(req *http.Request) {
+	namespace, ok := pkg.NamespaceFromContext(req.Context())
+	if !ok {
+		namespace = c.Config.Namespace
+	}
+
+	if namespace != "" {
+		req.Header.Set(NamespaceHeader, namespace)
+	}
+}
+
+// postConfig posts a configuration request. Newer Vault versions respond with the written configuration and older
+// ones with no content, so both are treated as success and the response body is ignored.
+func (c *Client) postConfig(ctx context.Context, token string, apiPath string, parameters interface{}, description string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 apiPath,
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: description,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       nil,
+	}, WithExpectedStatus(http.StatusOK, http.StatusNoContent))
+
+	return err
+}
@@ -0,0 +1,101 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// RequestOption customizes a single request issued by doRequest, so that a request feature only needs to be
+// added here rather than to RequestArgs and every caller
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	headers        map[string]string
+	query          url.Values
+	expectedStatus []int
+}
+
+// WithHeader sets the header name to value, replacing the header set by doRequest if any, i.e. the Content-Type
+func WithHeader(name string, value string) RequestOption {
+	return func(options *requestOptions) {
+		options.headers[name] = value
+	}
+}
+
+// WithQueryParameter adds the query parameter name with value to the request URL
+func WithQueryParameter(name string, value string) RequestOption {
+	return func(options *requestOptions) {
+		options.query.Add(name, value)
+	}
+}
+
+// WithExpectedStatus accepts any of the status codes as success, instead of RequestArgs.ExpectedStatusCode.
+// The response object is not decoded for a 204 No Content response.
+func WithExpectedStatus(statusCodes ...int) RequestOption {
+	return func(options *requestOptions) {
+		options.expectedStatus = statusCodes
+	}
+}
+
+// WithWrapTTL asks the secret store to wrap the response in a wrapping token valid for ttl
+func WithWrapTTL(ttl time.Duration) RequestOption {
+	return WithHeader(WrapTTLHeader, fmt.Sprintf("%ds", int(ttl.Seconds())))
+}
+
+func newRequestOptions(params RequestArgs, options []RequestOption) *requestOptions {
+	settings := &requestOptions{
+		headers:        make(map[string]string),
+		query:          make(url.Values),
+		expectedStatus: []int{params.ExpectedStatusCode},
+	}
+	for _, option := range options {
+		option(settings)
+	}
+	return settings
+}
+
+func (o *requestOptions) expected(statusCode int) bool {
+	for _, expected := range o.expectedStatus {
+		if statusCode == expected {
+			return true
+		}
+	}
+	return false
+}
+
+// addQuery adds the query parameters to the query of targetUrl
+func (o *requestOptions) addQuery(targetUrl string) (string, error) {
+	if len(o.query) == 0 {
+		return targetUrl, nil
+	}
+
+	parsed, err := url.Parse(targetUrl)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsed.Query()
+	for name, values := range o.query {
+		for _, value := range values {
+			query.Add(name, value)
+		}
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,88 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestDoRequestOptions(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/echo":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query":        r.URL.Query(),
+				"wrap_ttl":     r.Header.Get(WrapTTLHeader),
+				"content_type": r.Header.Get("Content-Type"),
+			})
+		case "/v1/accepted":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	args := RequestArgs{
+		Method:               http.MethodGet,
+		OperationDescription: "test request",
+		ExpectedStatusCode:   http.StatusOK,
+	}
+
+	var response struct {
+		Query       map[string][]string `json:"query"`
+		WrapTTL     string              `json:"wrap_ttl"`
+		ContentType string              `json:"content_type"`
+	}
+	echo := args
+	echo.Path = "/v1/echo"
+	echo.ResponseObject = &response
+	_, err := client.doRequest(context.Background(), echo,
+		WithQueryParameter("list", "true"),
+		WithQueryParameter("version", "1"),
+		WithQueryParameter("version", "2"),
+		WithWrapTTL(2*time.Minute),
+		WithHeader("Content-Type", "text/plain"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"list": {"true"}, "version": {"1", "2"}}, response.Query)
+	assert.Equal(t, "120s", response.WrapTTL)
+	assert.Equal(t, "text/plain", response.ContentType)
+
+	noContent := args
+	noContent.Path = "/v1/none"
+	noContent.ResponseObject = &response
+	_, err = client.doRequest(context.Background(), noContent)
+	require.Error(t, err, "204 is not the expected status")
+	status, err := client.doRequest(context.Background(), noContent, WithExpectedStatus(http.StatusOK, http.StatusNoContent))
+	require.NoError(t, err, "204 is accepted and not decoded")
+	assert.Equal(t, http.StatusNoContent, status)
+
+	accepted := args
+	accepted.Path = "/v1/accepted"
+	status, err = client.doRequest(context.Background(), accepted, WithExpectedStatus(http.StatusOK, http.StatusNoContent))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+}
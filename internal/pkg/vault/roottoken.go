@@ -15,31 +15,34 @@
 package vault
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 )
 
-func (c *Client) RegenRootToken(keys []string) (string, error) {
+func (c *Client) RegenRootToken(ctx context.Context, keys []string) (string, error) {
 	// cancel any previous generation attempt
 	// start root token generation --> nonce, otp
 	// provide keys, nonce --> encoded token
 	// encoded token + otp --> root token
 	// caller should revoke root token when done with it
 
-	if err := c.rootTokenCancelPrevious(); err != nil {
+	if err := c.CancelGenerateRoot(ctx); err != nil {
 		c.lc.Warn(fmt.Sprintf("failed to cancel previous root token generation: %s", err.Error()))
 		// Not fatal, continue
 	}
 
-	nonce, otp, err := c.rootTokenStartGeneration()
+	status, err := c.StartGenerateRoot(ctx)
 	if err != nil {
 		c.lc.Error(fmt.Sprintf("failed to start root token generation: %s", err.Error()))
 		return "", err
 	}
 
-	encodedToken, err := c.rootTokenSubmitKeys(keys, nonce)
+	encodedToken, err := c.rootTokenSubmitKeys(ctx, keys, status.Nonce)
 	if err != nil {
 		c.lc.Error(fmt.Sprintf("failed to generate new root token: %s", err.Error()))
 		return "", err
@@ -49,7 +52,7 @@ func (c *Client) RegenRootToken(keys []string) (string, error) {
 		return "", err
 	}
 
-	newRootToken, err := c.rootTokenDecodeToken(encodedToken, otp)
+	newRootToken, err := c.DecodeRootToken(encodedToken, status.OTP)
 	if err != nil {
 		c.lc.Error(fmt.Sprintf("failed to decode root token: %s", err.Error()))
 		return "", err
@@ -58,8 +61,9 @@ func (c *Client) RegenRootToken(keys []string) (string, error) {
 	return newRootToken, nil
 }
 
-func (c *Client) rootTokenCancelPrevious() error {
-	_, err := c.doRequest(RequestArgs{
+// CancelGenerateRoot cancels the root token generation in progress, discarding the submitted key shares
+func (c *Client) CancelGenerateRoot(ctx context.Context) error {
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            "",
 		Method:               http.MethodDelete,
 		Path:                 RootTokenControlAPI,
@@ -72,10 +76,12 @@ func (c *Client) rootTokenCancelPrevious() error {
 	return err
 }
 
-func (c *Client) rootTokenStartGeneration() (string, string, error) {
-	response := &RootTokenControlResponse{}
+// StartGenerateRoot starts a root token generation. The returned status holds the nonce to submit the key shares
+// with and the one-time password which DecodeRootToken needs to decode the generated root token.
+func (c *Client) StartGenerateRoot(ctx context.Context) (types.GenerateRootStatus, error) {
+	response := types.GenerateRootStatus{}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            "",
 		Method:               http.MethodPut,
 		Path:                 RootTokenControlAPI,
@@ -83,37 +89,22 @@ func (c *Client) rootTokenStartGeneration() (string, string, error) {
 		BodyReader:           nil,
 		OperationDescription: "start root token generation",
 		ExpectedStatusCode:   http.StatusOK,
-		ResponseObject:       response,
+		ResponseObject:       &response,
 	})
 
-	return response.Nonce, response.Otp, err
+	return response, err
 }
 
-func (c *Client) rootTokenSubmitKeys(keys []string, nonce string) (string, error) {
-	var encodedToken string
-
-	for _, key := range keys {
-		complete, token, err := c.rootTokenSubmitKey(key, nonce)
-		if err != nil {
-			c.lc.Error(fmt.Sprintf("root token retrieval aborted due to error: %s", err.Error()))
-			return "", err
-		} else if complete {
-			encodedToken = token
-			break
-		}
-	}
-
-	return encodedToken, nil
-}
-
-func (c *Client) rootTokenSubmitKey(key string, nonce string) (bool, string, error) {
+// SubmitGenerateRootShare submits one of the unseal key shares. Once enough key shares are submitted the returned
+// status is complete and holds the encoded root token.
+func (c *Client) SubmitGenerateRootShare(ctx context.Context, key string, nonce string) (types.GenerateRootStatus, error) {
 	params := RootTokenRetrievalRequest{
-		Key:   key,
+		Key:   types.SecretString(key),
 		Nonce: nonce,
 	}
-	response := &RootTokenRetrievalResponse{}
+	response := types.GenerateRootStatus{}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            "",
 		Method:               http.MethodPut,
 		Path:                 RootTokenRetrievalAPI,
@@ -121,22 +112,31 @@ func (c *Client) rootTokenSubmitKey(key string, nonce string) (bool, string, err
 		BodyReader:           nil,
 		OperationDescription: "submit root token key share",
 		ExpectedStatusCode:   http.StatusOK,
-		ResponseObject:       response,
+		ResponseObject:       &response,
 	})
 
-	if err != nil {
-		return false, "", err
-	}
+	return response, err
+}
 
+func (c *Client) rootTokenSubmitKeys(ctx context.Context, keys []string, nonce string) (string, error) {
 	var encodedToken string
-	if response.Complete {
-		encodedToken = response.EncodedToken
+
+	for _, key := range keys {
+		status, err := c.SubmitGenerateRootShare(ctx, key, nonce)
+		if err != nil {
+			c.lc.Error(fmt.Sprintf("root token retrieval aborted due to error: %s", err.Error()))
+			return "", err
+		} else if status.Complete {
+			encodedToken = status.EncodedToken
+			break
+		}
 	}
 
-	return response.Complete, encodedToken, nil
+	return encodedToken, nil
 }
 
-func (c *Client) rootTokenDecodeToken(encodedToken string, otp string) (string, error) {
+// DecodeRootToken decodes the encoded root token of a completed root token generation with its one-time password
+func (c *Client) DecodeRootToken(encodedToken string, otp string) (string, error) {
 	// otp is base62 ascii pad cast to raw bytes
 	// encodedToken is un-padded (raw) base64
 	// XOR the otp bytes with the encoded token bytes to recover the root token
@@ -150,7 +150,7 @@ func (c *Client) rootTokenDecodeToken(encodedToken string, otp string) (string,
 	otpBytes := []byte(otp)
 
 	if len(encodedBytes) != len(otpBytes) {
-		return "", fmt.Errorf("Invalid input to rootTokenDecodeToken - array length mismatch: %d != %d", len(encodedBytes), len(otpBytes))
+		return "", fmt.Errorf("Invalid input to DecodeRootToken - array length mismatch: %d != %d", len(encodedBytes), len(otpBytes))
 	}
 
 	decodedBytes := make([]byte, len(encodedBytes))
@@ -15,6 +15,7 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -68,9 +69,74 @@ func TestRegenRootToken(t *testing.T) {
 
 	// Act
 	var rootToken string
-	rootToken, err := client.RegenRootToken([]string{"dGVzdC1rZXktMQ==", "dGVzdC1rZXktMgo="})
+	rootToken, err := client.RegenRootToken(context.Background(), []string{"dGVzdC1rZXktMQ==", "dGVzdC1rZXktMgo="})
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, "s.Z1X8YkHUgbsTs2eeTDVE6SNK", rootToken)
 }
+
+func TestGenerateRootSteps(t *testing.T) {
+	submitted := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == RootTokenControlAPI && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == RootTokenControlAPI && r.Method == http.MethodPut:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"started":    true,
+				"nonce":      "2dbd10f1-8528-6246-09e7-82b25b8aba63",
+				"required":   2,
+				"otp":        "jzEHVfxe6w0Q0yz5jQuvlQG557",
+				"otp_length": 26,
+			})
+		case r.URL.Path == RootTokenRetrievalAPI && r.Method == http.MethodPut:
+			var request RootTokenRetrievalRequest
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			if request.Nonce != "2dbd10f1-8528-6246-09e7-82b25b8aba63" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			submitted++
+			response := map[string]interface{}{"started": true, "nonce": request.Nonce, "required": 2, "progress": submitted}
+			if submitted == 2 {
+				response["complete"] = true
+				response["encoded_token"] = "GVQfeQ5eIQ5+IlczQy0JBw80ITI6FHFme3w"
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	require.NoError(t, client.CancelGenerateRoot(context.Background()))
+
+	status, err := client.StartGenerateRoot(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.Started)
+	assert.Equal(t, 2, status.Required)
+	assert.Len(t, status.OTP, status.OTPLength)
+	otp := status.OTP
+
+	_, err = client.SubmitGenerateRootShare(context.Background(), "dGVzdC1rZXktMQ==", "wrong-nonce")
+	require.Error(t, err)
+
+	status, err = client.SubmitGenerateRootShare(context.Background(), "dGVzdC1rZXktMQ==", status.Nonce)
+	require.NoError(t, err)
+	assert.False(t, status.Complete)
+	assert.Equal(t, 1, status.Progress)
+
+	status, err = client.SubmitGenerateRootShare(context.Background(), "dGVzdC1rZXktMgo=", status.Nonce)
+	require.NoError(t, err)
+	require.True(t, status.Complete)
+
+	rootToken, err := client.DecodeRootToken(status.EncodedToken, otp)
+	require.NoError(t, err)
+	assert.Equal(t, "s.Z1X8YkHUgbsTs2eeTDVE6SNK", rootToken)
+
+	_, err = client.DecodeRootToken(status.EncodedToken, "short")
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// RotateEncryptionKey installs a new encryption key for the storage barrier. Existing data keeps being readable as
+// the previous keys are kept in the keyring, new writes are encrypted with the new key.
+func (c *Client) RotateEncryptionKey(ctx context.Context, token string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 RotateAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "rotate encryption key",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+	if err == nil {
+		c.lc.Info("Vault encryption key rotated")
+	}
+
+	return err
+}
+
+// GetKeyStatus returns the term and install time of the current encryption key
+func (c *Client) GetKeyStatus(ctx context.Context, token string) (types.KeyStatus, error) {
+	response := types.KeyStatus{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 KeyStatusAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "get encryption key status",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response, err
+}
@@ -0,0 +1,76 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockRotateServer is a stub Vault counting the encryption key terms
+type mockRotateServer struct {
+	mutex sync.Mutex
+	term  int
+}
+
+func (m *mockRotateServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == RotateAPI && r.Method == http.MethodPut:
+		m.term++
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == KeyStatusAPI && r.Method == http.MethodGet:
+		_, _ = fmt.Fprintf(w, `{"term":%d,"install_time":"2026-10-14T08:00:00.123456789Z","encryptions":42}`, m.term)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRotateEncryptionKey(t *testing.T) {
+	mock := &mockRotateServer{term: 1}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	require.NoError(t, client.RotateEncryptionKey(context.Background(), expectedToken))
+	require.Error(t, client.RotateEncryptionKey(context.Background(), "bad-token"))
+
+	status, err := client.GetKeyStatus(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Term)
+	assert.Equal(t, time.Date(2026, 10, 14, 8, 0, 0, 123456789, time.UTC), status.InstallTime)
+	assert.Equal(t, int64(42), status.Encryptions)
+
+	_, err = client.GetKeyStatus(context.Background(), "bad-token")
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/runtimetokenprovider"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// runtimeTokenProvider obtains the token of the service from a runtime token provider service. The token is issued
+// rather than logged in for, so it is requested again when the client has to log in anew.
+type runtimeTokenProvider struct {
+	provider *runtimetokenprovider.Provider
+}
+
+func newRuntimeTokenProvider(c *Client, info types.RuntimeTokenProviderInfo) (*runtimeTokenProvider, error) {
+	if info.ServiceKey == "" {
+		return nil, pkg.NewErrSecretStore("RuntimeTokenProvider ServiceKey is required in config for the runtime auth method")
+	}
+
+	service, err := newRuntimeTokenService(c.Config.Transport, info)
+	if err != nil {
+		return nil, err
+	}
+
+	options := runtimetokenprovider.Options{Timeout: info.Timeout, Retry: info.Retry}
+	return &runtimeTokenProvider{
+		provider: runtimetokenprovider.NewProvider(service, info.ServiceKey, c.lc, options),
+	}, nil
+}
+
+func (p *runtimeTokenProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	token, err := p.provider.GetToken(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var expiry time.Time
+	if token.TTL > 0 {
+		expiry = time.Now().Add(token.TTL)
+	}
+	return token.ClientToken.Reveal(), expiry, nil
+}
+
+// newRuntimeTokenService returns the registered TokenService of info, or one reaching its HTTP/JSON endpoint
+func newRuntimeTokenService(transport types.TransportInfo, info types.RuntimeTokenProviderInfo) (runtimetokenprovider.TokenService, error) {
+	if info.Service != "" {
+		service, ok := runtimetokenprovider.LookupTokenService(info.Service)
+		if !ok {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("no runtime TokenService is registered as '%s'", info.Service))
+		}
+		return service, nil
+	}
+
+	if info.Host == "" {
+		return nil, pkg.NewErrSecretStore("RuntimeTokenProvider Host or Service is required in config for the runtime auth method")
+	}
+
+	protocol := info.Protocol
+	if protocol == "" {
+		protocol = "https"
+	}
+	baseURL := fmt.Sprintf("%s://%s", protocol, info.Host)
+	if info.Port != 0 {
+		baseURL = fmt.Sprintf("%s:%d", baseURL, info.Port)
+	}
+
+	var tlsConfig *tls.Config
+	if info.RootCaCertPath != "" {
+		caCert, err := ioutil.ReadFile(info.RootCaCertPath)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read the runtime token provider CA: %s", err.Error()))
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, pkg.NewErrSecretStore("no certificates found in the RootCaCertPath of the runtime token provider")
+		}
+		tlsConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	caller, err := pkg.NewHTTPClient(transport, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return runtimetokenprovider.NewHTTPTokenService(baseURL, caller), nil
+}
@@ -0,0 +1,121 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/runtimetokenprovider"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestRuntimeTokenProviderLogin(t *testing.T) {
+	var mutex sync.Mutex
+	var requests int
+	tokenProvider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		requests++
+		if requests == 1 {
+			// the token provider is still starting
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path != runtimetokenprovider.TokenAPI || r.URL.Query().Get("service_key") != "core-data" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"` + testClientToken + `","lease_duration":3600,"renewable":true}}`))
+	}))
+	defer tokenProvider.Close()
+
+	vault := httptest.NewServer(&mockAuthServer{})
+	defer vault.Close()
+
+	providerURL, err := url.Parse(tokenProvider.URL)
+	require.NoError(t, err)
+	host, port, _ := net.SplitHostPort(providerURL.Host)
+	portNum, _ := strconv.Atoi(port)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, vault, types.AuthenticationInfo{Method: AuthMethodRuntime,
+		RuntimeTokenProvider: types.RuntimeTokenProviderInfo{Protocol: "http", Host: host, Port: portNum,
+			ServiceKey: "core-data", Retry: types.RetryPolicy{InitialInterval: time.Millisecond}}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests, "the unavailable token provider is retried")
+
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+}
+
+func TestRuntimeTokenProviderService(t *testing.T) {
+	runtimetokenprovider.RegisterTokenService("test-grpc", runtimetokenprovider.TokenServiceFunc(
+		func(_ context.Context, _ string) (runtimetokenprovider.Token, error) {
+			return runtimetokenprovider.Token{ClientToken: testClientToken, TTL: time.Hour, Renewable: true}, nil
+		}))
+
+	vault := httptest.NewServer(&mockAuthServer{})
+	defer vault.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, vault, types.AuthenticationInfo{Method: AuthMethodRuntime,
+		RuntimeTokenProvider: types.RuntimeTokenProviderInfo{Service: "test-grpc", ServiceKey: "core-data"}})
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+}
+
+func TestRuntimeTokenProviderConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		info types.RuntimeTokenProviderInfo
+	}{
+		{"No service key", types.RuntimeTokenProviderInfo{Host: "localhost"}},
+		{"No host or service", types.RuntimeTokenProviderInfo{ServiceKey: "core-data"}},
+		{"Unknown service", types.RuntimeTokenProviderInfo{Service: "unknown", ServiceKey: "core-data"}},
+		{"Missing CA", types.RuntimeTokenProviderInfo{Host: "localhost", ServiceKey: "core-data", RootCaCertPath: "/missing"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewClient(types.SecretConfig{Host: "localhost", Authentication: types.AuthenticationInfo{
+				Method: AuthMethodRuntime, RuntimeTokenProvider: test.info}})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// SealStatus returns the seal status, which does not require a token so is available while Vault is sealed
+func (c *Client) SealStatus(ctx context.Context) (types.SealStatus, error) {
+	response := types.SealStatus{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodGet,
+		Path:                 SealStatusAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "get seal status",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response, err
+}
+
+// Seal seals Vault, which requires a root token or a token with sudo capability on sys/seal
+func (c *Client) Seal(ctx context.Context, token string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPut,
+		Path:                 SealAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "seal secret store",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+	if err == nil {
+		c.lc.Info("Vault sealed")
+	}
+
+	return err
+}
@@ -0,0 +1,90 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockSealServer is a stub unsealed Vault which can be sealed
+type mockSealServer struct {
+	mutex  sync.Mutex
+	sealed bool
+}
+
+func (m *mockSealServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == SealStatusAPI && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(types.SealStatus{
+			Type:        "shamir",
+			Initialized: true,
+			Sealed:      m.sealed,
+			T:           3,
+			N:           5,
+			Version:     "1.9.3",
+			ClusterName: "vault-cluster",
+		})
+	case r.URL.Path == SealAPI && r.Method == http.MethodPut:
+		if r.Header.Get(AuthTypeHeader) != expectedToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		m.sealed = true
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestSealStatusAndSeal(t *testing.T) {
+	mock := &mockSealServer{}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	status, err := client.SealStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, types.SealStatus{
+		Type:        "shamir",
+		Initialized: true,
+		T:           3,
+		N:           5,
+		Version:     "1.9.3",
+		ClusterName: "vault-cluster",
+	}, status)
+
+	require.Error(t, client.Seal(context.Background(), "bad-token"))
+	require.NoError(t, client.Seal(context.Background(), expectedToken))
+
+	status, err = client.SealStatus(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.Sealed)
+}
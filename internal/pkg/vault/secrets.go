@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -49,11 +50,40 @@ type vaultTokenToCancelFuncMap map[string]context.CancelFunc
 // and getting a replacement token
 // it can be nil if the caller choose not to do that
 func NewSecretsClient(ctx context.Context, config types.SecretConfig, lc logger.LoggingClient, callback pkg.TokenExpiredCallback) (*Client, error) {
-	vaultClient, err := NewClient(config, nil, true, lc)
+	vaultClient, err := NewClient(config, WithLogger(lc), WithAuthTokenRequired())
 	if err != nil {
 		return nil, err
 	}
 
+	if config.Authentication.Method == AuthMethodAgent {
+		if err := vaultClient.useAgent(ctx); err != nil {
+			return nil, err
+		}
+		if vaultClient.AgentMode() {
+			// the Vault Agent renews the token of its auto-auth
+			return vaultClient, nil
+		}
+	}
+
+	var tokenWatcher *tokenFileWatcher
+	if config.Authentication.Method == pkg.AuthMethodFile && config.Authentication.TokenFileWatchInterval >= 0 {
+		// the baseline is taken before the token is loaded, so a file replaced meanwhile is loaded again
+		tokenWatcher = newTokenFileWatcher(config.Authentication.TokenFile, config.Authentication.TokenFileWatchInterval)
+	}
+
+	if vaultClient.tokenProvider != nil {
+		if err := vaultClient.login(ctx); err != nil {
+			return nil, err
+		}
+	} else if config.Authentication.ExchangeChildToken {
+		// the configured AuthToken is only used to create the child token
+		token, _, err := vaultClient.exchangeChildToken(ctx, vaultClient.authToken())
+		if err != nil {
+			return nil, err
+		}
+		vaultClient.setAuthToken(token)
+	}
+
 	// tokenCancelFunc is an internal map with token as key and
 	// the context.cancel function as value
 	tokenCancelFunc := make(vaultTokenToCancelFuncMap)
@@ -80,61 +110,96 @@ func NewSecretsClient(ctx context.Context, config types.SecretConfig, lc logger.
 		mapMutex.Lock()
 		tokenCancelFunc[config.Authentication.AuthToken] = cancel
 		mapMutex.Unlock()
+		vaultClient.stopRenewal = cancel
+		if tokenWatcher != nil {
+			go vaultClient.watchTokenFile(cCtx, tokenWatcher)
+		}
 	}
 
 	return vaultClient, err
 }
 
-// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
-func (c *Client) GetSecrets(subPath string, keys ...string) (map[string]string, error) {
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys. Concurrent calls for the
+// same sub-path share one request to the secret store.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
 
-	// no need to retry now as the secretstore should be ready as the security bootstrapper starts in sequence now
-	data, err := c.getAllKeys(subPath)
+	// no need to retry now as the secretstore should be ready as the security bootstrapper starts in sequence now.
+	// Identical calls in flight, i.e. services reading the same secrets at startup, share one request.
+	data, err := c.secretsFlight.do(ctx, c.secretsFlightKey(ctx, subPath), func() (map[string]string, error) {
+		return c.getAllKeys(ctx, subPath)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Do not filter any of the secrets
-	if len(keys) <= 0 {
-		return data, nil
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// secretsFlightKey returns the key of the GetSecrets calls sharing a request, which are those for the same sub-path,
+// namespace and caller identity
+func (c *Client) secretsFlightKey(ctx context.Context, subPath string) string {
+	namespace, ok := pkg.NamespaceFromContext(ctx)
+	if !ok {
+		namespace = c.Config.Namespace
 	}
+	identity, _ := pkg.CallerIdentityFromContext(ctx)
 
-	values := make(map[string]string)
-	var notFound []string
+	return strings.Join([]string{namespace, identity, subPath}, "\x00")
+}
 
-	for _, key := range keys {
-		value, success := data[key]
-		if !success {
-			notFound = append(notFound, key)
-			continue
-		}
+// StoreSecrets stores the secrets at the provided sub-path for the specified keys.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	// this interface acting as facade, just calling the internal store func on the client
+	if err := c.store(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
 
-		values[key] = value
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path, either
+// by StoreSecrets or by the KV v2 writes. Changes made by other clients are reported by the listener.Watcher.
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path with a KV LIST
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	var response ListTokenAccessorsResponse
+
+	listPath, err := c.listPath(ctx, subPath)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(notFound) > 0 {
-		return nil, pkg.NewErrSecretsNotFound(notFound)
+	statusCode, body, err := c.kvRequest(ctx, "LIST", listPath, nil)
+	if statusCode == http.StatusNotFound {
+		// the secret store responds with not found when there are no keys under the path
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return values, nil
-}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
 
-// StoreSecrets stores the secrets at the provided sub-path for the specified keys.
-func (c *Client) StoreSecrets(subPath string, secrets map[string]string) error {
-	// this interface acting as facade, just calling the internal store func on the client
-	return c.store(subPath, secrets)
+	return response.Data.Keys, nil
 }
 
 // GenerateConsulToken generates a new Consul token using serviceKey as role name to
 // call secretstore's consul/creds API
 // the serviceKey is used in the part of secretstore's URL as role name and should be accessible to the API
-func (c *Client) GenerateConsulToken(serviceKey string) (string, error) {
+func (c *Client) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
 	trimmedSrvKey := strings.TrimSpace(serviceKey)
 	if len(trimmedSrvKey) == 0 {
 		return emptyToken, pkg.NewErrSecretStore("serviceKey cannot be empty for generating Consul token")
 	}
 
-	if len(c.Config.Authentication.AuthToken) == 0 {
+	token := c.authToken()
+	if len(token) == 0 {
 		return emptyToken, pkg.NewErrSecretStore("secretestore token from config cannot be empty for generating Consul token")
 	}
 
@@ -143,14 +208,15 @@ func (c *Client) GenerateConsulToken(serviceKey string) (string, error) {
 		return emptyToken, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, credsURL, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, credsURL, http.NoBody)
 	if err != nil {
 		return emptyToken, err
 	}
 
-	req.Header.Set(AuthTypeHeader, c.Config.Authentication.AuthToken)
+	req.Header.Set(AuthTypeHeader, token)
+	c.setNamespaceHeader(req)
 
-	resp, err := c.HttpCaller.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return emptyToken, err
 	}
@@ -165,10 +231,8 @@ func (c *Client) GenerateConsulToken(serviceKey string) (string, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return emptyToken, ErrHTTPResponse{
-			StatusCode: resp.StatusCode,
-			ErrMsg:     fmt.Sprintf("failed to generate Consul token using [%s]: %s", trimmedSrvKey, string(tokenResp)),
-		}
+		return emptyToken, pkg.NewErrFromResponse(fmt.Sprintf("request to generate Consul token using [%s]", trimmedSrvKey),
+			resp.StatusCode, readErrors(bytes.NewReader(tokenResp)))
 	}
 
 	type TokenResp struct {
@@ -186,21 +250,22 @@ func (c *Client) GenerateConsulToken(serviceKey string) (string, error) {
 	return consulTokenResp.Data.Token, nil
 }
 
-func (c *Client) getTokenDetails() (*types.TokenMetadata, error) {
+func (c *Client) getTokenDetails(ctx context.Context) (*types.TokenMetadata, error) {
 	// call Vault's token self lookup API
 	url, err := c.Config.BuildURL(lookupSelfVaultAPI)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set(AuthTypeHeader, c.Config.Authentication.AuthToken)
+	req.Header.Set(AuthTypeHeader, c.authToken())
+	c.setNamespaceHeader(req)
 
-	resp, err := c.HttpCaller.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -209,10 +274,7 @@ func (c *Client) getTokenDetails() (*types.TokenMetadata, error) {
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, ErrHTTPResponse{
-			StatusCode: resp.StatusCode,
-			ErrMsg:     "failed to lookup token",
-		}
+		return nil, pkg.NewErrFromResponse("request to lookup token", resp.StatusCode, readErrors(resp.Body))
 	}
 
 	// the returned JSON structure for token self-read is TokenLookupResponse
@@ -230,21 +292,78 @@ func (c *Client) getTokenDetails() (*types.TokenMetadata, error) {
 	return &result.Data, nil
 }
 
+// LookupSelf returns the properties of the client's own token
+func (c *Client) LookupSelf(ctx context.Context) (types.TokenInfo, error) {
+	metadata, err := c.getTokenDetails(ctx)
+	if err != nil {
+		return types.TokenInfo{}, err
+	}
+
+	return newTokenInfo(*metadata)
+}
+
+// newTokenInfo parses the token lookup response. Tokens which never expire, i.e. root tokens, have no expire time.
+func newTokenInfo(metadata types.TokenMetadata) (types.TokenInfo, error) {
+	parseTime := func(name string, value string) (time.Time, error) {
+		if value == "" {
+			return time.Time{}, nil
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return time.Time{}, pkg.NewErrSecretStore(fmt.Sprintf("invalid token %s '%s': %s", name, value, err.Error()))
+		}
+		return parsed, nil
+	}
+
+	expireTime, err := parseTime("expire_time", metadata.ExpireTime)
+	if err != nil {
+		return types.TokenInfo{}, err
+	}
+	issueTime, err := parseTime("issue_time", metadata.IssueTime)
+	if err != nil {
+		return types.TokenInfo{}, err
+	}
+
+	return types.TokenInfo{
+		Accessor:       metadata.Accessor,
+		Policies:       metadata.Policies,
+		TTL:            time.Duration(metadata.Ttl) * time.Second,
+		Renewable:      metadata.Renewable,
+		Period:         time.Duration(metadata.Period) * time.Second,
+		EntityId:       metadata.EntityId,
+		ExpireTime:     expireTime,
+		IssueTime:      issueTime,
+		ExplicitMaxTTL: time.Duration(metadata.ExplicitMaxTtl) * time.Second,
+		NumUses:        metadata.NumUses,
+		Orphan:         metadata.Orphan,
+		Type:           metadata.Type,
+		DisplayName:    metadata.DisplayName,
+		Meta:           metadata.Meta,
+	}, nil
+}
+
 func (c *Client) refreshToken(ctx context.Context, tokenExpiredCallback pkg.TokenExpiredCallback) error {
-	tokenData, err := c.getTokenDetails()
+	tokenInfo, err := c.LookupSelf(ctx)
 
 	if err != nil {
 		return err
 	}
 
-	if !tokenData.Renewable {
+	if !tokenInfo.Renewable {
 		// token is not renewable, log warning and return
 		c.lc.Warn("token is not renewable from the secret store")
 		return nil
 	}
 
+	ttl := tokenInfo.TTL
+
 	// the renew interval is half of period value
-	tokenPeriod := time.Duration(tokenData.Period) * time.Second
+	tokenPeriod := tokenInfo.Period
+	if tokenPeriod == 0 && (c.tokenProvider != nil || c.Config.Authentication.ExchangeChildToken) {
+		// tokens issued by auth method logins and exchanged child tokens are usually not periodic, so they are
+		// renewed based on their TTL
+		tokenPeriod = ttl
+	}
 	renewInterval := tokenPeriod / 2
 	if renewInterval <= 0 {
 		// cannot renew, as the renew interval is non-positive
@@ -252,14 +371,12 @@ func (c *Client) refreshToken(ctx context.Context, tokenExpiredCallback pkg.Toke
 		return nil
 	}
 
-	ttl := time.Duration(tokenData.Ttl) * time.Second
-
 	// if the current time-to-live is already less than the half of period
 	// need to renew the token right away
 	if ttl <= renewInterval {
 		// call renew self api
 		c.lc.Info("ttl already <= half of the renewal period")
-		if err := c.renewToken(); err != nil {
+		if _, err := c.renewToken(ctx); err != nil {
 			return err
 		}
 	}
@@ -288,105 +405,140 @@ func (c *Client) doTokenRefreshPeriodically(renewInterval time.Duration,
 		case <-ticker.C:
 			// renew token to keep it refreshed
 			// if err happens then handle it according to the callback func tokenExpiredCallback
-			if err := c.renewToken(); err != nil {
-				if isForbidden(err) {
-					// the current token is expired,
-					// cannot renew, handle it based upon
-					// the implementation of callback from the caller if any
-					if tokenExpiredCallback == nil {
-						ticker.Stop()
-						return
-					}
-					replacementToken, retry := tokenExpiredCallback(c.Config.Authentication.AuthToken)
-					if !retry {
-						ticker.Stop()
-						return
-					}
-					c.Config.Authentication.AuthToken = replacementToken
-					c.lc.Info("auth token is replaced")
-				} else {
-					// other type of errors, cannot continue, quitting the renewal routine
-					c.lc.Errorf("dismiss the renewal process as the current token cannot be renewed: %v", err)
+			ttl, err := c.renewToken(c.context)
+			if metrics := c.getMetrics(); metrics != nil {
+				metrics.TokenRenewed(err == nil)
+			}
+			if err == nil && c.tokenProvider != nil && ttl > 0 && ttl < renewInterval {
+				// the token reached its max TTL and expires before the next renewal. A renewal which doesn't report
+				// a TTL still renewed the token, so it isn't taken as the max TTL being reached
+				err = errTokenMaxTTLReached
+			}
+			if err == nil {
+				continue
+			}
+
+			if c.tokenProvider != nil && (isForbidden(err) || err == errTokenMaxTTLReached) {
+				// the token can no longer be renewed, log in again when it was obtained from an auth method
+				if c.reauthenticate(c.context, err) == nil {
+					continue
+				}
+				if err == errTokenMaxTTLReached {
+					// the current token is still valid, so logging in is attempted again on the next renewal
+					continue
+				}
+			}
+
+			if isForbidden(err) {
+				// the current token is expired, cannot renew, handle it based upon
+				// the implementation of callback from the caller if any
+				if tokenExpiredCallback == nil {
 					ticker.Stop()
 					return
 				}
+				replacementToken, retry := tokenExpiredCallback(c.authToken())
+				if !retry {
+					ticker.Stop()
+					return
+				}
+				c.setAuthToken(replacementToken)
+				c.lc.Info("auth token is replaced")
+			} else {
+				// other type of errors, cannot continue, quitting the renewal routine
+				c.lc.Errorf("dismiss the renewal process as the current token cannot be renewed: %v", err)
+				ticker.Stop()
+				return
 			}
 		}
 	}
 }
 
-func (c *Client) renewToken() error {
+// renewToken renews the token and returns the new TTL, which is 0 when not reported by the secret store
+func (c *Client) renewToken(ctx context.Context) (time.Duration, error) {
 	// call Vault's renew self API
 	url, err := c.Config.BuildURL(renewSelfVaultAPI)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	req, err := http.NewRequest(http.MethodPost, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	req.Header.Set(AuthTypeHeader, c.Config.Authentication.AuthToken)
+	req.Header.Set(AuthTypeHeader, c.authToken())
+	c.setNamespaceHeader(req)
 
-	resp, err := c.HttpCaller.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer func() {
 		_ = resp.Body.Close()
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return ErrHTTPResponse{
-			StatusCode: resp.StatusCode,
-			ErrMsg:     "failed to renew token",
-		}
+		return 0, pkg.NewErrFromResponse("request to renew token", resp.StatusCode, readErrors(resp.Body))
 	}
 
+	response := LoginResponse{}
+	// the TTL is informational, a response without one still means the token was renewed
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
 	c.lc.Debug("token is successfully renewed")
-	return nil
+	return time.Duration(response.Auth.LeaseDuration) * time.Second, nil
 }
 
 // getAllKeys obtains all the keys that reside at the provided sub-path.
-func (c *Client) getAllKeys(subPath string) (map[string]string, error) {
-	url, err := c.Config.BuildSecretsPathURL(subPath)
+func (c *Client) getAllKeys(ctx context.Context, subPath string) (map[string]string, error) {
+	url, v2, err := c.secretsURL(ctx, subPath)
 	if err != nil {
 		return nil, err
 	}
 
 	c.lc.Debug(fmt.Sprintf("Using Secrets URL of `%s`", url))
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set(c.Config.Authentication.AuthType, c.Config.Authentication.AuthToken)
+	c.setTokenHeader(req)
 
-	if c.Config.Namespace != "" {
-		req.Header.Set(NamespaceHeader, c.Config.Namespace)
-	}
+	c.setNamespaceHeader(req)
 
-	resp, err := c.HttpCaller.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
 
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, pkg.NewErrSecretStore(fmt.Sprintf("Received a '%d' response from the secret store", resp.StatusCode))
+		return nil, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from the secret store",
+			resp.StatusCode), resp.StatusCode, readErrors(resp.Body))
 	}
 
-	defer func() {
-		_ = resp.Body.Close()
-	}()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
 
 	var result map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&result)
+	err = json.Unmarshal(body, &result)
 	if err != nil {
 		return nil, err
 	}
+	c.handleWarnings(req.URL.Path, body)
 
 	data, success := result["data"].(map[string]interface{})
+	if success && v2 {
+		// the KV v2 secret is nested in the data of the version, which is null when the version is deleted
+		data, success = data["data"].(map[string]interface{})
+	}
 	if !success || len(data) <= 0 {
 		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
 	}
@@ -400,43 +552,43 @@ func (c *Client) getAllKeys(subPath string) (map[string]string, error) {
 	return secretKeyValues, nil
 }
 
+// isForbidden reports whether the secret store refused the token, including an expired or revoked one
 func isForbidden(err error) bool {
-	if httpRespErr, ok := err.(ErrHTTPResponse); ok {
-		return httpRespErr.StatusCode == http.StatusForbidden
-	}
-	return false
+	return errors.Is(err, pkg.ErrPermissionDenied{})
 }
 
-func (c *Client) store(subPath string, secrets map[string]string) error {
+func (c *Client) store(ctx context.Context, subPath string, secrets map[string]string) error {
 	if len(secrets) == 0 {
 		// nothing to store
 		return nil
 	}
 
-	url, err := c.Config.BuildSecretsPathURL(subPath)
+	url, v2, err := c.secretsURL(ctx, subPath)
 	if err != nil {
 		return err
 	}
 
 	c.lc.Debug(fmt.Sprintf("Using Secrets URL of `%s`", url))
 
-	payload, err := json.Marshal(secrets)
+	var request interface{} = secrets
+	if v2 {
+		request = map[string]interface{}{"data": secrets}
+	}
+	payload, err := json.Marshal(request)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
 	if err != nil {
 		return err
 	}
 
-	req.Header.Set(c.Config.Authentication.AuthType, c.Config.Authentication.AuthToken)
+	c.setTokenHeader(req)
 
-	if c.Config.Namespace != "" {
-		req.Header.Set(NamespaceHeader, c.Config.Namespace)
-	}
+	c.setNamespaceHeader(req)
 
-	resp, err := c.HttpCaller.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return err
 	}
@@ -447,7 +599,8 @@ func (c *Client) store(subPath string, secrets map[string]string) error {
 	}()
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return pkg.NewErrSecretStore(fmt.Sprintf("Received a '%d' response from the secret store", resp.StatusCode))
+		return pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from the secret store",
+			resp.StatusCode), resp.StatusCode, readErrors(resp.Body))
 	}
 
 	return nil
@@ -28,6 +28,7 @@ import (
 
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"testing"
@@ -204,19 +205,19 @@ func TestMultipleTokenRenewals(t *testing.T) {
 			name:              "New secret client with expired token, no TTL remaining",
 			authToken:         "expiredToken",
 			expectError:       true,
-			expectedErrorType: ErrHTTPResponse{StatusCode: 403, ErrMsg: "forbidden"},
+			expectedErrorType: pkg.ErrPermissionDenied{},
 		},
 		{
 			name:              "New secret client with expired token, no TTL remaining",
 			authToken:         "expiredToken",
 			expectError:       true,
-			expectedErrorType: ErrHTTPResponse{StatusCode: 403, ErrMsg: "forbidden"},
+			expectedErrorType: pkg.ErrPermissionDenied{},
 		},
 		{
 			name:              "New secret client with unauthenticated token",
 			authToken:         "invalidToken",
 			expectError:       true,
-			expectedErrorType: ErrHTTPResponse{StatusCode: 403, ErrMsg: "forbidden"},
+			expectedErrorType: pkg.ErrPermissionDenied{},
 		},
 		{
 			name:              "New secret client with unrenewable token",
@@ -260,7 +261,7 @@ func TestMultipleTokenRenewals(t *testing.T) {
 			require.NoError(t, err)
 
 			// look up the token data again after renewal
-			lookupTokenData, err := client.getTokenDetails()
+			lookupTokenData, err := client.getTokenDetails(context.Background())
 			require.NoError(t, err)
 
 			if lookupTokenData != nil && lookupTokenData.Renewable &&
@@ -528,7 +529,7 @@ func TestHttpSecretStoreManager_GetValue(t *testing.T) {
 				lc:         logger.NewMockClient(),
 			}
 
-			actual, err := ssm.GetSecrets(test.path, test.keys...)
+			actual, err := ssm.GetSecrets(context.Background(), test.path, test.keys...)
 			if test.expectError {
 				require.Error(t, err)
 
@@ -653,7 +654,7 @@ func TestHttpSecretStoreManager_SetValue(t *testing.T) {
 				lc:         logger.NewMockClient(),
 			}
 
-			err := ssm.StoreSecrets(test.path, test.secrets)
+			err := ssm.StoreSecrets(context.Background(), test.path, test.secrets)
 
 			if test.expectError {
 				require.Error(t, err)
@@ -686,7 +687,7 @@ func TestHttpSecretStoreManager_SetValue(t *testing.T) {
 				keys = append(keys, k)
 			}
 
-			actual, err := ssm.GetSecrets(test.path, keys...)
+			actual, err := ssm.GetSecrets(context.Background(), test.path, keys...)
 			require.NoError(t, err)
 			for k, expected := range test.expectedValues {
 				assert.Equalf(t, expected, actual[k],
@@ -696,6 +697,60 @@ func TestHttpSecretStoreManager_SetValue(t *testing.T) {
 	}
 }
 
+func TestGetSecretsCancelledContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// hang until the client gives up on the request
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: "testToken"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetSecrets(ctx, testPath)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestListSecretPaths(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "LIST" || r.Header.Get(AuthTypeHeader) != "testToken" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/edgex/core-data":
+			_, _ = w.Write([]byte(`{"data":{"keys":["mqtt/","redisdb"]}}`))
+		case "/v1/secret/edgex/core-data/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = "/v1/secret/edgex/core-data"
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: "testToken"}
+
+	keys, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt/", "redisdb"}, keys)
+
+	keys, err = client.ListSecretPaths(context.Background(), "/unknown")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = client.ListSecretPaths(context.Background(), "/forbidden")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
 func TestGenerateConsulToken(t *testing.T) {
 	okJsonData := `{"data":{"token":"token-1", "accessor":"xxxxx"}}`
 	authToken := "auth-token"
@@ -734,7 +789,7 @@ func TestGenerateConsulToken(t *testing.T) {
 				lc:         logger.NewMockClient(),
 			}
 
-			actual, err := secretstoreClient.GenerateConsulToken(test.serviceKey)
+			actual, err := secretstoreClient.GenerateConsulToken(context.Background(), test.serviceKey)
 			if test.expectError {
 				require.Error(t, err)
 			} else {
@@ -0,0 +1,107 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// RevokeSelf revokes the client's token along with the child tokens and leases created with it, then stops the
+// renewal of the token and forgets it, after which the client must not be used
+func (c *Client) RevokeSelf(ctx context.Context) error {
+	if c.AgentMode() {
+		return pkg.NewErrSecretStore("the token of the Vault Agent is not revoked by the client")
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            c.authToken(),
+		Method:               http.MethodPost,
+		Path:                 RevokeSelfAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "revoke self token",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.lc.Info("revoked the client's token")
+	c.Destroy()
+	return nil
+}
+
+// Shutdown stops the renewal of the token and revokes the leases of the dynamic secrets obtained through the client,
+// so a terminated service doesn't leave live credentials behind. The token itself is revoked as well when
+// RevokeTokenOnShutdown is set, apart from the token of a Vault Agent. Every lease is revoked even when some
+// revocations fail, the returned error then lists the failures. The client must not be used afterwards.
+func (c *Client) Shutdown(ctx context.Context) error {
+	if c.stopRenewal != nil {
+		c.stopRenewal()
+	}
+
+	c.leasesMutex.Lock()
+	leases := c.leases
+	c.leases = nil
+	c.leasesMutex.Unlock()
+
+	var failures []string
+	for id := range leases {
+		if err := c.RevokeLease(ctx, id); err != nil {
+			failures = append(failures, fmt.Sprintf("lease '%s': %v", id, err))
+		}
+	}
+
+	if c.Config.Authentication.RevokeTokenOnShutdown && !c.AgentMode() {
+		if err := c.RevokeSelf(ctx); err != nil {
+			failures = append(failures, fmt.Sprintf("token: %v", err))
+		}
+	}
+	c.Destroy()
+
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to revoke all the credentials on shutdown: %s", strings.Join(failures, "; "))
+	}
+
+	c.lc.Debugf("revoked %d leases on shutdown", len(leases))
+	return nil
+}
+
+// trackLease records the lease of a dynamic secret for Shutdown to revoke
+func (c *Client) trackLease(lease types.Lease) {
+	if lease.LeaseId == "" {
+		return
+	}
+
+	c.leasesMutex.Lock()
+	defer c.leasesMutex.Unlock()
+	if c.leases == nil {
+		c.leases = make(map[string]bool)
+	}
+	c.leases[lease.LeaseId] = true
+}
+
+func (c *Client) untrackLease(leaseId string) {
+	c.leasesMutex.Lock()
+	defer c.leasesMutex.Unlock()
+	delete(c.leases, leaseId)
+}
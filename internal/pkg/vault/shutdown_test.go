@@ -0,0 +1,148 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockShutdownServer is a stub Vault issuing dynamic credentials and recording the revoked leases and token
+type mockShutdownServer struct {
+	mutex        sync.Mutex
+	revoked      []string
+	tokenRevoked bool
+	// failLease is the lease which fails to be revoked
+	failLease string
+}
+
+func (m *mockShutdownServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken || m.tokenRevoked {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.URL.Path {
+	case fmt.Sprintf(DatabaseCredentialsAPI, "core-data"):
+		_, _ = w.Write([]byte(`{"lease_id":"database/creds/core-data/1","lease_duration":3600,"renewable":true,
+			"data":{"username":"user","password":"password"}}`))
+	case fmt.Sprintf(GenerateConsulTokenAPI, "core-data"):
+		_, _ = w.Write([]byte(`{"lease_id":"consul/creds/core-data/1","lease_duration":3600,"renewable":true,
+			"data":{"token":"consul-token","accessor":"accessor"}}`))
+	case fmt.Sprintf(RabbitMQCredentialsAPI, "core-data"):
+		_, _ = w.Write([]byte(`{"lease_id":"rabbitmq/creds/core-data/1","lease_duration":3600,"renewable":true,
+			"data":{"username":"user","password":"password"}}`))
+	case LeaseRevokeAPI:
+		var request LeaseRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.LeaseId == m.failLease {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		m.revoked = append(m.revoked, request.LeaseId)
+		w.WriteHeader(http.StatusNoContent)
+	case RevokeSelfAPI:
+		m.tokenRevoked = true
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	tests := []struct {
+		name        string
+		revokeToken bool
+		failLease   string
+		expected    []string
+	}{
+		{"Leases", false, "", []string{"consul/creds/core-data/1", "rabbitmq/creds/core-data/1"}},
+		{"Leases and token", true, "", []string{"consul/creds/core-data/1", "rabbitmq/creds/core-data/1"}},
+		{"Failed lease", true, "consul/creds/core-data/1", []string{"rabbitmq/creds/core-data/1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockShutdownServer{failLease: test.failLease}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			client := createClient(t, server.URL, logger.NewMockClient())
+			client.Config.Authentication.AuthToken = expectedToken
+			client.Config.Authentication.RevokeTokenOnShutdown = test.revokeToken
+			renewalCtx, cancel := context.WithCancel(context.Background())
+			client.stopRenewal = cancel
+
+			ctx := context.Background()
+			database, err := client.GetDatabaseCredentials(ctx, "core-data")
+			require.NoError(t, err)
+			_, err = client.GetConsulCredentials(ctx, "core-data")
+			require.NoError(t, err)
+			_, err = client.GetRabbitMQCredentials(ctx, "core-data")
+			require.NoError(t, err)
+
+			// a lease revoked before the shutdown is not revoked again
+			require.NoError(t, client.RevokeLease(ctx, database.Lease.LeaseId))
+			mock.revoked = nil
+
+			err = client.Shutdown(ctx)
+			if test.failLease != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.failLease)
+			} else {
+				require.NoError(t, err)
+			}
+
+			sort.Strings(mock.revoked)
+			assert.Equal(t, test.expected, mock.revoked)
+			assert.Equal(t, test.revokeToken, mock.tokenRevoked)
+			assert.Error(t, renewalCtx.Err(), "token renewal not stopped")
+			assert.Empty(t, client.authToken())
+		})
+	}
+}
+
+func TestRevokeSelf(t *testing.T) {
+	mock := &mockShutdownServer{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	client := createClient(t, server.URL, logger.NewMockClient())
+	client.Config.Authentication.AuthToken = expectedToken
+
+	require.NoError(t, client.RevokeSelf(context.Background()))
+	assert.True(t, mock.tokenRevoked)
+	assert.Empty(t, client.authToken())
+
+	// the token is forgotten, so revoking it again is refused
+	require.Error(t, client.RevokeSelf(context.Background()))
+
+	client.agent = true
+	require.Error(t, client.RevokeSelf(context.Background()))
+}
@@ -0,0 +1,99 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup de-duplicates concurrent calls with the same key, so one call to the secret store serves all the
+// callers waiting for its result
+type flightGroup struct {
+	mutex sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a call in flight or completed, done is closed once its result is set
+type flightCall struct {
+	done   chan struct{}
+	result map[string]string
+	err    error
+	// canceled is set when the call failed as the context of its caller was done, which those waiting with a live
+	// context do not share
+	canceled bool
+}
+
+// do calls fn and returns a copy of its result unless a call with the same key is in flight, in which case it waits
+// for a copy of that call's result instead. The result itself is never handed out, so each caller owns its copy.
+// Waiting stops with the error of ctx when it is done. When the call in flight fails because the context of its
+// caller is done, the waiting callers try again with their own call.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (map[string]string, error)) (map[string]string, error) {
+	for {
+		g.mutex.Lock()
+		if call, ok := g.calls[key]; ok {
+			g.mutex.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-call.done:
+			}
+
+			if call.canceled && ctx.Err() == nil {
+				continue
+			}
+			return copySecrets(call.result), call.err
+		}
+
+		if g.calls == nil {
+			g.calls = make(map[string]*flightCall)
+		}
+		call := &flightCall{done: make(chan struct{})}
+		g.calls[key] = call
+		g.mutex.Unlock()
+
+		g.call(ctx, key, call, fn)
+		return copySecrets(call.result), call.err
+	}
+}
+
+// call runs fn for call, completing call even when fn panics so the waiting callers are not blocked forever
+func (g *flightGroup) call(ctx context.Context, key string, call *flightCall, fn func() (map[string]string, error)) {
+	// a panicking fn leaves the call canceled, so the waiting callers make their own call
+	call.canceled = true
+	defer func() {
+		g.mutex.Lock()
+		delete(g.calls, key)
+		g.mutex.Unlock()
+		close(call.done)
+	}()
+
+	call.result, call.err = fn()
+	call.canceled = call.err != nil && ctx.Err() != nil
+}
+
+// copySecrets copies secrets, so the callers sharing a result can each modify theirs
+func copySecrets(secrets map[string]string) map[string]string {
+	if secrets == nil {
+		return nil
+	}
+
+	copied := make(map[string]string, len(secrets))
+	for key, value := range secrets {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,197 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// newSlowSecretsServer serves the secrets of testPath once release is closed, counting the requests
+func newSlowSecretsServer(t *testing.T, release chan struct{}, requests *int32) *Client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"username":"admin","password":"secret"}}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	return client
+}
+
+func TestGetSecretsSingleFlight(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	client := newSlowSecretsServer(t, release, &requests)
+
+	const callers = 10
+	results := make([]map[string]string, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = client.GetSecrets(context.Background(), testPath)
+		}(i)
+	}
+
+	// let all the callers join the call in flight before the secrets are served
+	time.Sleep(200 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	expected := map[string]string{"username": "admin", "password": "secret"}
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, expected, results[i])
+	}
+
+	// each caller gets its own copy of the shared secrets
+	results[0]["username"] = "changed"
+	assert.Equal(t, "admin", results[1]["username"])
+}
+
+func TestGetSecretsSingleFlightKeys(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	client := newSlowSecretsServer(t, release, &requests)
+
+	contexts := []context.Context{
+		context.Background(),
+		pkg.WithNamespace(context.Background(), "tenant"),
+		pkg.WithCallerIdentity(context.Background(), "core-data"),
+	}
+	var wg sync.WaitGroup
+	for _, ctx := range contexts {
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			_, err := client.GetSecrets(ctx, testPath)
+			assert.NoError(t, err)
+		}(ctx)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// calls in other namespaces or by other callers do not share a request
+	assert.Equal(t, int32(len(contexts)), atomic.LoadInt32(&requests))
+}
+
+func TestGetSecretsSingleFlightCanceledCaller(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	client := newSlowSecretsServer(t, release, &requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error)
+	go func() {
+		_, err := client.GetSecrets(ctx, testPath)
+		leader <- err
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 1 }, time.Second, 10*time.Millisecond)
+
+	follower := make(chan error)
+	go func() {
+		_, err := client.GetSecrets(context.Background(), testPath)
+		follower <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// the follower makes its own request once the call it joined fails with its caller's context
+	cancel()
+	require.Error(t, <-leader)
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 2 }, time.Second, 10*time.Millisecond)
+	close(release)
+	assert.NoError(t, <-follower)
+}
+
+func TestConcurrentTokenSwap(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	var requests int32
+	client := newSlowSecretsServer(t, release, &requests)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.setAuthToken(expectedToken)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := client.GetSecrets(context.Background(), testPath)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, expectedToken, client.authToken())
+	// the swapped token is kept apart from the configured one
+	client.setAuthToken("")
+	assert.Empty(t, client.authToken())
+	assert.Equal(t, expectedToken, client.Config.Authentication.AuthToken)
+}
+
+func TestGetSecretsSingleFlightMutatedResults(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	client := newSlowSecretsServer(t, release, &requests)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			secrets, err := client.GetSecrets(context.Background(), testPath)
+			if !assert.NoError(t, err) {
+				return
+			}
+			// mutating a result must not race with the other callers copying theirs, which -race detects
+			assert.Equal(t, "admin", secrets["username"])
+			secrets["username"] = fmt.Sprintf("caller%d", i)
+			delete(secrets, "password")
+		}(i)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	close(release)
+	wg.Wait()
+}
@@ -0,0 +1,174 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/spiffe"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// defaultSPIFFEAudience is the audience of the JWT-SVID when none is configured
+const defaultSPIFFEAudience = "vault"
+
+// spiffeProvider logs in with the SPIFFE identity of the workload. The X.509-SVID is presented during the TLS
+// handshake by the client certificate callback of newTLSConfig, so only the JWT-SVID is fetched by the provider.
+type spiffeProvider struct {
+	client   *Client
+	info     types.SPIFFEAuthInfo
+	svidType string
+	// source is nil for the X.509-SVID
+	source spiffe.Source
+	// now abstracts the clock used to check the JWT-SVID expiry, which is most useful for testing
+	now func() time.Time
+}
+
+func newSPIFFEProvider(c *Client, info types.SPIFFEAuthInfo) (*spiffeProvider, error) {
+	svidType, err := spiffeSVIDType(info)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &spiffeProvider{client: c, info: info, svidType: svidType, now: time.Now}
+	if svidType == SVIDTypeJWT {
+		if provider.source, err = newSPIFFESource(info); err != nil {
+			return nil, err
+		}
+	}
+	return provider, nil
+}
+
+func (p *spiffeProvider) GetToken(ctx context.Context) (string, time.Time, error) {
+	mountPath := p.info.MountPath
+
+	if p.svidType == SVIDTypeX509 {
+		if mountPath == "" {
+			mountPath = AuthMethodCert
+		}
+		return p.client.loginWithMethod(ctx, mountPath, CertLoginRequest{Name: p.info.Role})
+	}
+
+	audience := p.info.Audience
+	if audience == "" {
+		audience = defaultSPIFFEAudience
+	}
+
+	jwt, err := p.source.FetchJWTSVID(ctx, audience)
+	if err != nil {
+		return "", time.Time{}, pkg.NewErrSecretStore(fmt.Sprintf("unable to fetch the JWT-SVID: %s", err.Error()))
+	}
+	if err := validateJWTClaims(jwt, "JWT-SVID", audience, p.now()); err != nil {
+		return "", time.Time{}, err
+	}
+	id, err := spiffe.IDFromJWT(jwt)
+	if err != nil {
+		return "", time.Time{}, pkg.NewErrSecretStore(err.Error())
+	}
+	if err := checkTrustDomain(id, p.info.TrustDomain); err != nil {
+		return "", time.Time{}, err
+	}
+
+	if mountPath == "" {
+		mountPath = AuthMethodJWT
+	}
+	p.client.lc.Debugf("logging in with the JWT-SVID of %s", id)
+	return p.client.loginWithMethod(ctx, mountPath, JWTLoginRequest{Role: p.info.Role, JWT: types.SecretString(jwt)})
+}
+
+// spiffeSVIDType returns the validated SVIDType of info, defaulting to the JWT-SVID
+func spiffeSVIDType(info types.SPIFFEAuthInfo) (string, error) {
+	switch info.SVIDType {
+	case "", SVIDTypeJWT:
+		return SVIDTypeJWT, nil
+	case SVIDTypeX509:
+		return SVIDTypeX509, nil
+	default:
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("invalid SPIFFE SVIDType '%s' in config, expected %s or %s",
+			info.SVIDType, SVIDTypeJWT, SVIDTypeX509))
+	}
+}
+
+// newSPIFFESource returns the registered Source of info, or a source reading the files written by spiffe-helper
+func newSPIFFESource(info types.SPIFFEAuthInfo) (spiffe.Source, error) {
+	if info.Source == "" {
+		return spiffe.NewFileSource(spiffe.FileSourceOptions{
+			JWTSVIDFile: info.JWTSVIDFile,
+			CertFile:    info.CertFile,
+			KeyFile:     info.KeyFile,
+		}), nil
+	}
+
+	source, ok := spiffe.LookupSource(info.Source)
+	if !ok {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("no SPIFFE Source is registered as '%s'", info.Source))
+	}
+	return source, nil
+}
+
+// newX509SVIDReloader returns the tls.Config.GetClientCertificate callback presenting the current X.509-SVID of the
+// source of info. The X.509-SVID is fetched once up front so that a missing SVID fails the creation of the client.
+func newX509SVIDReloader(info types.SPIFFEAuthInfo) (func(*tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
+	source, err := newSPIFFESource(info)
+	if err != nil {
+		return nil, err
+	}
+
+	fetch := func() (*tls.Certificate, error) {
+		cert, err := source.FetchX509SVID(context.Background())
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to fetch the X.509-SVID: %s", err.Error()))
+		}
+		if len(cert.Certificate) == 0 {
+			return nil, pkg.NewErrSecretStore("the X.509-SVID has no certificate")
+		}
+
+		leaf := cert.Leaf
+		if leaf == nil {
+			if leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+				return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse the X.509-SVID: %s", err.Error()))
+			}
+		}
+		id, err := spiffe.IDFromCertificate(leaf)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(err.Error())
+		}
+		if err := checkTrustDomain(id, info.TrustDomain); err != nil {
+			return nil, err
+		}
+		return cert, nil
+	}
+
+	if _, err := fetch(); err != nil {
+		return nil, err
+	}
+
+	return func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return fetch()
+	}, nil
+}
+
+// checkTrustDomain verifies the SPIFFE ID belongs to the trust domain, when one is configured
+func checkTrustDomain(id spiffe.ID, trustDomain string) error {
+	if trustDomain == "" || id.MemberOf(trustDomain) {
+		return nil
+	}
+	return pkg.NewErrSecretStore(fmt.Sprintf("SVID of %s is not in the trust domain '%s'", id, trustDomain))
+}
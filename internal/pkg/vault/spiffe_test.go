@@ -0,0 +1,217 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/spiffe"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// rotatingJWTSource is a spiffe.Source returning the JWT-SVIDs in turn, the last one once the others were fetched
+type rotatingJWTSource struct {
+	mutex     sync.Mutex
+	jwts      []string
+	audiences []string
+}
+
+func (s *rotatingJWTSource) FetchJWTSVID(_ context.Context, audience string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.audiences = append(s.audiences, audience)
+	jwt := s.jwts[0]
+	if len(s.jwts) > 1 {
+		s.jwts = s.jwts[1:]
+	}
+	return jwt, nil
+}
+
+func (s *rotatingJWTSource) FetchX509SVID(_ context.Context) (*tls.Certificate, error) {
+	return nil, nil
+}
+
+func TestSPIFFEJWTLogin(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Unix()
+	first := makeJWT(t, map[string]interface{}{"sub": "spiffe://edgex/core-data", "aud": "vault", "exp": expiry})
+	rotated := makeJWT(t, map[string]interface{}{"sub": "spiffe://edgex/core-data", "aud": "vault", "exp": expiry + 60})
+	source := &rotatingJWTSource{jwts: []string{first, rotated}}
+	spiffe.RegisterSource("test-jwt-svid", source)
+
+	var expectedJWT string
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodJWT,
+		checkLogin: func(r *http.Request) bool {
+			request := decodeLoginRequest(r)
+			return request["role"] == "core-data" && request["jwt"] == expectedJWT
+		},
+	}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodSPIFFE,
+		SPIFFE: types.SPIFFEAuthInfo{Role: "core-data", TrustDomain: "edgex", Source: "test-jwt-svid"}})
+	expectedJWT = first
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+	// the JWT-SVID rotated by SPIRE is fetched for the next login
+	expectedJWT = rotated
+	require.NoError(t, client.login(ctx))
+	assert.Equal(t, 2, mock.logins)
+	assert.Equal(t, []string{defaultSPIFFEAudience, defaultSPIFFEAudience}, source.audiences)
+}
+
+func TestSPIFFEJWTLoginInvalid(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Unix()
+	spiffe.RegisterSource("test-other-domain", &rotatingJWTSource{jwts: []string{
+		makeJWT(t, map[string]interface{}{"sub": "spiffe://other/core-data", "aud": "vault", "exp": expiry})}})
+	spiffe.RegisterSource("test-other-audience", &rotatingJWTSource{jwts: []string{
+		makeJWT(t, map[string]interface{}{"sub": "spiffe://edgex/core-data", "aud": "consul", "exp": expiry})}})
+	spiffe.RegisterSource("test-not-spiffe", &rotatingJWTSource{jwts: []string{
+		makeJWT(t, map[string]interface{}{"sub": "core-data", "aud": "vault", "exp": expiry})}})
+
+	tests := []struct {
+		name string
+		info types.SPIFFEAuthInfo
+	}{
+		{"Other trust domain", types.SPIFFEAuthInfo{TrustDomain: "edgex", Source: "test-other-domain"}},
+		{"Other audience", types.SPIFFEAuthInfo{Source: "test-other-audience"}},
+		{"Not a SPIFFE ID", types.SPIFFEAuthInfo{Source: "test-not-spiffe"}},
+		{"Unknown source", types.SPIFFEAuthInfo{Source: "unknown"}},
+		{"Missing JWT-SVID file", types.SPIFFEAuthInfo{JWTSVIDFile: filepath.Join(t.TempDir(), "missing")}},
+		{"Invalid SVIDType", types.SPIFFEAuthInfo{SVIDType: "token"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mock := &mockAuthServer{loginMethod: AuthMethodJWT, checkLogin: func(r *http.Request) bool { return true }}
+			server := httptest.NewServer(mock)
+			defer server.Close()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodSPIFFE, SPIFFE: test.info})
+			_, err := NewSecretsClient(context.Background(), config, logger.NewMockClient(), nil)
+			require.Error(t, err)
+			assert.Equal(t, 0, mock.logins, "the SVID must not be sent to Vault")
+		})
+	}
+}
+
+// writeX509SVID writes a self-signed X.509-SVID for the SPIFFE ID
+func writeX509SVID(t *testing.T, certFile string, keyFile string, id string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	uri, err := url.Parse(id)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		URIs:         []*url.URL{uri},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestSPIFFEX509Login(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "svid.pem")
+	keyFile := filepath.Join(dir, "svid_key.pem")
+	svid := writeX509SVID(t, certFile, keyFile, "spiffe://edgex/core-data")
+
+	mock := &mockAuthServer{
+		loginMethod: AuthMethodCert,
+		checkLogin: func(r *http.Request) bool {
+			request := decodeLoginRequest(r)
+			return request["name"] == "core-data" && len(r.TLS.PeerCertificates) == 1 &&
+				r.TLS.PeerCertificates[0].URIs[0].String() == "spiffe://edgex/core-data"
+		},
+	}
+	server := httptest.NewUnstartedServer(mock)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(svid)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	rootCaCertPath := filepath.Join(dir, "root-ca.crt")
+	require.NoError(t, ioutil.WriteFile(rootCaCertPath,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	info := types.SPIFFEAuthInfo{SVIDType: SVIDTypeX509, Role: "core-data", TrustDomain: "edgex",
+		CertFile: certFile, KeyFile: keyFile}
+	config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: AuthMethodSPIFFE, SPIFFE: info})
+	config.Protocol = "https"
+	config.RootCaCertPath = rootCaCertPath
+
+	client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+	secrets, err := client.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+
+	// the X.509-SVID must be in the trust domain
+	config.Authentication.SPIFFE.TrustDomain = "other"
+	_, err = NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.Error(t, err)
+
+	// the CA must be pinned to log in with the X.509-SVID
+	config.Authentication.SPIFFE.TrustDomain = ""
+	config.RootCaCertPath = ""
+	_, err = NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+	require.Error(t, err)
+}
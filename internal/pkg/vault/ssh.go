@@ -0,0 +1,116 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableSSHEngine mounts the SSH secrets engine at mountPoint
+func (c *Client) EnableSSHEngine(ctx context.Context, token string, mountPoint string) error {
+	parameters := EnableSecretsEngineRequest{
+		Type:        SSH,
+		Description: "signed SSH certificates",
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "update mounts for SSH",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ConfigureSSHCA generates the CA signing key of the SSH secrets engine at mountPoint and returns its public key,
+// which SSH servers trust through their TrustedUserCAKeys setting. keyType is i.e. "ssh-ed25519", the secret
+// store's default applies when it is empty.
+func (c *Client) ConfigureSSHCA(ctx context.Context, token string, mountPoint string, keyType string) (string, error) {
+	response := SSHCAResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 fmt.Sprintf(SSHCAConfigAPI, strings.Trim(mountPoint, "/")),
+		JSONObject:           SSHCARequest{GenerateSigningKey: true, KeyType: keyType},
+		BodyReader:           nil,
+		OperationDescription: "configure SSH CA for " + mountPoint,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response.Data.PublicKey, err
+}
+
+// CreateSSHRole creates or replaces the role named roleName of the SSH secrets engine at mountPoint, which signs
+// SSH certificates with the CA
+func (c *Client) CreateSSHRole(ctx context.Context, token string, mountPoint string, roleName string, role types.SSHRole) error {
+	if roleName == "" {
+		return pkg.NewErrSecretStore("SSH role name cannot be empty")
+	}
+	if !role.AllowUserCertificates && !role.AllowHostCertificates {
+		return pkg.NewErrSecretStore(fmt.Sprintf("SSH role '%s' must allow user or host certificates", roleName))
+	}
+
+	return c.postConfig(ctx, token, fmt.Sprintf(SSHRoleAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)),
+		SSHRoleRequest{SSHRole: role, KeyType: "ca"}, "create SSH role "+roleName)
+}
+
+// SignSSHKey signs the public key for the role named roleName of the SSH secrets engine at mountPoint
+func (c *Client) SignSSHKey(ctx context.Context, token string, mountPoint string, roleName string, request types.SSHSignRequest) (types.SSHCertificate, error) {
+	if roleName == "" || request.PublicKey == "" {
+		return types.SSHCertificate{}, pkg.NewErrSecretStore("SSH role name and public key cannot be empty for signing an SSH key")
+	}
+
+	signRequest := SSHSignRequest{
+		PublicKey:       request.PublicKey,
+		ValidPrincipals: strings.Join(request.ValidPrincipals, ","),
+		CertType:        request.CertType,
+		KeyId:           request.KeyId,
+		TTL:             request.TTL,
+	}
+
+	response := SSHSignResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 fmt.Sprintf(SSHSignAPI, strings.Trim(mountPoint, "/"), url.PathEscape(roleName)),
+		JSONObject:           signRequest,
+		BodyReader:           nil,
+		OperationDescription: "sign SSH key for " + roleName,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return types.SSHCertificate{}, err
+	}
+
+	return types.SSHCertificate{
+		SerialNumber: response.Data.SerialNumber,
+		SignedKey:    response.Data.SignedKey,
+	}, nil
+}
@@ -0,0 +1,138 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockSSHServer is a stub Vault with the SSH secrets engine mounted at "ssh"
+type mockSSHServer struct {
+	mutex   sync.Mutex
+	mounted bool
+	caKey   string
+	roles   map[string]SSHRoleRequest
+	signed  []SSHSignRequest
+}
+
+func (m *mockSSHServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.URL.Path == MountsAPI+"/ssh":
+		var request EnableSecretsEngineRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.mounted = request.Type == SSH
+		w.WriteHeader(http.StatusNoContent)
+	case !m.mounted:
+		w.WriteHeader(http.StatusNotFound)
+	case r.URL.Path == "/v1/ssh/config/ca":
+		var request SSHCARequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if !request.GenerateSigningKey {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.caKey = request.KeyType + " AAAA-ca"
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"public_key": m.caKey}})
+	case strings.HasPrefix(r.URL.Path, "/v1/ssh/roles/"):
+		var role SSHRoleRequest
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		m.roles[strings.TrimPrefix(r.URL.Path, "/v1/ssh/roles/")] = role
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, "/v1/ssh/sign/"):
+		if _, ok := m.roles[strings.TrimPrefix(r.URL.Path, "/v1/ssh/sign/")]; !ok || m.caKey == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request SSHSignRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.signed = append(m.signed, request)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{
+			"serial_number": "c73f26d2340276aa",
+			"signed_key":    "ssh-ed25519-cert-v01@openssh.com AAAA-signed",
+		}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestSSHSecretEngine(t *testing.T) {
+	mock := &mockSSHServer{roles: make(map[string]SSHRoleRequest)}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	require.NoError(t, client.EnableSSHEngine(context.Background(), expectedToken, "ssh"))
+
+	publicKey, err := client.ConfigureSSHCA(context.Background(), expectedToken, "ssh", "ssh-ed25519")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh-ed25519 AAAA-ca", publicKey)
+
+	role := types.SSHRole{
+		AllowUserCertificates: true,
+		AllowedUsers:          "edgex",
+		DefaultUser:           "edgex",
+		DefaultExtensions:     map[string]string{"permit-pty": ""},
+		TTL:                   1800,
+	}
+	require.NoError(t, client.CreateSSHRole(context.Background(), expectedToken, "ssh", "maintenance", role))
+	assert.Equal(t, SSHRoleRequest{SSHRole: role, KeyType: "ca"}, mock.roles["maintenance"])
+	require.Error(t, client.CreateSSHRole(context.Background(), expectedToken, "ssh", "maintenance", types.SSHRole{}))
+
+	request := types.SSHSignRequest{
+		PublicKey:       "ssh-ed25519 AAAA-user",
+		ValidPrincipals: []string{"edgex", "root"},
+		KeyId:           "maintainer@example.com",
+		TTL:             600,
+	}
+	certificate, err := client.SignSSHKey(context.Background(), expectedToken, "ssh", "maintenance", request)
+	require.NoError(t, err)
+	assert.Equal(t, types.SSHCertificate{
+		SerialNumber: "c73f26d2340276aa",
+		SignedKey:    "ssh-ed25519-cert-v01@openssh.com AAAA-signed",
+	}, certificate)
+	assert.Equal(t, []SSHSignRequest{{
+		PublicKey:       "ssh-ed25519 AAAA-user",
+		ValidPrincipals: "edgex,root",
+		KeyId:           "maintainer@example.com",
+		TTL:             600,
+	}}, mock.signed)
+
+	_, err = client.SignSSHKey(context.Background(), expectedToken, "ssh", "unknown", request)
+	require.Error(t, err)
+	_, err = client.SignSSHKey(context.Background(), expectedToken, "ssh", "maintenance", types.SSHSignRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,201 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// newTLSConfig builds the TLS configuration of the client, returning nil when nothing is configured so the
+// system roots are trusted with the default settings
+func newTLSConfig(config types.SecretConfig) (*tls.Config, error) {
+	certAuth := config.Authentication.Method == AuthMethodCert
+	svidAuth := config.Authentication.Method == AuthMethodSPIFFE && config.Authentication.SPIFFE.SVIDType == SVIDTypeX509
+	tlsInfo := config.TLS
+
+	if config.RootCaCertPath == "" && tlsInfo.RootCaCertPEM == "" {
+		if certAuth {
+			// the CA is pinned so the client certificate is only presented to the expected Vault server
+			return nil, pkg.NewErrSecretStore("RootCaCertPath is required in config for the cert auth method")
+		}
+		if svidAuth {
+			return nil, pkg.NewErrSecretStore("RootCaCertPath is required in config to log in with the X.509-SVID")
+		}
+		if config.ServerName == "" && tlsInfo.ClientCertPath == "" && tlsInfo.MinVersion == "" {
+			return nil, nil
+		}
+	}
+
+	minVersion, err := parseTLSVersion(tlsInfo.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: minVersion,
+		ServerName: config.ServerName,
+	}
+
+	switch {
+	case config.RootCaCertPath != "":
+		serverName := config.ServerName
+		if serverName == "" {
+			serverName = config.Host
+		}
+		roots, err := newCAReloader(config.RootCaCertPath, serverName)
+		if err != nil {
+			return nil, err
+		}
+		// the chain is verified by VerifyConnection instead, against the reloaded CA
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = roots.VerifyConnection
+	case tlsInfo.RootCaCertPEM != "":
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(tlsInfo.RootCaCertPEM)) {
+			return nil, pkg.NewErrSecretStore("no certificates found in the RootCaCertPEM of the TLS config")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if svidAuth {
+		// the X.509-SVID is the client certificate, replacing any configured for mutual TLS
+		getClientCertificate, err := newX509SVIDReloader(config.Authentication.SPIFFE)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetClientCertificate = getClientCertificate
+		return tlsConfig, nil
+	}
+
+	certPath, keyPath := tlsInfo.ClientCertPath, tlsInfo.ClientKeyPath
+	if certAuth {
+		certPath, keyPath = config.Authentication.Cert.CertPath, config.Authentication.Cert.KeyPath
+	}
+	if !certAuth && (certPath == "") != (keyPath == "") {
+		return nil, pkg.NewErrSecretStore("both ClientCertPath and ClientKeyPath are required in the TLS config for mutual TLS")
+	}
+	if certAuth || certPath != "" {
+		reloader, err := newCertReloader(certPath, keyPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+	}
+
+	return tlsConfig, nil
+}
+
+func parseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, pkg.NewErrSecretStore(fmt.Sprintf("invalid TLS MinVersion '%s' in config, expected 1.2 or 1.3", version))
+	}
+}
+
+// caReloader verifies the certificate chain of the secret store against the CA bundle of a file, reloading it when
+// the file is modified so that a rotated CA is trusted without restarting the service.
+type caReloader struct {
+	path string
+	// serverName is verified when the connection has no SNI server name, as is the case for IP addresses
+	serverName string
+	mutex      sync.Mutex
+	pool       *x509.CertPool
+	modTime    time.Time
+}
+
+func newCAReloader(path string, serverName string) (*caReloader, error) {
+	reloader := &caReloader{path: path, serverName: serverName}
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// VerifyConnection implements tls.Config.VerifyConnection, verifying the chain presented by the server and its
+// host name as the standard verification would. If a modified bundle fails to load the previous one is used.
+func (r *caReloader) VerifyConnection(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("secret store presented no certificate")
+	}
+
+	r.mutex.Lock()
+	_ = r.reload()
+	pool := r.pool
+	r.mutex.Unlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	serverName := state.ServerName
+	if serverName == "" {
+		serverName = r.serverName
+	}
+	if serverName == "" {
+		// an empty name would skip verifying the host name
+		return errors.New("no server name to verify the certificate of the secret store against")
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         pool,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// reload loads the CA bundle when the file has been modified since the last load. Must be called with the
+// mutex held, apart from during construction.
+func (r *caReloader) reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return ErrCaRootCert{path: r.path, description: err.Error()}
+	}
+
+	if r.pool != nil && info.ModTime().Equal(r.modTime) {
+		return nil
+	}
+
+	caCert, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return ErrCaRootCert{path: r.path, description: err.Error()}
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return ErrCaRootCert{path: r.path, description: "no certificates found"}
+	}
+
+	r.pool = pool
+	r.modTime = info.ModTime()
+
+	return nil
+}
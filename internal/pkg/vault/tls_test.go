@@ -0,0 +1,195 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+func encodeCert(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func newTLSTestConfig(t *testing.T, server *httptest.Server) types.SecretConfig {
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return types.SecretConfig{Protocol: "https", Host: serverURL.Hostname()}
+}
+
+func tlsGet(t *testing.T, config types.SecretConfig, server *httptest.Server) error {
+	caller, err := createHTTPClient(config)
+	require.NoError(t, err)
+	// new connections so every request is verified again
+	defer caller.(*http.Client).CloseIdleConnections()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := caller.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+func TestTLSRootCA(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	server := httptest.NewTLSServer(handler)
+	defer server.Close()
+	// the test servers share one certificate, so any other certificate is untrusted
+	other := writeClientCert(t, filepath.Join(t.TempDir(), "other.crt"), filepath.Join(t.TempDir(), "other.key"), "other", time.Now())
+
+	caPath := filepath.Join(t.TempDir(), "root-ca.crt")
+	require.NoError(t, ioutil.WriteFile(caPath, encodeCert(server.Certificate()), 0600))
+
+	config := newTLSTestConfig(t, server)
+	config.RootCaCertPath = caPath
+	require.NoError(t, tlsGet(t, config, server))
+
+	tests := []struct {
+		name   string
+		modify func(config *types.SecretConfig)
+	}{
+		{"Invalid server name", func(config *types.SecretConfig) { config.ServerName = "vault.edgex" }},
+		{"Untrusted PEM", func(config *types.SecretConfig) {
+			config.RootCaCertPath = ""
+			config.TLS.RootCaCertPEM = string(encodeCert(other))
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			invalid := config
+			test.modify(&invalid)
+			require.Error(t, tlsGet(t, invalid, server))
+		})
+	}
+
+	// certificates of the test servers are valid for example.com
+	config.ServerName = "example.com"
+	require.NoError(t, tlsGet(t, config, server))
+
+	config.RootCaCertPath = ""
+	config.TLS.RootCaCertPEM = string(encodeCert(server.Certificate()))
+	require.NoError(t, tlsGet(t, config, server))
+}
+
+func TestTLSRootCAReload(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	server := httptest.NewTLSServer(handler)
+	defer server.Close()
+	// the test servers share one certificate, so any other certificate is untrusted
+	other := writeClientCert(t, filepath.Join(t.TempDir(), "other.crt"), filepath.Join(t.TempDir(), "other.key"), "other", time.Now())
+
+	caPath := filepath.Join(t.TempDir(), "root-ca.crt")
+	modTime := time.Now().Add(-time.Minute)
+	require.NoError(t, ioutil.WriteFile(caPath, encodeCert(other), 0600))
+	require.NoError(t, os.Chtimes(caPath, modTime, modTime))
+
+	config := newTLSTestConfig(t, server)
+	config.RootCaCertPath = caPath
+	caller, err := createHTTPClient(config)
+	require.NoError(t, err)
+	client := caller.(*http.Client)
+
+	get := func() error {
+		defer client.CloseIdleConnections()
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+	require.Error(t, get())
+
+	// the rotated CA is trusted by the same client
+	require.NoError(t, ioutil.WriteFile(caPath, encodeCert(server.Certificate()), 0600))
+	require.NoError(t, get())
+
+	// an invalid bundle keeps the previous CA
+	require.NoError(t, ioutil.WriteFile(caPath, []byte("invalid"), 0600))
+	require.NoError(t, os.Chtimes(caPath, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+	require.NoError(t, get())
+}
+
+func TestTLSClientCert(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.crt")
+	keyPath := filepath.Join(dir, "client.key")
+	clientCert := writeClientCert(t, certPath, keyPath, "core-data", time.Now())
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "core-data", r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.WriteHeader(http.StatusOK)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	config := newTLSTestConfig(t, server)
+	config.TLS.RootCaCertPEM = string(encodeCert(server.Certificate()))
+	require.Error(t, tlsGet(t, config, server), "client certificate is required")
+
+	config.TLS.ClientCertPath = certPath
+	config.TLS.ClientKeyPath = keyPath
+	config.TLS.MinVersion = "1.3"
+	require.NoError(t, tlsGet(t, config, server))
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	tlsConfig, err := newTLSConfig(types.SecretConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig, "system roots with the default settings")
+
+	tlsConfig, err = newTLSConfig(types.SecretConfig{TLS: types.TLSInfo{MinVersion: "1.3"}})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+
+	tests := []struct {
+		name   string
+		config types.SecretConfig
+	}{
+		{"Invalid MinVersion", types.SecretConfig{TLS: types.TLSInfo{MinVersion: "1.1"}}},
+		{"Invalid PEM", types.SecretConfig{TLS: types.TLSInfo{RootCaCertPEM: "invalid"}}},
+		{"Missing client key", types.SecretConfig{TLS: types.TLSInfo{ClientCertPath: "client.crt"}}},
+		{"Missing CA file", types.SecretConfig{RootCaCertPath: "/non-existent-directory/rootCa.crt"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newTLSConfig(test.config)
+			require.Error(t, err)
+			if test.name != "Missing CA file" {
+				assert.IsType(t, pkg.ErrSecretStore{}, err)
+			}
+		})
+	}
+}
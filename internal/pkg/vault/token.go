@@ -15,15 +15,122 @@
 package vault
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
 
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 )
 
-func (c *Client) CreateToken(token string, parameters map[string]interface{}) (map[string]interface{}, error) {
+// CreateTokenWithRequest creates the token described by request with token, which must be permitted to create it.
+// Orphan tokens are created with the create-orphan API, which requires sudo, unless created against a role.
+func (c *Client) CreateTokenWithRequest(ctx context.Context, token string, request types.TokenCreateRequest) (types.TokenCreateResponse, error) {
+	return c.createToken(ctx, token, request)
+}
+
+func (c *Client) createToken(ctx context.Context, token string, request types.TokenCreateRequest) (types.TokenCreateResponse, error) {
+	if err := validateTokenCreateRequest(request); err != nil {
+		return types.TokenCreateResponse{}, err
+	}
+
+	parameters := map[string]interface{}{}
+	if len(request.Policies) > 0 {
+		parameters["policies"] = request.Policies
+	}
+	if request.NoDefaultPolicy {
+		parameters["no_default_policy"] = true
+	}
+	if len(request.Meta) > 0 {
+		parameters["meta"] = request.Meta
+	}
+	if request.DisplayName != "" {
+		parameters["display_name"] = request.DisplayName
+	}
+	if request.Type != "" {
+		parameters["type"] = request.Type
+	}
+	if request.Renewable != nil {
+		parameters["renewable"] = *request.Renewable
+	}
+	if request.TTL > 0 {
+		parameters["ttl"] = durationParameter(request.TTL)
+	}
+	if request.ExplicitMaxTTL > 0 {
+		parameters["explicit_max_ttl"] = durationParameter(request.ExplicitMaxTTL)
+	}
+	if request.Period > 0 {
+		parameters["period"] = durationParameter(request.Period)
+	}
+	if request.NumUses > 0 {
+		parameters["num_uses"] = request.NumUses
+	}
+	if request.EntityAlias != "" {
+		parameters["entity_alias"] = request.EntityAlias
+	}
+
+	path := CreateTokenAPI
+	switch {
+	case request.RoleName != "":
+		// roles configured with orphan always create orphans, otherwise no_parent requests one, which requires sudo
+		path = fmt.Sprintf(CreateRoleTokenAPI, url.PathEscape(request.RoleName))
+		if request.Orphan {
+			parameters["no_parent"] = true
+		}
+	case request.Orphan:
+		path = CreateOrphanTokenAPI
+	}
+
+	response := TokenCreateResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path,
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "create token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response.Auth, err
+}
+
+func validateTokenCreateRequest(request types.TokenCreateRequest) error {
+	switch request.Type {
+	case "", TokenTypeService:
+	case TokenTypeBatch:
+		if request.Period > 0 || request.NumUses > 0 || (request.Renewable != nil && *request.Renewable) {
+			return pkg.NewErrSecretStore("batch tokens can't be periodic, renewable or limited to a number of uses")
+		}
+	default:
+		return pkg.NewErrSecretStore(fmt.Sprintf("invalid token Type '%s'", request.Type))
+	}
+
+	if request.EntityAlias != "" && request.RoleName == "" {
+		return pkg.NewErrSecretStore("a token role is required to create a token with an entity alias")
+	}
+	if request.NumUses < 0 {
+		return pkg.NewErrSecretStore("token NumUses can't be negative")
+	}
+
+	return nil
+}
+
+// durationParameter formats d as the whole seconds accepted by the secret store's duration parameters
+func durationParameter(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d/time.Second))
+}
+
+// CreateToken creates a token with the raw token create API parameters and returns the raw response.
+// CreateTokenWithRequest is the typed alternative.
+func (c *Client) CreateToken(ctx context.Context, token string, parameters map[string]interface{}) (map[string]interface{}, error) {
 	response := make(map[string]interface{})
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 CreateTokenAPI,
@@ -37,10 +144,11 @@ func (c *Client) CreateToken(token string, parameters map[string]interface{}) (m
 	return response, err
 }
 
-func (c *Client) ListTokenAccessors(token string) ([]string, error) {
+// ListTokenAccessors lists the accessors of all tokens, which requires sudo on auth/token/accessors
+func (c *Client) ListTokenAccessors(ctx context.Context, token string) ([]string, error) {
 	var response ListTokenAccessorsResponse
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               "LIST",
 		Path:                 ListAccessorsAPI,
@@ -58,10 +166,11 @@ func (c *Client) ListTokenAccessors(token string) ([]string, error) {
 	return response.Data.Keys, nil
 }
 
-func (c *Client) RevokeTokenAccessor(token string, accessor string) error {
+// RevokeTokenAccessor revokes the token identified by accessor, along with its child tokens, without possessing it
+func (c *Client) RevokeTokenAccessor(ctx context.Context, token string, accessor string) error {
 	parameters := RevokeTokenAccessorRequest{Accessor: accessor}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 RevokeAccessorAPI,
@@ -75,11 +184,12 @@ func (c *Client) RevokeTokenAccessor(token string, accessor string) error {
 	return err
 }
 
-func (c *Client) LookupTokenAccessor(token string, accessor string) (types.TokenMetadata, error) {
+// LookupTokenAccessor returns the metadata of the token identified by accessor, without possessing the token
+func (c *Client) LookupTokenAccessor(ctx context.Context, token string, accessor string) (types.TokenMetadata, error) {
 	parameters := LookupAccessorRequest{Accessor: accessor}
 	response := &TokenLookupResponse{}
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 LookupAccessorAPI,
@@ -93,10 +203,10 @@ func (c *Client) LookupTokenAccessor(token string, accessor string) (types.Token
 	return response.Data, err
 }
 
-func (c *Client) LookupToken(token string) (types.TokenMetadata, error) {
+func (c *Client) LookupToken(ctx context.Context, token string) (types.TokenMetadata, error) {
 	var response TokenLookupResponse
 
-	_, err := c.doRequest(RequestArgs{
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodGet,
 		Path:                 LookupSelfAPI,
@@ -110,8 +220,8 @@ func (c *Client) LookupToken(token string) (types.TokenMetadata, error) {
 	return response.Data, err
 }
 
-func (c *Client) RevokeToken(token string) error {
-	_, err := c.doRequest(RequestArgs{
+func (c *Client) RevokeToken(ctx context.Context, token string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
 		AuthToken:            token,
 		Method:               http.MethodPost,
 		Path:                 RevokeSelfAPI,
@@ -124,3 +234,21 @@ func (c *Client) RevokeToken(token string) error {
 
 	return err
 }
+
+// RenewToken renews the token and returns its new TTL, which Vault caps at the token's max TTL
+func (c *Client) RenewToken(ctx context.Context, token string) (time.Duration, error) {
+	var response LoginResponse
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 RenewSelfAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "renew self token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return time.Duration(response.Auth.LeaseDuration) * time.Second, err
+}
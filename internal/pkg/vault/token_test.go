@@ -15,10 +15,14 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 	"github.com/stretchr/testify/assert"
@@ -58,7 +62,7 @@ func TestCreateToken(t *testing.T) {
 	// Act
 	parameters := make(map[string]interface{})
 	parameters["sample_parameter"] = "sample-value"
-	response, err := client.CreateToken(expectedToken, parameters)
+	response, err := client.CreateToken(context.Background(), expectedToken, parameters)
 
 	// Assert
 	require.NoError(t, err)
@@ -96,7 +100,7 @@ func TestListTokenAccessors(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	response, err := client.ListTokenAccessors(expectedToken)
+	response, err := client.ListTokenAccessors(context.Background(), expectedToken)
 
 	// Assert
 	require.NoError(t, err)
@@ -128,7 +132,7 @@ func TestRevokeTokenAccessor(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	err := client.RevokeTokenAccessor(expectedToken, "accessor1")
+	err := client.RevokeTokenAccessor(context.Background(), expectedToken, "accessor1")
 
 	// Assert
 	require.NoError(t, err)
@@ -155,9 +159,19 @@ func TestLookupTokenAccessor(t *testing.T) {
 			Data interface{} `json:"data"`
 		}{
 			Data: struct {
-				Accessor string `json:"accessor"`
+				Accessor    string            `json:"accessor"`
+				DisplayName string            `json:"display_name"`
+				Meta        map[string]string `json:"meta"`
+				NumUses     int               `json:"num_uses"`
+				Orphan      bool              `json:"orphan"`
+				Type        string            `json:"type"`
 			}{
-				Accessor: "accessor-value",
+				Accessor:    "accessor-value",
+				DisplayName: "token-core-data",
+				Meta:        map[string]string{"edgex-service-name": "core-data"},
+				NumUses:     0,
+				Orphan:      true,
+				Type:        "service",
 			},
 		}
 		err = json.NewEncoder(w).Encode(response)
@@ -169,11 +183,15 @@ func TestLookupTokenAccessor(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	tokenData, err := client.LookupTokenAccessor(expectedToken, "8609694a-cdbc-db9b-d345-e782dbb562ed")
+	tokenData, err := client.LookupTokenAccessor(context.Background(), expectedToken, "8609694a-cdbc-db9b-d345-e782dbb562ed")
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, "accessor-value", tokenData.Accessor)
+	assert.Equal(t, "token-core-data", tokenData.DisplayName)
+	assert.Equal(t, "core-data", tokenData.Meta["edgex-service-name"])
+	assert.True(t, tokenData.Orphan)
+	assert.Equal(t, "service", tokenData.Type)
 }
 
 func TestLookupToken(t *testing.T) {
@@ -207,7 +225,7 @@ func TestLookupToken(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	tokenData, err := client.LookupToken(expectedToken)
+	tokenData, err := client.LookupToken(context.Background(), expectedToken)
 
 	// Assert
 	require.NoError(t, err)
@@ -232,8 +250,170 @@ func TestRevokeToken(t *testing.T) {
 	client := createClient(t, ts.URL, mockLogger)
 
 	// Act
-	err := client.RevokeToken(expectedToken)
+	err := client.RevokeToken(context.Background(), expectedToken)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestRenewToken(t *testing.T) {
+	// Arrange
+	mockLogger := logger.MockLogger{}
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, RenewSelfAPI, r.URL.EscapedPath())
+		require.Equal(t, expectedToken, r.Header.Get(AuthTypeHeader))
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"` + expectedToken + `","lease_duration":3600,"renewable":true}}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, mockLogger)
+
+	// Act
+	ttl, err := client.RenewToken(context.Background(), expectedToken)
 
 	// Assert
 	require.NoError(t, err)
+	assert.Equal(t, time.Hour, ttl)
+}
+
+func TestCreateTokenWithRequest(t *testing.T) {
+	notRenewable := false
+	renewable := true
+
+	tests := []struct {
+		name         string
+		request      types.TokenCreateRequest
+		expectedPath string
+		expectedBody map[string]interface{}
+		expectError  bool
+	}{
+		{
+			name: "Service token",
+			request: types.TokenCreateRequest{Policies: []string{"core-data"}, DisplayName: "core-data",
+				Meta: map[string]string{"service": "core-data"}, Renewable: &renewable, TTL: time.Hour,
+				ExplicitMaxTTL: 24 * time.Hour, Period: 30 * time.Minute, NumUses: 5},
+			expectedPath: CreateTokenAPI,
+			expectedBody: map[string]interface{}{"policies": []interface{}{"core-data"}, "display_name": "core-data",
+				"meta": map[string]interface{}{"service": "core-data"}, "renewable": true, "ttl": "3600s",
+				"explicit_max_ttl": "86400s", "period": "1800s", "num_uses": float64(5)},
+		},
+		{
+			name:         "Batch token",
+			request:      types.TokenCreateRequest{Type: TokenTypeBatch, Renewable: &notRenewable, TTL: time.Minute},
+			expectedPath: CreateTokenAPI,
+			expectedBody: map[string]interface{}{"type": "batch", "renewable": false, "ttl": "60s"},
+		},
+		{
+			name:         "Orphan token",
+			request:      types.TokenCreateRequest{Orphan: true, NoDefaultPolicy: true},
+			expectedPath: CreateOrphanTokenAPI,
+			expectedBody: map[string]interface{}{"no_default_policy": true},
+		},
+		{
+			name:         "Role token with entity alias",
+			request:      types.TokenCreateRequest{RoleName: "edgex-service", EntityAlias: "core-data", Orphan: true},
+			expectedPath: "/v1/auth/token/create/edgex-service",
+			expectedBody: map[string]interface{}{"entity_alias": "core-data", "no_parent": true},
+		},
+		{
+			name:        "Invalid - periodic batch token",
+			request:     types.TokenCreateRequest{Type: TokenTypeBatch, Period: time.Hour},
+			expectError: true,
+		},
+		{
+			name:        "Invalid - entity alias without role",
+			request:     types.TokenCreateRequest{EntityAlias: "core-data"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid - unknown type",
+			request:     types.TokenCreateRequest{Type: "default"},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, test.expectedPath, r.URL.EscapedPath())
+				require.Equal(t, expectedToken, r.Header.Get(AuthTypeHeader))
+
+				body := make(map[string]interface{})
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, test.expectedBody, body)
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"auth":{"client_token":"s.token","accessor":"accessor","token_type":"service","orphan":true}}`))
+			}))
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.MockLogger{})
+
+			response, err := client.CreateTokenWithRequest(context.Background(), expectedToken, test.request)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "s.token", response.ClientToken.Reveal())
+			assert.Equal(t, "accessor", response.Accessor)
+			assert.True(t, response.Orphan)
+		})
+	}
+}
+
+func TestLookupSelf(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		expected    types.TokenInfo
+		expectError bool
+	}{
+		{"Service token", `{"data":{"accessor":"accessor","policies":["default","edgex-service-core-data"],"ttl":2764,
+			"renewable":true,"period":0,"entity_id":"entity","expire_time":"2026-10-14T11:35:54.466476215Z",
+			"issue_time":"2026-10-14T10:35:54.466476215Z","explicit_max_ttl":86400,"num_uses":0,"orphan":false,
+			"type":"service","display_name":"token-core-data","meta":{"service":"core-data"}}}`,
+			types.TokenInfo{Accessor: "accessor", Policies: []string{"default", "edgex-service-core-data"},
+				TTL: 2764 * time.Second, Renewable: true, EntityId: "entity",
+				ExpireTime: time.Date(2026, 10, 14, 11, 35, 54, 466476215, time.UTC),
+				IssueTime:  time.Date(2026, 10, 14, 10, 35, 54, 466476215, time.UTC), ExplicitMaxTTL: 24 * time.Hour,
+				Type: "service", DisplayName: "token-core-data", Meta: map[string]string{"service": "core-data"}}, false},
+		{"Root token", `{"data":{"accessor":"root-accessor","policies":["root"],"ttl":0,"renewable":false,
+			"expire_time":null,"issue_time":"2026-10-14T10:35:54Z","orphan":true,"type":"service"}}`,
+			types.TokenInfo{Accessor: "root-accessor", Policies: []string{"root"},
+				IssueTime: time.Date(2026, 10, 14, 10, 35, 54, 0, time.UTC), Orphan: true, Type: "service"}, false},
+		{"Periodic token", `{"data":{"ttl":1800,"renewable":true,"period":3600}}`,
+			types.TokenInfo{TTL: 30 * time.Minute, Renewable: true, Period: time.Hour}, false},
+		{"Invalid expire time", `{"data":{"expire_time":"tomorrow"}}`, types.TokenInfo{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodGet, r.Method)
+				require.Equal(t, LookupSelfAPI, r.URL.EscapedPath())
+				require.Equal(t, expectedToken, r.Header.Get(AuthTypeHeader))
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.MockLogger{})
+			client.Config.Authentication.AuthToken = expectedToken
+
+			info, err := client.LookupSelf(context.Background())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, info)
+		})
+	}
 }
@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// CreateTokenRole creates or replaces the token role named roleName
+func (c *Client) CreateTokenRole(ctx context.Context, token string, roleName string, role types.TokenRole) error {
+	if roleName == "" {
+		return pkg.NewErrSecretStore("token role name cannot be empty")
+	}
+
+	// the name is part of the path, Vault rejects it in the body
+	role.Name = ""
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 tokenRolePath(roleName),
+		JSONObject:           role,
+		BodyReader:           nil,
+		OperationDescription: "create token role " + roleName,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// GetTokenRole returns the token role named roleName
+func (c *Client) GetTokenRole(ctx context.Context, token string, roleName string) (types.TokenRole, error) {
+	response := TokenRoleResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 tokenRolePath(roleName),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "get token role " + roleName,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response.Data, err
+}
+
+// DeleteTokenRole deletes the token role named roleName. Tokens created against the role are not revoked.
+func (c *Client) DeleteTokenRole(ctx context.Context, token string, roleName string) error {
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodDelete,
+		Path:                 tokenRolePath(roleName),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "delete token role " + roleName,
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// ListTokenRoles returns the names of all the token roles
+func (c *Client) ListTokenRoles(ctx context.Context, token string) ([]string, error) {
+	var response ListTokenAccessorsResponse
+
+	statusCode, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               "LIST",
+		Path:                 TokenRolesAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "list token roles",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if statusCode == http.StatusNotFound {
+		// Vault responds with not found when there are no roles
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Keys, nil
+}
+
+func tokenRolePath(roleName string) string {
+	return fmt.Sprintf(TokenRoleAPI, url.PathEscape(roleName))
+}
@@ -0,0 +1,131 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockTokenRoleServer is a stub Vault keeping the token roles in memory
+type mockTokenRoleServer struct {
+	mutex sync.Mutex
+	roles map[string]map[string]interface{}
+}
+
+func (m *mockTokenRoleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if r.URL.Path == TokenRolesAPI && r.Method == "LIST" {
+		if len(m.roles) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		response := ListTokenAccessorsResponse{}
+		for name := range m.roles {
+			response.Data.Keys = append(response.Data.Keys, name)
+		}
+		sort.Strings(response.Data.Keys)
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, TokenRolesAPI+"/")
+	switch r.Method {
+	case http.MethodPost:
+		role := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		if _, ok := role["name"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.roles[name] = role
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		role, ok := m.roles[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data := map[string]interface{}{"name": name}
+		for key, value := range role {
+			data[key] = value
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	case http.MethodDelete:
+		delete(m.roles, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestTokenRoles(t *testing.T) {
+	ts := httptest.NewTLSServer(&mockTokenRoleServer{roles: make(map[string]map[string]interface{})})
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	roles, err := client.ListTokenRoles(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+
+	role := types.TokenRole{
+		AllowedPolicies:     []string{"core-data"},
+		Orphan:              true,
+		Renewable:           true,
+		TokenPeriod:         3600,
+		TokenExplicitMaxTtl: 86400,
+		TokenType:           TokenTypeService,
+	}
+	require.NoError(t, client.CreateTokenRole(context.Background(), expectedToken, "core-data", role))
+	require.NoError(t, client.CreateTokenRole(context.Background(), expectedToken, "core-command", types.TokenRole{Name: "ignored", Renewable: true}))
+	require.Error(t, client.CreateTokenRole(context.Background(), expectedToken, "", role))
+
+	actual, err := client.GetTokenRole(context.Background(), expectedToken, "core-data")
+	require.NoError(t, err)
+	role.Name = "core-data"
+	assert.Equal(t, role, actual)
+
+	roles, err = client.ListTokenRoles(context.Background(), expectedToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core-command", "core-data"}, roles)
+
+	require.NoError(t, client.DeleteTokenRole(context.Background(), expectedToken, "core-data"))
+	_, err = client.GetTokenRole(context.Background(), expectedToken, "core-data")
+	require.Error(t, err)
+
+	_, err = client.ListTokenRoles(context.Background(), "bad-token")
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// defaultTokenFileWatchInterval is how often the token file is checked when TokenFileWatchInterval is not set
+const defaultTokenFileWatchInterval = 5 * time.Second
+
+// tokenFileWatcher detects when the token file of the file auth method is replaced, i.e. by the security
+// bootstrapper or a Vault Agent re-issuing the token. The file is polled for its modification time and size, which
+// needs no file system notifications and also works for files on volumes mounted into containers.
+type tokenFileWatcher struct {
+	path     string
+	interval time.Duration
+	modTime  time.Time
+	size     int64
+}
+
+// newTokenFileWatcher records the current modification time and size of the file at path, changes after which
+// are reported by changed
+func newTokenFileWatcher(path string, interval time.Duration) *tokenFileWatcher {
+	if interval == 0 {
+		interval = defaultTokenFileWatchInterval
+	}
+
+	watcher := &tokenFileWatcher{path: path, interval: interval}
+	watcher.modTime, watcher.size, _ = watcher.stat()
+	return watcher
+}
+
+// changed reports whether the file was modified since the last accepted change. A file which is missing, i.e.
+// while it is being replaced, is not reported.
+func (w *tokenFileWatcher) changed() bool {
+	modTime, size, err := w.stat()
+	if err != nil {
+		return false
+	}
+	return !modTime.Equal(w.modTime) || size != w.size
+}
+
+// accept records the current state of the file, so it is only reported again once modified anew
+func (w *tokenFileWatcher) accept() {
+	if modTime, size, err := w.stat(); err == nil {
+		w.modTime = modTime
+		w.size = size
+	}
+}
+
+func (w *tokenFileWatcher) stat() (time.Time, int64, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return info.ModTime(), info.Size(), nil
+}
+
+// watchTokenFile swaps the token for the one in the token file whenever the file changes, until ctx is done.
+// A token which fails to load, i.e. as the file is only partially written, is loaded again on the next check while
+// the current token stays in use. This function is intended to be executed in a go-routine.
+func (c *Client) watchTokenFile(ctx context.Context, watcher *tokenFileWatcher) {
+	c.lc.Infof("watching the token file %s for a re-issued token every %v", watcher.path, watcher.interval)
+
+	ticker := time.NewTicker(watcher.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.lc.Info("context cancelled, dismiss the token file watch")
+			return
+		case <-ticker.C:
+			if !watcher.changed() {
+				continue
+			}
+
+			if err := c.login(ctx); err != nil {
+				c.lc.Warnf("unable to load the changed token file %s, keeping the current token: %v", watcher.path, err)
+				continue
+			}
+			watcher.accept()
+			c.lc.Info("auth token is replaced by the changed token file")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func writeTokenFile(t *testing.T, path string, token string) {
+	// written to a temporary file and renamed, as the bootstrapper replaces the token file
+	temp := path + ".tmp"
+	require.NoError(t, ioutil.WriteFile(temp, []byte(`{"auth":{"client_token":"`+token+`"}}`), 0600))
+	require.NoError(t, os.Rename(temp, path))
+}
+
+func TestTokenFileWatch(t *testing.T) {
+	// the stub reports the token of each secret request, so the tests see which token is in use
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case lookupSelfVaultAPI:
+			_ = json.NewEncoder(w).Encode(TokenLookupResponse{Data: types.TokenMetadata{Renewable: false}})
+		case testPath + "/redisdb":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"token": r.Header.Get(AuthTypeHeader)}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		expected string
+	}{
+		{"Watched", 10 * time.Millisecond, "second-token"},
+		{"Watch disabled", -1, "first-token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tokenFile := filepath.Join(t.TempDir(), "secrets-token.json")
+			writeTokenFile(t, tokenFile, "first-token")
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			config := newAuthTestConfig(t, server, types.AuthenticationInfo{Method: pkg.AuthMethodFile,
+				TokenFile: tokenFile, TokenFileWatchInterval: test.interval})
+			client, err := NewSecretsClient(ctx, config, logger.NewMockClient(), nil)
+			require.NoError(t, err)
+			defer client.Destroy()
+
+			tokenInUse := func() string {
+				secrets, err := client.GetSecrets(ctx, "/redisdb")
+				require.NoError(t, err)
+				return secrets["token"]
+			}
+			assert.Equal(t, "first-token", tokenInUse())
+
+			// a partially written file keeps the current token in use
+			require.NoError(t, ioutil.WriteFile(tokenFile, []byte(`{"auth":`), 0600))
+			time.Sleep(50 * time.Millisecond)
+			assert.Equal(t, "first-token", tokenInUse())
+
+			writeTokenFile(t, tokenFile, "second-token")
+			if test.interval < 0 {
+				time.Sleep(50 * time.Millisecond)
+				assert.Equal(t, test.expected, tokenInUse())
+				return
+			}
+			assert.Eventually(t, func() bool { return tokenInUse() == test.expected }, time.Second, 10*time.Millisecond)
+		})
+	}
+}
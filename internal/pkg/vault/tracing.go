@@ -0,0 +1,67 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+const redacted = "redacted"
+
+// sensitivePaths are the API paths followed by a token or lease id, which are secret, in the deprecated forms of
+// the token and lease endpoints taking them in the path rather than the body
+var sensitivePaths = []string{
+	"/v1/auth/token/lookup/",
+	"/v1/auth/token/renew/",
+	"/v1/auth/token/revoke/",
+	"/v1/auth/token/revoke-orphan/",
+	"/v1/sys/leases/lookup/",
+	"/v1/sys/leases/renew/",
+	"/v1/sys/leases/revoke/",
+	"/v1/sys/leases/revoke-prefix/",
+	"/v1/sys/leases/revoke-force/",
+}
+
+// SetTracer starts a span with tracer for each request of the client, replacing the tracer set WithTracer. A nil
+// tracer stops the tracing.
+func (c *Client) SetTracer(tracer pkg.Tracer) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.tracer = tracer
+}
+
+// redactPath returns the path and query of the request URL for the span attributes, with the tokens and lease ids
+// in the path and every query value replaced by "redacted"
+func redactPath(requestURL *url.URL) string {
+	path := requestURL.Path
+	for _, prefix := range sensitivePaths {
+		if strings.HasPrefix(path, prefix) && len(path) > len(prefix) {
+			path = prefix + redacted
+			break
+		}
+	}
+
+	if requestURL.RawQuery == "" {
+		return path
+	}
+	query := requestURL.Query()
+	for key := range query {
+		query[key] = []string{redacted}
+	}
+	return path + "?" + query.Encode()
+}
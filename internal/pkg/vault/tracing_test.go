@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+type spanKey struct{}
+
+// recordingTracer records the spans it starts and propagates their names as the traceparent header
+type recordingTracer struct {
+	mutex sync.Mutex
+	spans []*recordedSpan
+}
+
+type recordedSpan struct {
+	name       string
+	parent     string
+	attributes map[string]interface{}
+	ended      bool
+	err        error
+}
+
+func (r *recordingTracer) Start(ctx context.Context, operation string) (context.Context, pkg.Span) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	parent, _ := ctx.Value(spanKey{}).(string)
+	span := &recordedSpan{name: operation, parent: parent, attributes: make(map[string]interface{})}
+	r.spans = append(r.spans, span)
+	return context.WithValue(ctx, spanKey{}, operation), span
+}
+
+func (r *recordingTracer) Inject(ctx context.Context, header http.Header) {
+	header.Set("traceparent", ctx.Value(spanKey{}).(string))
+}
+
+func (s *recordedSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *recordedSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+func TestTracing(t *testing.T) {
+	var traceparents []string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if r.URL.Path != "/v1/secret/edgex/redisdb" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"password": "password"}}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Path = "/v1/secret/edgex/"
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	tracer := &recordingTracer{}
+	client.SetTracer(tracer)
+
+	ctx := context.WithValue(context.Background(), spanKey{}, "service")
+	_, err := client.GetSecrets(ctx, "redisdb")
+	require.NoError(t, err)
+	_, err = client.GetSecrets(ctx, "unknown")
+	require.Error(t, err)
+
+	require.Len(t, tracer.spans, 2)
+	assert.Equal(t, []string{"GET secret", "GET secret"}, traceparents)
+	for index, status := range []int{http.StatusOK, http.StatusNotFound} {
+		span := tracer.spans[index]
+		assert.Equal(t, "GET secret", span.name)
+		assert.Equal(t, "service", span.parent)
+		assert.True(t, span.ended)
+		assert.NoError(t, span.err)
+		assert.Equal(t, http.MethodGet, span.attributes["http.method"])
+		assert.Equal(t, status, span.attributes["http.status_code"])
+	}
+	assert.Equal(t, "/v1/secret/edgex/redisdb", tracer.spans[0].attributes["secretstore.path"])
+
+	// a request failing without a response ends its span with the error
+	ts.Close()
+	_, err = client.GetSecrets(ctx, "redisdb")
+	require.Error(t, err)
+	require.Len(t, tracer.spans, 3)
+	assert.Error(t, tracer.spans[2].err)
+	assert.NotContains(t, tracer.spans[2].attributes, "http.status_code")
+
+	client.SetTracer(nil)
+	_, _ = client.GetSecrets(ctx, "redisdb")
+	assert.Len(t, tracer.spans, 3)
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{"Secret path", "https://vault:8200/v1/secret/edgex/redisdb", "/v1/secret/edgex/redisdb"},
+		{"Token in path", "https://vault:8200/v1/auth/token/lookup/s.abc", "/v1/auth/token/lookup/redacted"},
+		{"Lease id in path", "https://vault:8200/v1/sys/leases/revoke/database/creds/edgex/xyz", "/v1/sys/leases/revoke/redacted"},
+		{"Token in body", "https://vault:8200/v1/auth/token/lookup", "/v1/auth/token/lookup"},
+		{"Query", "https://vault:8200/v1/secret/data/redisdb?version=2", "/v1/secret/data/redisdb?version=redacted"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requestURL, err := url.Parse(test.url)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, redactPath(requestURL))
+		})
+	}
+}
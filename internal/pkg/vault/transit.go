@@ -0,0 +1,345 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EnableTransitEngine mounts the transit secrets engine at mountPoint
+func (c *Client) EnableTransitEngine(ctx context.Context, token string, mountPoint string) error {
+	parameters := EnableSecretsEngineRequest{
+		Type:        Transit,
+		Description: "encryption as a service",
+	}
+
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 path.Join(MountsAPI, mountPoint),
+		JSONObject:           parameters,
+		BodyReader:           nil,
+		OperationDescription: "update mounts for transit",
+		ExpectedStatusCode:   http.StatusNoContent,
+		ResponseObject:       nil,
+	})
+
+	return err
+}
+
+// CreateTransitKey creates the key named keyName of the transit secrets engine at mountPoint. Creating a key
+// which already exists leaves the existing key unchanged.
+func (c *Client) CreateTransitKey(ctx context.Context, token string, mountPoint string, keyName string, key types.TransitKey) error {
+	if keyName == "" {
+		return pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, transitPath(TransitKeyAPI, mountPoint, keyName), key, "create transit key "+keyName)
+}
+
+// RotateTransitKey creates a new version of the key named keyName, which becomes the version used to encrypt and
+// sign. The previous versions still decrypt and verify until the min decryption version is raised.
+func (c *Client) RotateTransitKey(ctx context.Context, token string, mountPoint string, keyName string) error {
+	if keyName == "" {
+		return pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, transitPath(TransitKeyRotateAPI, mountPoint, keyName), nil, "rotate transit key "+keyName)
+}
+
+// ReadTransitKey returns the configuration and the available versions of the key named keyName
+func (c *Client) ReadTransitKey(ctx context.Context, token string, mountPoint string, keyName string) (types.TransitKeyInfo, error) {
+	if keyName == "" {
+		return types.TransitKeyInfo{}, pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+
+	response := TransitKeyResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodGet,
+		Path:                 transitPath(TransitKeyAPI, mountPoint, keyName),
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "read transit key " + keyName,
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+	if err != nil {
+		return types.TransitKeyInfo{}, err
+	}
+
+	data := response.Data
+	versions := make([]int, 0, len(data.Keys))
+	for key := range data.Keys {
+		version, err := strconv.Atoi(key)
+		if err != nil {
+			return types.TransitKeyInfo{}, pkg.NewErrSecretStore(fmt.Sprintf("invalid transit key version '%s'", key))
+		}
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	return types.TransitKeyInfo{
+		Name:                 data.Name,
+		Type:                 data.Type,
+		LatestVersion:        data.LatestVersion,
+		MinDecryptionVersion: data.MinDecryptionVersion,
+		MinEncryptionVersion: data.MinEncryptionVersion,
+		MinAvailableVersion:  data.MinAvailableVersion,
+		DeletionAllowed:      data.DeletionAllowed,
+		Exportable:           data.Exportable,
+		AllowPlaintextBackup: data.AllowPlaintextBackup,
+		AutoRotatePeriod:     data.AutoRotatePeriod,
+		Versions:             versions,
+	}, nil
+}
+
+// UpdateTransitKeyConfig updates the configuration of the key named keyName, i.e. raising its min decryption
+// version so ciphertexts of older versions can no longer be decrypted
+func (c *Client) UpdateTransitKeyConfig(ctx context.Context, token string, mountPoint string, keyName string, config types.TransitKeyConfig) error {
+	if keyName == "" {
+		return pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+
+	return c.postConfig(ctx, token, transitPath(TransitKeyConfigAPI, mountPoint, keyName), config, "update transit key config "+keyName)
+}
+
+// TrimTransitKeyVersions permanently deletes the versions of the key named keyName older than minAvailableVersion,
+// which cannot be newer than the key's min decryption and encryption versions
+func (c *Client) TrimTransitKeyVersions(ctx context.Context, token string, mountPoint string, keyName string, minAvailableVersion int) error {
+	if keyName == "" {
+		return pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+	if minAvailableVersion < 1 {
+		return pkg.NewErrSecretStore("min available version must be at least 1 for trimming transit key versions")
+	}
+
+	return c.postConfig(ctx, token, transitPath(TransitKeyTrimAPI, mountPoint, keyName),
+		TransitTrimRequest{MinAvailableVersion: minAvailableVersion}, "trim transit key versions "+keyName)
+}
+
+// Encrypt encrypts the plaintext with the named transit key using the client's token
+func (c *Client) Encrypt(ctx context.Context, keyName string, plaintext []byte) (string, error) {
+	request := TransitRequest{Plaintext: base64.StdEncoding.EncodeToString(plaintext)}
+	var response TransitResponse
+	if err := c.transitRequest(ctx, TransitEncryptAPI, keyName, request, &response); err != nil {
+		return "", err
+	}
+
+	return response.Data.Ciphertext, nil
+}
+
+// Decrypt decrypts the ciphertext with the named transit key using the client's token
+func (c *Client) Decrypt(ctx context.Context, keyName string, ciphertext string) ([]byte, error) {
+	if ciphertext == "" {
+		return nil, pkg.NewErrSecretStore("ciphertext cannot be empty for decrypting")
+	}
+
+	var response TransitResponse
+	if err := c.transitRequest(ctx, TransitDecryptAPI, keyName, TransitRequest{Ciphertext: ciphertext}, &response); err != nil {
+		return nil, err
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("decrypted plaintext is not base64 encoded: %s", err.Error()))
+	}
+
+	return plaintext, nil
+}
+
+// Rewrap re-encrypts the ciphertext with the latest version of the named transit key using the client's token
+func (c *Client) Rewrap(ctx context.Context, keyName string, ciphertext string) (string, error) {
+	if ciphertext == "" {
+		return "", pkg.NewErrSecretStore("ciphertext cannot be empty for rewrapping")
+	}
+
+	var response TransitResponse
+	if err := c.transitRequest(ctx, TransitRewrapAPI, keyName, TransitRequest{Ciphertext: ciphertext}, &response); err != nil {
+		return "", err
+	}
+
+	return response.Data.Ciphertext, nil
+}
+
+// Sign signs the input with the named transit key using the client's token
+func (c *Client) Sign(ctx context.Context, keyName string, input []byte, options types.TransitSignOptions) (string, error) {
+	request := newTransitSignRequest(options)
+	request.Input = base64.StdEncoding.EncodeToString(input)
+
+	var response TransitSignResponse
+	if err := c.transitRequest(ctx, TransitSignAPI, keyName, request, &response); err != nil {
+		return "", err
+	}
+
+	return response.Data.Signature, nil
+}
+
+// SignBatch signs the inputs with the named transit key in a single request using the client's token
+func (c *Client) SignBatch(ctx context.Context, keyName string, inputs [][]byte, options types.TransitSignOptions) ([]string, error) {
+	results, err := c.transitBatch(ctx, TransitSignAPI, keyName, inputs, newTransitSignRequest(options))
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]string, len(results))
+	for index, result := range results {
+		signatures[index] = result.Signature
+	}
+	return signatures, nil
+}
+
+// Verify verifies the signature of the input with the named transit key using the client's token
+func (c *Client) Verify(ctx context.Context, keyName string, input []byte, signature string, options types.TransitSignOptions) (bool, error) {
+	if signature == "" {
+		return false, pkg.NewErrSecretStore("signature cannot be empty for verifying")
+	}
+
+	request := newTransitSignRequest(options)
+	request.Input = base64.StdEncoding.EncodeToString(input)
+	request.Signature = signature
+	// the signature embeds the key version it was signed with
+	request.KeyVersion = 0
+
+	return c.transitVerify(ctx, keyName, request)
+}
+
+// HMAC computes the HMAC of the input with the named transit key using the client's token
+func (c *Client) HMAC(ctx context.Context, keyName string, input []byte, options types.TransitSignOptions) (string, error) {
+	request := newTransitHMACRequest(options)
+	request.Input = base64.StdEncoding.EncodeToString(input)
+
+	var response TransitSignResponse
+	if err := c.transitRequest(ctx, TransitHMACAPI, keyName, request, &response); err != nil {
+		return "", err
+	}
+
+	return response.Data.HMAC, nil
+}
+
+// HMACBatch computes the HMACs of the inputs with the named transit key in a single request using the client's token
+func (c *Client) HMACBatch(ctx context.Context, keyName string, inputs [][]byte, options types.TransitSignOptions) ([]string, error) {
+	results, err := c.transitBatch(ctx, TransitHMACAPI, keyName, inputs, newTransitHMACRequest(options))
+	if err != nil {
+		return nil, err
+	}
+
+	hmacs := make([]string, len(results))
+	for index, result := range results {
+		hmacs[index] = result.HMAC
+	}
+	return hmacs, nil
+}
+
+// VerifyHMAC verifies the HMAC of the input with the named transit key using the client's token
+func (c *Client) VerifyHMAC(ctx context.Context, keyName string, input []byte, hmac string, options types.TransitSignOptions) (bool, error) {
+	if hmac == "" {
+		return false, pkg.NewErrSecretStore("hmac cannot be empty for verifying")
+	}
+
+	request := newTransitHMACRequest(options)
+	request.Input = base64.StdEncoding.EncodeToString(input)
+	request.HMAC = hmac
+	request.KeyVersion = 0
+
+	return c.transitVerify(ctx, keyName, request)
+}
+
+func (c *Client) transitVerify(ctx context.Context, keyName string, request TransitSignRequest) (bool, error) {
+	var response TransitSignResponse
+	if err := c.transitRequest(ctx, TransitVerifyAPI, keyName, request, &response); err != nil {
+		return false, err
+	}
+
+	return response.Data.Valid, nil
+}
+
+// transitBatch sends the inputs as the batch of the request, failing when the result of any input is an error
+func (c *Client) transitBatch(ctx context.Context, apiFmt string, keyName string, inputs [][]byte, request TransitSignRequest) ([]TransitBatchResult, error) {
+	if len(inputs) == 0 {
+		return []TransitBatchResult{}, nil
+	}
+
+	request.BatchInput = make([]TransitBatchItem, len(inputs))
+	for index, input := range inputs {
+		request.BatchInput[index].Input = base64.StdEncoding.EncodeToString(input)
+	}
+
+	var response TransitSignResponse
+	if err := c.transitRequest(ctx, apiFmt, keyName, request, &response); err != nil {
+		return nil, err
+	}
+
+	results := response.Data.BatchResults
+	if len(results) != len(inputs) {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("expected %d batch results, received %d", len(inputs), len(results)))
+	}
+	for index, result := range results {
+		if result.Error != "" {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("batch input %d failed: %s", index, result.Error))
+		}
+	}
+
+	return results, nil
+}
+
+func newTransitSignRequest(options types.TransitSignOptions) TransitSignRequest {
+	return TransitSignRequest{
+		HashAlgorithm:       options.HashAlgorithm,
+		Prehashed:           options.Prehashed,
+		SignatureAlgorithm:  options.SignatureAlgorithm,
+		MarshalingAlgorithm: options.MarshalingAlgorithm,
+		KeyVersion:          options.KeyVersion,
+	}
+}
+
+// newTransitHMACRequest only keeps the options which apply to HMACs
+func newTransitHMACRequest(options types.TransitSignOptions) TransitSignRequest {
+	return TransitSignRequest{
+		HashAlgorithm: options.HashAlgorithm,
+		KeyVersion:    options.KeyVersion,
+	}
+}
+
+// transitRequest posts the request to the transit operation of the named key and decodes the response
+func (c *Client) transitRequest(ctx context.Context, apiFmt string, keyName string, request interface{}, response interface{}) error {
+	keyName = strings.TrimSpace(keyName)
+	if keyName == "" {
+		return pkg.NewErrSecretStore("transit key name cannot be empty")
+	}
+
+	_, body, err := c.kvRequest(ctx, http.MethodPost, fmt.Sprintf(apiFmt, url.PathEscape(keyName)), request)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, response)
+}
+
+func transitPath(apiFmt string, mountPoint string, keyName string) string {
+	return fmt.Sprintf(apiFmt, strings.Trim(mountPoint, "/"), url.PathEscape(keyName))
+}
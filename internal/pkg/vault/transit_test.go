@@ -0,0 +1,360 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockTransitServer is a stub Vault with the transit secrets engine mounted at "transit". Its "ciphertext" is the
+// reversed base64 plaintext prefixed with the key version, which is enough to tell the operations apart. Its
+// signatures and HMACs are the base64 input prefixed with the operation.
+type mockTransitServer struct {
+	mutex   sync.Mutex
+	mounted bool
+	keys    map[string]types.TransitKey
+	// version is the latest version of every key
+	version int
+	// lastSignRequest is the last sign, verify or hmac request
+	lastSignRequest TransitSignRequest
+}
+
+func (m *mockTransitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var request TransitRequest
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/transit/"), "/")
+	_, exists := m.keys[parts[len(parts)-1]]
+	switch {
+	case r.URL.Path == MountsAPI+"/transit":
+		var request EnableSecretsEngineRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		m.mounted = request.Type == Transit
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case !m.mounted || len(parts) != 2:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	case parts[0] == "keys":
+		var key types.TransitKey
+		_ = json.NewDecoder(r.Body).Decode(&key)
+		m.keys[parts[1]] = key
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"name": parts[1]}})
+		return
+	case !exists:
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"encryption key not found"}})
+		return
+	}
+
+	switch parts[0] {
+	case "sign", "hmac", "verify":
+		m.serveSign(w, r, parts[0])
+		return
+	}
+
+	_ = json.NewDecoder(r.Body).Decode(&request)
+	var response TransitRequest
+	switch parts[0] {
+	case "encrypt":
+		response.Ciphertext = fmt.Sprintf("vault:v%d:%s", m.version, reverse(request.Plaintext))
+	case "decrypt":
+		response.Plaintext = reverse(request.Ciphertext[strings.LastIndex(request.Ciphertext, ":")+1:])
+	case "rewrap":
+		response.Ciphertext = fmt.Sprintf("vault:v%d:%s", m.version, request.Ciphertext[strings.LastIndex(request.Ciphertext, ":")+1:])
+	}
+	_ = json.NewEncoder(w).Encode(TransitResponse{Data: response})
+}
+
+func (m *mockTransitServer) serveSign(w http.ResponseWriter, r *http.Request, operation string) {
+	var request TransitSignRequest
+	_ = json.NewDecoder(r.Body).Decode(&request)
+	m.lastSignRequest = request
+
+	sign := func(input string) string { return fmt.Sprintf("vault:v%d:%s-%s", m.version, operation, input) }
+	var response TransitSignResponse
+	switch {
+	case operation == "verify" && request.Signature != "":
+		response.Data.Valid = strings.HasSuffix(request.Signature, ":sign-"+request.Input)
+	case operation == "verify":
+		response.Data.Valid = strings.HasSuffix(request.HMAC, ":hmac-"+request.Input)
+	case len(request.BatchInput) > 0:
+		for _, item := range request.BatchInput {
+			result := TransitBatchResult{Signature: sign(item.Input), HMAC: sign(item.Input)}
+			if item.Input == "" {
+				result = TransitBatchResult{Error: "missing input"}
+			}
+			response.Data.BatchResults = append(response.Data.BatchResults, result)
+		}
+	default:
+		response.Data.Signature = sign(request.Input)
+		response.Data.HMAC = sign(request.Input)
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func reverse(value string) string {
+	runes := []rune(value)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func TestTransit(t *testing.T) {
+	mock := &mockTransitServer{keys: make(map[string]types.TransitKey), version: 1}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	require.NoError(t, client.EnableTransitEngine(context.Background(), expectedToken, "transit"))
+	key := types.TransitKey{Type: "aes256-gcm96", AutoRotatePeriod: 86400}
+	require.NoError(t, client.CreateTransitKey(context.Background(), expectedToken, "transit", "core-data", key))
+	assert.Equal(t, key, mock.keys["core-data"])
+	require.Error(t, client.CreateTransitKey(context.Background(), expectedToken, "transit", "", key))
+
+	plaintext := []byte(`{"Writable":{"LogLevel":"INFO"}}`)
+	ciphertext, err := client.Encrypt(context.Background(), "core-data", plaintext)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "vault:v1:"))
+	assert.NotContains(t, ciphertext, base64.StdEncoding.EncodeToString(plaintext))
+
+	mock.version = 2
+	rewrapped, err := client.Rewrap(context.Background(), "core-data", ciphertext)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rewrapped, "vault:v2:"))
+
+	for _, value := range []string{ciphertext, rewrapped} {
+		decrypted, err := client.Decrypt(context.Background(), "core-data", value)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+	}
+
+	_, err = client.Encrypt(context.Background(), "unknown", plaintext)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "encryption key not found")
+	_, err = client.Encrypt(context.Background(), " ", plaintext)
+	require.Error(t, err)
+	_, err = client.Decrypt(context.Background(), "core-data", "")
+	require.Error(t, err)
+	_, err = client.Rewrap(context.Background(), "core-data", "")
+	require.Error(t, err)
+}
+
+func TestTransitSign(t *testing.T) {
+	mock := &mockTransitServer{keys: map[string]types.TransitKey{"jwt": {Type: "ecdsa-p256"}}, mounted: true, version: 1}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	ctx := context.Background()
+	input := []byte("header.payload")
+	options := types.TransitSignOptions{
+		HashAlgorithm:       "sha2-256",
+		Prehashed:           true,
+		MarshalingAlgorithm: "jws",
+		KeyVersion:          1,
+	}
+
+	signature, err := client.Sign(ctx, "jwt", input, options)
+	require.NoError(t, err)
+	assert.Equal(t, "vault:v1:sign-"+base64.StdEncoding.EncodeToString(input), signature)
+	assert.Equal(t, TransitSignRequest{
+		Input:               base64.StdEncoding.EncodeToString(input),
+		HashAlgorithm:       "sha2-256",
+		Prehashed:           true,
+		MarshalingAlgorithm: "jws",
+		KeyVersion:          1,
+	}, mock.lastSignRequest)
+
+	valid, err := client.Verify(ctx, "jwt", input, signature, options)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Zero(t, mock.lastSignRequest.KeyVersion, "the key version is not expected to be sent for verifying")
+	valid, err = client.Verify(ctx, "jwt", []byte("tampered"), signature, options)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	_, err = client.Verify(ctx, "jwt", input, "", options)
+	require.Error(t, err)
+
+	signatures, err := client.SignBatch(ctx, "jwt", [][]byte{[]byte("first"), []byte("second")}, options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"vault:v1:sign-" + base64.StdEncoding.EncodeToString([]byte("first")),
+		"vault:v1:sign-" + base64.StdEncoding.EncodeToString([]byte("second")),
+	}, signatures)
+	assert.Empty(t, mock.lastSignRequest.Input)
+
+	_, err = client.SignBatch(ctx, "jwt", [][]byte{[]byte("first"), nil}, options)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "batch input 1 failed: missing input")
+
+	signatures, err = client.SignBatch(ctx, "jwt", nil, options)
+	require.NoError(t, err)
+	assert.Empty(t, signatures)
+
+	_, err = client.Sign(ctx, "unknown", input, options)
+	require.Error(t, err)
+}
+
+func TestTransitHMAC(t *testing.T) {
+	mock := &mockTransitServer{keys: map[string]types.TransitKey{"messages": {}}, mounted: true, version: 2}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+	ctx := context.Background()
+	input := []byte(`{"reading":42}`)
+	// the signing options which don't apply to HMACs are not expected to be sent
+	options := types.TransitSignOptions{HashAlgorithm: "sha2-512", Prehashed: true, SignatureAlgorithm: "pss"}
+
+	hmac, err := client.HMAC(ctx, "messages", input, options)
+	require.NoError(t, err)
+	assert.Equal(t, "vault:v2:hmac-"+base64.StdEncoding.EncodeToString(input), hmac)
+	assert.Equal(t, TransitSignRequest{Input: base64.StdEncoding.EncodeToString(input), HashAlgorithm: "sha2-512"}, mock.lastSignRequest)
+
+	valid, err := client.VerifyHMAC(ctx, "messages", input, hmac, options)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = client.VerifyHMAC(ctx, "messages", input, "vault:v2:hmac-other", options)
+	require.NoError(t, err)
+	assert.False(t, valid)
+	_, err = client.VerifyHMAC(ctx, "messages", input, "", options)
+	require.Error(t, err)
+
+	hmacs, err := client.HMACBatch(ctx, "messages", [][]byte{input}, options)
+	require.NoError(t, err)
+	assert.Equal(t, []string{hmac}, hmacs)
+}
+
+// mockTransitKey is a stub transit key managed at "/v1/transit/keys/core-data". Rotating and updating the config
+// respond with no content, as older Vault versions do.
+type mockTransitKey struct {
+	mutex    sync.Mutex
+	info     types.TransitKeyInfo
+	versions map[int]bool
+}
+
+func (m *mockTransitKey) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get(AuthTypeHeader) != expectedToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/transit/keys/core-data":
+		var response TransitKeyResponse
+		response.Data.Name = m.info.Name
+		response.Data.LatestVersion = m.info.LatestVersion
+		response.Data.MinDecryptionVersion = m.info.MinDecryptionVersion
+		response.Data.MinAvailableVersion = m.info.MinAvailableVersion
+		response.Data.Keys = make(map[string]json.RawMessage)
+		for version := range m.versions {
+			response.Data.Keys[fmt.Sprint(version)] = json.RawMessage(`1700000000`)
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	case "/v1/transit/keys/core-data/rotate":
+		m.info.LatestVersion++
+		m.versions[m.info.LatestVersion] = true
+		w.WriteHeader(http.StatusNoContent)
+	case "/v1/transit/keys/core-data/config":
+		var config types.TransitKeyConfig
+		_ = json.NewDecoder(r.Body).Decode(&config)
+		m.info.MinDecryptionVersion = config.MinDecryptionVersion
+		w.WriteHeader(http.StatusNoContent)
+	case "/v1/transit/keys/core-data/trim":
+		var request TransitTrimRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.MinAvailableVersion > m.info.MinDecryptionVersion {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for version := range m.versions {
+			if version < request.MinAvailableVersion {
+				delete(m.versions, version)
+			}
+		}
+		m.info.MinAvailableVersion = request.MinAvailableVersion
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"name": "core-data"}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestTransitKeyRotation(t *testing.T) {
+	mock := &mockTransitKey{
+		info:     types.TransitKeyInfo{Name: "core-data", LatestVersion: 1, MinDecryptionVersion: 1},
+		versions: map[int]bool{1: true},
+	}
+	ts := httptest.NewTLSServer(mock)
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+
+	require.NoError(t, client.RotateTransitKey(context.Background(), expectedToken, "transit", "core-data"))
+	require.NoError(t, client.RotateTransitKey(context.Background(), expectedToken, "/transit/", "core-data"))
+
+	key, err := client.ReadTransitKey(context.Background(), expectedToken, "transit", "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, types.TransitKeyInfo{
+		Name:                 "core-data",
+		LatestVersion:        3,
+		MinDecryptionVersion: 1,
+		Versions:             []int{1, 2, 3},
+	}, key)
+
+	require.Error(t, client.TrimTransitKeyVersions(context.Background(), expectedToken, "transit", "core-data", 3))
+	require.NoError(t, client.UpdateTransitKeyConfig(context.Background(), expectedToken, "transit", "core-data", types.TransitKeyConfig{MinDecryptionVersion: 3}))
+	require.NoError(t, client.TrimTransitKeyVersions(context.Background(), expectedToken, "transit", "core-data", 3))
+
+	key, err = client.ReadTransitKey(context.Background(), expectedToken, "transit", "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, 3, key.MinDecryptionVersion)
+	assert.Equal(t, 3, key.MinAvailableVersion)
+	assert.Equal(t, []int{3}, key.Versions)
+
+	require.Error(t, client.TrimTransitKeyVersions(context.Background(), expectedToken, "transit", "core-data", 0))
+	require.Error(t, client.RotateTransitKey(context.Background(), expectedToken, "transit", ""))
+	_, err = client.ReadTransitKey(context.Background(), expectedToken, "transit", "unknown")
+	require.Error(t, err)
+}
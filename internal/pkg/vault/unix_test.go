@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestUnixSocket(t *testing.T) {
+	// not t.TempDir, as the length of socket paths is limited
+	dir, err := ioutil.TempDir("", "agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	socketPath := filepath.Join(dir, "agent.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, SealStatusAPI, r.URL.Path)
+		_, _ = w.Write([]byte(`{"type":"shamir","initialized":true,"sealed":false,"t":1,"n":1}`))
+	})}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Close() }()
+
+	config := types.SecretConfig{Protocol: types.UnixProtocol, Host: socketPath}
+	client, err := NewClient(config, WithLogger(logger.NewMockClient()))
+	require.NoError(t, err)
+
+	status, err := client.SealStatus(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.Initialized)
+	assert.False(t, status.Sealed)
+
+	_, err = NewClient(types.SecretConfig{Protocol: types.UnixProtocol})
+	require.Error(t, err, "socket path is required")
+}
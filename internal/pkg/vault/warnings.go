@@ -0,0 +1,51 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// SetWarningCallback sets the callback notified with the warnings of each response having any, in addition to
+// them being logged
+func (c *Client) SetWarningCallback(callback pkg.WarningCallback) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+	c.warningCallback = callback
+}
+
+// handleWarnings logs the warnings of a successful response body and notifies the warning callback of them.
+// Vault reports them as {"warnings": [...]} next to the data, i.e. when a KV v1 path is used on a KV v2 engine.
+func (c *Client) handleWarnings(path string, body []byte) {
+	var response struct {
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Warnings) == 0 {
+		return
+	}
+
+	c.lc.Warnf("secret store responded to the request to %s with warnings: %s", path,
+		strings.Join(response.Warnings, "; "))
+
+	c.tokenMutex.RLock()
+	callback := c.warningCallback
+	c.tokenMutex.RUnlock()
+	if callback != nil {
+		callback(path, response.Warnings)
+	}
+}
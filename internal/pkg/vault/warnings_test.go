@@ -0,0 +1,77 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const testWarning = "Invalid path for a versioned K/V secrets engine"
+
+// mockWarningVault responds to every request with data and a warning
+type mockWarningVault struct{}
+
+func (m *mockWarningVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case HealthAPI:
+		_, _ = w.Write([]byte(`{"initialized":true,"sealed":false,"version":"1.9.0","warnings":["` + testWarning + `"]}`))
+	default:
+		_, _ = w.Write([]byte(`{"data":{"password":"password"},"warnings":["` + testWarning + `"]}`))
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	ts := httptest.NewTLSServer(&mockWarningVault{})
+	defer ts.Close()
+
+	client := createClient(t, ts.URL, logger.NewMockClient())
+	client.Config.Authentication = types.AuthenticationInfo{AuthType: AuthTypeHeader, AuthToken: expectedToken}
+
+	var mutex sync.Mutex
+	warned := make(map[string][]string)
+	client.SetWarningCallback(func(path string, warnings []string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		warned[path] = warnings
+	})
+
+	// detecting the flavor reads sys/health
+	client.flavor = ""
+	_, err := client.ServerFlavor(context.Background())
+	require.NoError(t, err)
+	secrets, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, secrets)
+	_, err = client.ListSecretPaths(context.Background(), "/")
+	require.NoError(t, err)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Len(t, warned, 3, "warnings of a management, secrets and KV request")
+	for path, warnings := range warned {
+		assert.Equal(t, []string{testWarning}, warnings, path)
+	}
+}
@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// WrapData wraps data in a single use wrapping token valid for ttl, so it can be handed to another service
+// which unwraps it with UnwrapToken
+func (c *Client) WrapData(ctx context.Context, token string, data map[string]interface{}, ttl time.Duration) (types.WrapInfo, error) {
+	if ttl < time.Second {
+		return types.WrapInfo{}, pkg.NewErrSecretStore(fmt.Sprintf("wrapping TTL must be at least one second, got %v", ttl))
+	}
+
+	response := WrapResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            token,
+		Method:               http.MethodPost,
+		Path:                 WrapAPI,
+		JSONObject:           data,
+		BodyReader:           nil,
+		OperationDescription: "wrap data",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	}, WithWrapTTL(ttl))
+	if err != nil {
+		return types.WrapInfo{}, err
+	}
+	if response.WrapInfo.Token == "" {
+		return types.WrapInfo{}, pkg.NewErrSecretStore("wrap response does not contain a wrapping token")
+	}
+
+	return response.WrapInfo, nil
+}
+
+// LookupWrappingToken returns the creation path, time and TTL of the wrapping token without unwrapping it
+func (c *Client) LookupWrappingToken(ctx context.Context, wrappingToken string) (types.WrapInfo, error) {
+	response := WrapLookupResponse{}
+	_, err := c.doRequest(ctx, RequestArgs{
+		AuthToken:            "",
+		Method:               http.MethodPost,
+		Path:                 WrapLookupAPI,
+		JSONObject:           WrapLookupRequest{Token: types.SecretString(wrappingToken)},
+		BodyReader:           nil,
+		OperationDescription: "lookup wrapping token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return types.WrapInfo{
+		CreationTime: response.Data.CreationTime,
+		CreationPath: response.Data.CreationPath,
+		TTL:          response.Data.CreationTTL,
+	}, err
+}
+
+// UnwrapToken validates the wrapping token against options and unwraps it, returning the wrapped response.
+// Wrapped data is in the "data" field and a wrapped token creation or login in the "auth" field.
+func (c *Client) UnwrapToken(ctx context.Context, wrappingToken string, options types.UnwrapOptions) (map[string]interface{}, error) {
+	info, err := c.LookupWrappingToken(ctx, wrappingToken)
+	if err != nil {
+		// an unknown token has already been unwrapped, possibly by someone who intercepted it
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("wrapping token is invalid or was already unwrapped: %s", err.Error()))
+	}
+
+	if err := validateWrapInfo(info, options); err != nil {
+		return nil, err
+	}
+
+	response := make(map[string]interface{})
+	_, err = c.doRequest(ctx, RequestArgs{
+		AuthToken:            wrappingToken,
+		Method:               http.MethodPost,
+		Path:                 UnwrapAPI,
+		JSONObject:           nil,
+		BodyReader:           nil,
+		OperationDescription: "unwrap token",
+		ExpectedStatusCode:   http.StatusOK,
+		ResponseObject:       &response,
+	})
+
+	return response, err
+}
+
+func validateWrapInfo(info types.WrapInfo, options types.UnwrapOptions) error {
+	if options.CreationPath != "" {
+		expected := strings.Trim(options.CreationPath, "/")
+		actual := strings.Trim(info.CreationPath, "/")
+		matched := actual == expected
+		if strings.HasSuffix(expected, "*") {
+			matched = strings.HasPrefix(actual, strings.TrimSuffix(expected, "*"))
+		}
+		if !matched {
+			return pkg.NewErrSecretStore(fmt.Sprintf("wrapping token was created at '%s' rather than the expected '%s'",
+				info.CreationPath, options.CreationPath))
+		}
+	}
+
+	if options.MaxTTL > 0 && time.Duration(info.TTL)*time.Second > options.MaxTTL {
+		return pkg.NewErrSecretStore(fmt.Sprintf("wrapping token TTL of %ds exceeds the maximum of %v", info.TTL, options.MaxTTL))
+	}
+
+	return nil
+}
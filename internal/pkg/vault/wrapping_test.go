@@ -0,0 +1,138 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockWrappingServer is a stub Vault serving the sys/wrapping APIs, where every wrapping token can be unwrapped once
+type mockWrappingServer struct {
+	mutex   sync.Mutex
+	wrapped map[string]map[string]interface{}
+	ttls    map[string]int
+}
+
+func (m *mockWrappingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch r.URL.Path {
+	case WrapAPI:
+		if r.Header.Get(AuthTypeHeader) != expectedToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		ttl, err := time.ParseDuration(r.Header.Get(WrapTTLHeader))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data := make(map[string]interface{})
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		token := "wrapping-token"
+		m.wrapped[token] = data
+		m.ttls[token] = int(ttl.Seconds())
+		_ = json.NewEncoder(w).Encode(WrapResponse{WrapInfo: types.WrapInfo{
+			Token: types.SecretString(token), Accessor: "accessor", TTL: m.ttls[token], CreationPath: "sys/wrapping/wrap"}})
+	case WrapLookupAPI:
+		var request WrapLookupRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if _, ok := m.wrapped[request.Token.Reveal()]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response := WrapLookupResponse{}
+		response.Data.CreationPath = "sys/wrapping/wrap"
+		response.Data.CreationTTL = m.ttls[request.Token.Reveal()]
+		_ = json.NewEncoder(w).Encode(response)
+	case UnwrapAPI:
+		token := r.Header.Get(AuthTypeHeader)
+		data, ok := m.wrapped[token]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delete(m.wrapped, token)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestWrapUnwrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		options     types.UnwrapOptions
+		expectError bool
+	}{
+		{"Valid", time.Minute, types.UnwrapOptions{CreationPath: "sys/wrapping/wrap", MaxTTL: 5 * time.Minute}, false},
+		{"Valid - no checks", time.Minute, types.UnwrapOptions{}, false},
+		{"Valid - creation path prefix", time.Minute, types.UnwrapOptions{CreationPath: "/sys/wrapping/*"}, false},
+		{"Invalid - creation path", time.Minute, types.UnwrapOptions{CreationPath: "auth/approle/role/core-data/secret-id"}, true},
+		{"Invalid - TTL too long", time.Hour, types.UnwrapOptions{MaxTTL: 5 * time.Minute}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewTLSServer(&mockWrappingServer{
+				wrapped: make(map[string]map[string]interface{}),
+				ttls:    make(map[string]int),
+			})
+			defer ts.Close()
+
+			client := createClient(t, ts.URL, logger.NewMockClient())
+
+			info, err := client.WrapData(context.Background(), expectedToken, map[string]interface{}{"secret_id": "test-secret-id"}, test.ttl)
+			require.NoError(t, err)
+			assert.Equal(t, "wrapping-token", info.Token.Reveal())
+			assert.Equal(t, int(test.ttl.Seconds()), info.TTL)
+
+			response, err := client.UnwrapToken(context.Background(), info.Token.Reveal(), test.options)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"secret_id": "test-secret-id"}, response["data"])
+
+			// a wrapping token can only be unwrapped once
+			_, err = client.UnwrapToken(context.Background(), info.Token.Reveal(), test.options)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestWrapDataInvalidTTL(t *testing.T) {
+	client := createClient(t, "https://localhost:8200", logger.NewMockClient())
+
+	_, err := client.WrapData(context.Background(), expectedToken, map[string]interface{}{"key": "value"}, time.Millisecond)
+	require.Error(t, err)
+}
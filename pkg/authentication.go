@@ -0,0 +1,179 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/authtokenloader"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/encryptedtoken"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	// AuthMethodFile reads the token from the token file of the Authentication TokenFile setting
+	AuthMethodFile = "file"
+	// AuthMethodEnv reads the token from the environment variable of the Authentication TokenEnvVar setting
+	AuthMethodEnv = "env"
+	// DefaultTokenEnvVar is the environment variable read by the env auth method when TokenEnvVar is not set
+	DefaultTokenEnvVar = "VAULT_TOKEN"
+)
+
+// AuthenticationTokenProvider obtains the token a secret store client authenticates its requests with. The client
+// asks for a token when it is created and again whenever its token can no longer be renewed.
+type AuthenticationTokenProvider interface {
+	// GetToken returns the token and when it expires, the zero time when the expiry is not known
+	GetToken(ctx context.Context) (token string, expiry time.Time, err error)
+}
+
+// AuthenticationTokenProviderFunc adapts a function to an AuthenticationTokenProvider
+type AuthenticationTokenProviderFunc func(ctx context.Context) (string, time.Time, error)
+
+// GetToken calls f
+func (f AuthenticationTokenProviderFunc) GetToken(ctx context.Context) (string, time.Time, error) {
+	return f(ctx)
+}
+
+// AuthLoginFunc posts the login request to the auth method mounted at mountPath of the secret store, i.e. "approle",
+// and returns the issued token and its expiry
+type AuthLoginFunc func(ctx context.Context, mountPath string, request interface{}) (string, time.Time, error)
+
+// AuthMethodFactory creates the AuthenticationTokenProvider of an auth method from the configuration. Auth methods
+// exchanging credentials for a token log in with login, so they need no HTTP client of their own.
+type AuthMethodFactory func(config types.SecretConfig, login AuthLoginFunc) (AuthenticationTokenProvider, error)
+
+var (
+	authMethodsMutex sync.RWMutex
+	authMethods      = make(map[string]AuthMethodFactory)
+)
+
+func init() {
+	RegisterAuthMethod(AuthMethodFile, func(config types.SecretConfig, _ AuthLoginFunc) (AuthenticationTokenProvider, error) {
+		if config.Authentication.TokenFile == "" {
+			return nil, NewErrSecretStore("Authentication TokenFile is required in config for the file auth method")
+		}
+		if encryption := config.Authentication.TokenFileEncryption; encryption.Enabled() {
+			return newEncryptedFileTokenProvider(config.Authentication.TokenFile, encryption)
+		}
+		return NewFileTokenProvider(config.Authentication.TokenFile, fileioperformer.NewDefaultFileIoPerformer()), nil
+	})
+	RegisterAuthMethod(AuthMethodEnv, func(config types.SecretConfig, _ AuthLoginFunc) (AuthenticationTokenProvider, error) {
+		return NewEnvTokenProvider(config.Authentication.TokenEnvVar), nil
+	})
+}
+
+// RegisterAuthMethod makes the auth method available under name, which the Authentication Method setting selects.
+// The auth methods built into a client take precedence over the registered ones. RegisterAuthMethod panics if
+// factory is nil or an auth method is already registered under name.
+func RegisterAuthMethod(name string, factory AuthMethodFactory) {
+	authMethodsMutex.Lock()
+	defer authMethodsMutex.Unlock()
+
+	if factory == nil {
+		panic(fmt.Sprintf("pkg: RegisterAuthMethod factory is nil for '%s'", name))
+	}
+	if _, exists := authMethods[name]; exists {
+		panic(fmt.Sprintf("pkg: RegisterAuthMethod called twice for '%s'", name))
+	}
+
+	authMethods[name] = factory
+}
+
+// LookupAuthMethod returns the factory of the auth method registered under name
+func LookupAuthMethod(name string) (AuthMethodFactory, bool) {
+	authMethodsMutex.RLock()
+	defer authMethodsMutex.RUnlock()
+
+	factory, ok := authMethods[name]
+	return factory, ok
+}
+
+// AuthMethods returns the sorted names of the registered auth methods
+func AuthMethods() []string {
+	authMethodsMutex.RLock()
+	defer authMethodsMutex.RUnlock()
+
+	names := make([]string, 0, len(authMethods))
+	for name := range authMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewFileTokenProvider returns an AuthenticationTokenProvider reading the token from the JSON token file at path,
+// as written by the EdgeX security bootstrapper. The file is read for each token, so a token replaced by the
+// bootstrapper is picked up when the client logs in again.
+func NewFileTokenProvider(path string, opener fileioperformer.FileIoPerformer) AuthenticationTokenProvider {
+	loader := authtokenloader.NewAuthTokenLoader(opener)
+	return AuthenticationTokenProviderFunc(func(_ context.Context) (string, time.Time, error) {
+		token, err := loader.Load(path)
+		if err != nil {
+			return "", time.Time{}, NewErrSecretStore(fmt.Sprintf("unable to load the token file %s: %s", path, err.Error()))
+		}
+		return token, time.Time{}, nil
+	})
+}
+
+// NewEncryptedFileTokenProvider returns an AuthenticationTokenProvider reading the token from the token file at path
+// encrypted for the service with the encryptedtoken package. The token is decrypted in memory for each read, with the
+// key material of source.
+func NewEncryptedFileTokenProvider(path string, source encryptedtoken.KeySource, serviceKey string) AuthenticationTokenProvider {
+	return AuthenticationTokenProviderFunc(func(ctx context.Context) (string, time.Time, error) {
+		token, err := encryptedtoken.ReadToken(ctx, path, source, serviceKey)
+		if err != nil {
+			return "", time.Time{}, NewErrSecretStore(fmt.Sprintf("unable to load the encrypted token file %s: %s", path, err.Error()))
+		}
+		return token, time.Time{}, nil
+	})
+}
+
+// newEncryptedFileTokenProvider creates the provider of the encrypted token file from its settings
+func newEncryptedFileTokenProvider(path string, info types.TokenFileEncryptionInfo) (AuthenticationTokenProvider, error) {
+	if info.ServiceKey == "" {
+		return nil, NewErrSecretStore("TokenFileEncryption ServiceKey is required in config for an encrypted token file")
+	}
+
+	source := encryptedtoken.NewFileKeySource(info.KeyFile)
+	if info.KeySource != "" {
+		var ok bool
+		if source, ok = encryptedtoken.LookupKeySource(info.KeySource); !ok {
+			return nil, NewErrSecretStore(fmt.Sprintf("no token file KeySource '%s' is registered", info.KeySource))
+		}
+	}
+
+	return NewEncryptedFileTokenProvider(path, source, info.ServiceKey), nil
+}
+
+// NewEnvTokenProvider returns an AuthenticationTokenProvider reading the token from the environment variable name,
+// DefaultTokenEnvVar when name is empty
+func NewEnvTokenProvider(name string) AuthenticationTokenProvider {
+	if name == "" {
+		name = DefaultTokenEnvVar
+	}
+	return AuthenticationTokenProviderFunc(func(_ context.Context) (string, time.Time, error) {
+		token, ok := os.LookupEnv(name)
+		if !ok || token == "" {
+			return "", time.Time{}, NewErrSecretStore(fmt.Sprintf("the %s environment variable holding the token is not set", name))
+		}
+		return token, time.Time{}, nil
+	})
+}
@@ -0,0 +1,147 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/encryptedtoken"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+func TestRegisterAuthMethod(t *testing.T) {
+	factory := func(_ types.SecretConfig, _ AuthLoginFunc) (AuthenticationTokenProvider, error) {
+		return AuthenticationTokenProviderFunc(func(_ context.Context) (string, time.Time, error) {
+			return "token", time.Time{}, nil
+		}), nil
+	}
+
+	RegisterAuthMethod("test-method", factory)
+	found, ok := LookupAuthMethod("test-method")
+	require.True(t, ok)
+	provider, err := found(types.SecretConfig{}, nil)
+	require.NoError(t, err)
+	token, _, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+
+	assert.Subset(t, AuthMethods(), []string{AuthMethodEnv, AuthMethodFile, "test-method"})
+
+	_, ok = LookupAuthMethod("unknown")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { RegisterAuthMethod("test-method", factory) })
+	assert.Panics(t, func() { RegisterAuthMethod("test-nil", nil) })
+}
+
+func TestFileTokenProvider(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "secrets-token.json")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(`{"auth":{"client_token":"first-token"}}`), 0600))
+
+	factory, ok := LookupAuthMethod(AuthMethodFile)
+	require.True(t, ok)
+	_, err := factory(types.SecretConfig{}, nil)
+	require.Error(t, err, "TokenFile is required")
+
+	provider, err := factory(types.SecretConfig{Authentication: types.AuthenticationInfo{TokenFile: tokenFile}}, nil)
+	require.NoError(t, err)
+
+	token, expiry, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "first-token", token)
+	assert.True(t, expiry.IsZero())
+
+	// the file is read again for each token
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(`{"auth":{"client_token":"second-token"}}`), 0600))
+	token, _, err = provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "second-token", token)
+
+	missing := NewFileTokenProvider(filepath.Join(dir, "missing.json"), fileioperformer.NewDefaultFileIoPerformer())
+	_, _, err = missing.GetToken(context.Background())
+	require.Error(t, err)
+	assert.IsType(t, ErrSecretStore{}, err)
+}
+
+func TestEnvTokenProvider(t *testing.T) {
+	name := "TEST_ENV_TOKEN_PROVIDER"
+	provider := NewEnvTokenProvider(name)
+
+	_, _, err := provider.GetToken(context.Background())
+	require.Error(t, err)
+
+	require.NoError(t, os.Setenv(name, "env-token"))
+	defer func() { _ = os.Unsetenv(name) }()
+
+	token, expiry, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "env-token", token)
+	assert.True(t, expiry.IsZero())
+}
+
+func TestEncryptedFileTokenProvider(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "machine-id")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("machine-secret"), 0600))
+
+	contents, err := encryptedtoken.Encrypt([]byte(`{"auth":{"client_token":"encrypted-token"}}`), []byte("machine-secret"), "core-data")
+	require.NoError(t, err)
+	tokenFile := filepath.Join(dir, "secrets-token.json.enc")
+	require.NoError(t, ioutil.WriteFile(tokenFile, contents, 0600))
+
+	factory, ok := LookupAuthMethod(AuthMethodFile)
+	require.True(t, ok)
+
+	tests := []struct {
+		name        string
+		encryption  types.TokenFileEncryptionInfo
+		expectError bool
+	}{
+		{"Valid", types.TokenFileEncryptionInfo{ServiceKey: "core-data", KeyFile: keyFile}, false},
+		{"Invalid - other service", types.TokenFileEncryptionInfo{ServiceKey: "core-command", KeyFile: keyFile}, true},
+		{"Invalid - no service key", types.TokenFileEncryptionInfo{KeyFile: keyFile}, true},
+		{"Invalid - unknown key source", types.TokenFileEncryptionInfo{ServiceKey: "core-data", KeySource: "unknown"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := types.SecretConfig{Authentication: types.AuthenticationInfo{TokenFile: tokenFile,
+				TokenFileEncryption: test.encryption}}
+			provider, err := factory(config, nil)
+			if err == nil {
+				_, _, err = provider.GetToken(context.Background())
+			}
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			token, _, err := provider.GetToken(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "encrypted-token", token)
+		})
+	}
+}
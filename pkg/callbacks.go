@@ -0,0 +1,56 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"path"
+	"sync"
+)
+
+// SecretUpdatedCallbacks holds the SecretUpdatedCallbacks registered for each sub-path, for the SecretClients to
+// notify after storing secrets. The zero value is ready to use and it is safe for concurrent use.
+type SecretUpdatedCallbacks struct {
+	mutex     sync.RWMutex
+	callbacks map[string][]SecretUpdatedCallback
+}
+
+// Register adds callback to the callbacks notified when secrets are stored at subPath
+func (s *SecretUpdatedCallbacks) Register(subPath string, callback SecretUpdatedCallback) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.callbacks == nil {
+		s.callbacks = make(map[string][]SecretUpdatedCallback)
+	}
+	key := callbackKey(subPath)
+	s.callbacks[key] = append(s.callbacks[key], callback)
+}
+
+// Notify invokes the callbacks registered for subPath. The callbacks are invoked without holding the lock, so they
+// may register further callbacks or store secrets themselves.
+func (s *SecretUpdatedCallbacks) Notify(subPath string) {
+	s.mutex.RLock()
+	callbacks := s.callbacks[callbackKey(subPath)]
+	s.mutex.RUnlock()
+
+	for _, callback := range callbacks {
+		callback(subPath)
+	}
+}
+
+// callbackKey normalizes the sub-path so "redisdb", "/redisdb" and "/redisdb/" share their callbacks
+func callbackKey(subPath string) string {
+	return path.Clean("/" + subPath)
+}
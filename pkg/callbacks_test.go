@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretUpdatedCallbacks(t *testing.T) {
+	var callbacks SecretUpdatedCallbacks
+	var notified []string
+
+	// notifying without any registered callbacks is a no-op
+	callbacks.Notify("/redisdb")
+
+	callbacks.Register("/redisdb", func(subPath string) { notified = append(notified, "first:"+subPath) })
+	callbacks.Register("redisdb/", func(subPath string) { notified = append(notified, "second:"+subPath) })
+	callbacks.Register("/mqtt", func(subPath string) { notified = append(notified, "mqtt:"+subPath) })
+
+	callbacks.Notify("redisdb")
+	assert.Equal(t, []string{"first:redisdb", "second:redisdb"}, notified)
+}
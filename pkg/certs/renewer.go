@@ -0,0 +1,352 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package certs keeps the TLS certificates of a service issued by the PKI secrets engine current, renewing them
+// before they expire and writing them where the service loads them from.
+package certs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	defaultRenewFraction = 2.0 / 3.0
+	defaultMaxRetries    = 3
+	defaultRetryInterval = time.Second
+
+	certificatePerm = 0644
+	privateKeyPerm  = 0600
+)
+
+var (
+	// ErrAlreadyTracked is returned by Track when a certificate with the same name is already tracked
+	ErrAlreadyTracked = errors.New("certificate is already tracked")
+	// ErrNoExpiration is reported when an issued certificate has no expiration to schedule its renewal from
+	ErrNoExpiration = errors.New("issued certificate has no expiration")
+)
+
+// Issuer issues certificates. The secrets.SecretStoreClient satisfies this interface.
+type Issuer interface {
+	IssueCertificate(ctx context.Context, token string, mountPoint string, roleName string, request types.CertificateRequest) (types.Certificate, error)
+}
+
+// CertificateSpec describes a certificate to issue and keep renewed, and where to write it. The file paths are
+// optional, the files are replaced atomically so a service never loads a certificate which doesn't match its key.
+type CertificateSpec struct {
+	// Name identifies the certificate, i.e. "core-data-tls"
+	Name       string
+	MountPoint string
+	RoleName   string
+	Request    types.CertificateRequest
+	// CertFile receives the certificate followed by its CA chain, KeyFile the private key and CAFile the issuing CA
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	// OnIssued, when set, is called with every certificate issued for the spec, including the first one
+	OnIssued func(name string, certificate types.Certificate)
+}
+
+// Options configures when a CertRenewer renews and how it retries. Zero values select the defaults.
+type Options struct {
+	// RenewFraction is the fraction of the certificate's lifetime which elapses before it is renewed, defaults to 2/3
+	RenewFraction float64
+	// MaxRetries is the number of times a failed renewal is retried before the renewal of that certificate fails,
+	// defaults to 3. A negative value disables retries.
+	MaxRetries int
+	// RetryInterval is the wait before the first retry, which doubles for every following retry. Defaults to 1s.
+	RetryInterval time.Duration
+	// OnFailure, when set, is called with the certificate name and the error when its renewal fails and stops
+	OnFailure func(name string, err error)
+}
+
+// CertRenewer issues the tracked certificates and renews each of them in its own goroutine until it is untracked,
+// the renewer is stopped or the renewal fails. Consumers are signalled on the Reload channel after a renewal.
+type CertRenewer struct {
+	issuer   Issuer
+	token    func() string
+	lc       logger.LoggingClient
+	options  Options
+	failures chan error
+	reload   chan struct{}
+	// now abstracts the clock used to schedule the renewals, which is most useful for testing
+	now func() time.Time
+
+	mutex        sync.Mutex
+	certificates map[string]*trackedCertificate
+}
+
+type trackedCertificate struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCertRenewer creates a CertRenewer which issues certificates with the issuer, authenticating with the token
+// returned by token so a renewed secret store token is picked up
+func NewCertRenewer(issuer Issuer, token func() string, lc logger.LoggingClient, options Options) *CertRenewer {
+	if options.RenewFraction <= 0 || options.RenewFraction >= 1 {
+		options.RenewFraction = defaultRenewFraction
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = defaultMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = defaultRetryInterval
+	}
+
+	return &CertRenewer{
+		issuer:       issuer,
+		token:        token,
+		lc:           lc,
+		options:      options,
+		failures:     make(chan error, 1),
+		reload:       make(chan struct{}, 1),
+		now:          time.Now,
+		certificates: make(map[string]*trackedCertificate),
+	}
+}
+
+// Failures returns the channel on which the error is sent when the renewal of a certificate fails. The send doesn't
+// block, so a failure is dropped when the previous one has not been received.
+func (r *CertRenewer) Failures() <-chan error {
+	return r.failures
+}
+
+// Reload returns the channel signalled after certificates are renewed and written. Signals are coalesced, so a
+// consumer reloads all of its certificates when it receives one.
+func (r *CertRenewer) Reload() <-chan struct{} {
+	return r.reload
+}
+
+// Track issues the certificate described by spec, writes it and keeps renewing it in the background. The issued
+// certificate is returned, an error is returned when the first issuance or write fails. ctx bounds the first
+// issuance only, the renewals go on until Untrack or Stop.
+func (r *CertRenewer) Track(ctx context.Context, spec CertificateSpec) (types.Certificate, error) {
+	if spec.Name == "" || spec.RoleName == "" || spec.Request.CommonName == "" {
+		return types.Certificate{}, errors.New("certificate name, PKI role name and common name cannot be empty")
+	}
+
+	r.mutex.Lock()
+	_, exists := r.certificates[spec.Name]
+	r.mutex.Unlock()
+	if exists {
+		return types.Certificate{}, fmt.Errorf("%w: '%s'", ErrAlreadyTracked, spec.Name)
+	}
+
+	certificate, issuedAt, err := r.issue(ctx, spec)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+	if err := writeCertificate(spec, certificate); err != nil {
+		return types.Certificate{}, err
+	}
+	if spec.OnIssued != nil {
+		spec.OnIssued(spec.Name, certificate)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.certificates[spec.Name]; exists {
+		return types.Certificate{}, fmt.Errorf("%w: '%s'", ErrAlreadyTracked, spec.Name)
+	}
+
+	renewCtx, cancel := context.WithCancel(context.Background())
+	tracked := &trackedCertificate{cancel: cancel, done: make(chan struct{})}
+	r.certificates[spec.Name] = tracked
+	go r.run(renewCtx, tracked.done, spec, certificate, issuedAt)
+
+	return certificate, nil
+}
+
+// Untrack stops renewing the named certificate, leaving its files in place
+func (r *CertRenewer) Untrack(name string) {
+	r.mutex.Lock()
+	tracked, ok := r.certificates[name]
+	delete(r.certificates, name)
+	r.mutex.Unlock()
+
+	if ok {
+		tracked.stop()
+	}
+}
+
+// Stop stops all the renewals and waits for the background goroutines to exit
+func (r *CertRenewer) Stop() {
+	r.mutex.Lock()
+	certificates := r.certificates
+	r.certificates = make(map[string]*trackedCertificate)
+	r.mutex.Unlock()
+
+	for _, tracked := range certificates {
+		tracked.stop()
+	}
+}
+
+func (t *trackedCertificate) stop() {
+	t.cancel()
+	<-t.done
+}
+
+func (r *CertRenewer) run(ctx context.Context, done chan struct{}, spec CertificateSpec, certificate types.Certificate, issuedAt time.Time) {
+	defer close(done)
+
+	for {
+		if certificate.Expiration.IsZero() {
+			r.fail(spec.Name, ErrNoExpiration)
+			return
+		}
+
+		lifetime := certificate.Expiration.Sub(issuedAt)
+		timer := time.NewTimer(time.Duration(float64(lifetime) * r.options.RenewFraction))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		renewed, renewedAt, err := r.renew(ctx, spec)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = writeCertificate(spec, renewed)
+		}
+		if err != nil {
+			r.fail(spec.Name, err)
+			return
+		}
+
+		r.lc.Debugf("certificate '%s' renewed, expires at %v", spec.Name, renewed.Expiration)
+		if spec.OnIssued != nil {
+			spec.OnIssued(spec.Name, renewed)
+		}
+		select {
+		case r.reload <- struct{}{}:
+		default:
+		}
+
+		certificate, issuedAt = renewed, renewedAt
+	}
+}
+
+func (r *CertRenewer) issue(ctx context.Context, spec CertificateSpec) (types.Certificate, time.Time, error) {
+	issuedAt := r.now()
+	certificate, err := r.issuer.IssueCertificate(ctx, r.token(), spec.MountPoint, spec.RoleName, spec.Request)
+	return certificate, issuedAt, err
+}
+
+// renew issues a new certificate for the spec, retrying with an exponential backoff
+func (r *CertRenewer) renew(ctx context.Context, spec CertificateSpec) (types.Certificate, time.Time, error) {
+	wait := r.options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		certificate, issuedAt, err := r.issue(ctx, spec)
+		if err == nil || attempt >= r.options.MaxRetries {
+			return certificate, issuedAt, err
+		}
+
+		r.lc.Warnf("unable to renew certificate '%s', retrying in %v: %v", spec.Name, wait, err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return types.Certificate{}, time.Time{}, ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+func (r *CertRenewer) fail(name string, err error) {
+	err = fmt.Errorf("renewal of certificate '%s' stopped: %w", name, err)
+	r.lc.Error(err.Error())
+
+	select {
+	case r.failures <- err:
+	default:
+	}
+
+	if r.options.OnFailure != nil {
+		r.options.OnFailure(name, err)
+	}
+}
+
+// writeCertificate writes the certificate files configured by the spec, the key first so the certificate file
+// never refers to a key which hasn't been written yet
+func writeCertificate(spec CertificateSpec, certificate types.Certificate) error {
+	chain := []string{certificate.Certificate}
+	for _, ca := range certificate.CAChain {
+		if ca != certificate.Certificate {
+			chain = append(chain, ca)
+		}
+	}
+
+	files := []struct {
+		path string
+		data string
+		perm os.FileMode
+	}{
+		{spec.KeyFile, certificate.PrivateKey.Reveal(), privateKeyPerm},
+		{spec.CertFile, strings.Join(chain, "\n"), certificatePerm},
+		{spec.CAFile, certificate.IssuingCA, certificatePerm},
+	}
+	for _, file := range files {
+		if file.path == "" {
+			continue
+		}
+		if err := writeFileAtomic(file.path, []byte(file.data+"\n"), file.perm); err != nil {
+			return fmt.Errorf("unable to write certificate '%s' to %s: %w", spec.Name, file.path, err)
+		}
+	}
+
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it to path, so readers see either the
+// previous or the new content
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Chmod(perm); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,210 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package certs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// fakeIssuer issues numbered certificates valid for lifetime, or fails when err is set
+type fakeIssuer struct {
+	mutex    sync.Mutex
+	issued   int
+	lifetime time.Duration
+	err      error
+}
+
+func (f *fakeIssuer) IssueCertificate(_ context.Context, token string, mountPoint string, roleName string, request types.CertificateRequest) (types.Certificate, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.err != nil {
+		return types.Certificate{}, f.err
+	}
+	if token != "test-token" || mountPoint != "pki_int" || roleName != "edgex-services" {
+		return types.Certificate{}, errors.New("unexpected issue request")
+	}
+
+	f.issued++
+	certificate := types.Certificate{
+		Certificate: fmt.Sprintf("cert-%d:%s", f.issued, request.CommonName),
+		IssuingCA:   "intermediate",
+		CAChain:     []string{"intermediate", "root"},
+		PrivateKey:  types.SecretString(fmt.Sprintf("key-%d", f.issued)),
+	}
+	if f.lifetime > 0 {
+		certificate.Expiration = time.Now().Add(f.lifetime)
+	}
+	return certificate, nil
+}
+
+func (f *fakeIssuer) setErr(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.err = err
+}
+
+func (f *fakeIssuer) issueCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.issued
+}
+
+func testSpec(dir string) CertificateSpec {
+	return CertificateSpec{
+		Name:       "core-data",
+		MountPoint: "pki_int",
+		RoleName:   "edgex-services",
+		Request:    types.CertificateRequest{CommonName: "core-data.edgex"},
+		CertFile:   filepath.Join(dir, "cert.pem"),
+		KeyFile:    filepath.Join(dir, "key.pem"),
+		CAFile:     filepath.Join(dir, "ca.pem"),
+	}
+}
+
+func testToken() string { return "test-token" }
+
+func readFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestTrackWritesAndRenews(t *testing.T) {
+	dir := t.TempDir()
+	issuer := &fakeIssuer{lifetime: 200 * time.Millisecond}
+	renewer := NewCertRenewer(issuer, testToken, logger.NewMockClient(), Options{RenewFraction: 0.25})
+	defer renewer.Stop()
+
+	var mutex sync.Mutex
+	var issued []string
+	spec := testSpec(dir)
+	spec.OnIssued = func(name string, certificate types.Certificate) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		issued = append(issued, certificate.Certificate)
+	}
+
+	certificate, err := renewer.Track(context.Background(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "cert-1:core-data.edgex", certificate.Certificate)
+	assert.Equal(t, "cert-1:core-data.edgex\nintermediate\nroot\n", readFile(t, spec.CertFile))
+	assert.Equal(t, "key-1\n", readFile(t, spec.KeyFile))
+	assert.Equal(t, "intermediate\n", readFile(t, spec.CAFile))
+	info, err := os.Stat(spec.KeyFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(privateKeyPerm), info.Mode().Perm())
+
+	_, err = renewer.Track(context.Background(), spec)
+	assert.True(t, errors.Is(err, ErrAlreadyTracked))
+
+	select {
+	case <-renewer.Reload():
+	case <-time.After(time.Second):
+		require.Fail(t, "expected a reload signal after the renewal")
+	}
+	assert.Eventually(t, func() bool { return readFile(t, spec.KeyFile) != "key-1\n" }, time.Second, 5*time.Millisecond)
+
+	renewer.Untrack("core-data")
+	count := issuer.issueCount()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, count, issuer.issueCount(), "no renewals are expected once untracked")
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, "cert-1:core-data.edgex", issued[0])
+	// a renewal interrupted by Untrack is not reported
+	assert.GreaterOrEqual(t, len(issued), 2)
+	assert.LessOrEqual(t, len(issued), count)
+
+	// only the temporary files are expected to be cleaned up
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+}
+
+func TestTrackFailures(t *testing.T) {
+	issuer := &fakeIssuer{err: errors.New("permission denied")}
+	renewer := NewCertRenewer(issuer, testToken, logger.NewMockClient(), Options{})
+
+	_, err := renewer.Track(context.Background(), testSpec(t.TempDir()))
+	require.Error(t, err)
+	_, err = renewer.Track(context.Background(), CertificateSpec{Name: "missing-role"})
+	require.Error(t, err)
+
+	spec := testSpec(filepath.Join(t.TempDir(), "missing"))
+	issuer.setErr(nil)
+	_, err = renewer.Track(context.Background(), spec)
+	require.Error(t, err, "expected the certificate write to fail")
+}
+
+func TestRenewalFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		lifetime    time.Duration
+		expectedErr error
+	}{
+		{"Renewal error", 100 * time.Millisecond, nil},
+		{"No expiration", 0, ErrNoExpiration},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			issuer := &fakeIssuer{lifetime: test.lifetime}
+			var failed string
+			options := Options{
+				RenewFraction: 0.1,
+				MaxRetries:    1,
+				RetryInterval: time.Millisecond,
+				OnFailure:     func(name string, err error) { failed = name },
+			}
+			renewer := NewCertRenewer(issuer, testToken, logger.NewMockClient(), options)
+
+			_, err := renewer.Track(context.Background(), testSpec(t.TempDir()))
+			require.NoError(t, err)
+			issuer.setErr(errors.New("PKI mount is sealed"))
+
+			select {
+			case err := <-renewer.Failures():
+				assert.Contains(t, err.Error(), "core-data")
+				if test.expectedErr != nil {
+					assert.True(t, errors.Is(err, test.expectedErr))
+				} else {
+					assert.Contains(t, err.Error(), "PKI mount is sealed")
+				}
+			case <-time.After(time.Second):
+				require.Fail(t, "expected the renewal to fail")
+			}
+
+			renewer.Stop()
+			assert.Equal(t, "core-data", failed)
+		})
+	}
+}
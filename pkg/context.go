@@ -0,0 +1,64 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+type namespaceKey struct{}
+
+type retryPolicyKey struct{}
+
+type callerIdentityKey struct{}
+
+// WithNamespace returns a copy of ctx which overrides the configured secret store namespace for the requests made
+// with it, i.e. to reach the secrets of another tenant. An empty namespace selects the root namespace.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// NamespaceFromContext returns the namespace set on ctx by WithNamespace, ok is false when none is set
+func NamespaceFromContext(ctx context.Context) (namespace string, ok bool) {
+	namespace, ok = ctx.Value(namespaceKey{}).(string)
+	return namespace, ok
+}
+
+// WithRetryPolicy returns a copy of ctx which overrides the retry policy of the client for the requests made with it,
+// i.e. to disable retries of a request which must fail fast
+func WithRetryPolicy(ctx context.Context, policy types.RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy set on ctx by WithRetryPolicy, ok is false when none is set
+func RetryPolicyFromContext(ctx context.Context) (policy types.RetryPolicy, ok bool) {
+	policy, ok = ctx.Value(retryPolicyKey{}).(types.RetryPolicy)
+	return policy, ok
+}
+
+// WithCallerIdentity returns a copy of ctx identifying the caller on whose behalf the secrets are accessed with it,
+// i.e. a service name or a user, as recorded by the secrets.AuditingClient
+func WithCallerIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, callerIdentityKey{}, identity)
+}
+
+// CallerIdentityFromContext returns the caller identity set on ctx by WithCallerIdentity, ok is false when none is
+// set
+func CallerIdentityFromContext(ctx context.Context) (identity string, ok bool) {
+	identity, ok = ctx.Value(callerIdentityKey{}).(string)
+	return identity, ok
+}
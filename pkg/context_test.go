@@ -0,0 +1,57 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+func TestWithNamespace(t *testing.T) {
+	_, ok := NamespaceFromContext(context.Background())
+	assert.False(t, ok)
+
+	namespace, ok := NamespaceFromContext(WithNamespace(context.Background(), "tenant-a"))
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-a", namespace)
+
+	namespace, ok = NamespaceFromContext(WithNamespace(WithNamespace(context.Background(), "tenant-a"), ""))
+	assert.True(t, ok)
+	assert.Equal(t, "", namespace)
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	_, ok := RetryPolicyFromContext(context.Background())
+	assert.False(t, ok)
+
+	policy := types.RetryPolicy{MaxAttempts: 5, InitialInterval: time.Second}
+	actual, ok := RetryPolicyFromContext(WithRetryPolicy(context.Background(), policy))
+	assert.True(t, ok)
+	assert.Equal(t, policy, actual)
+}
+
+func TestWithCallerIdentity(t *testing.T) {
+	_, ok := CallerIdentityFromContext(context.Background())
+	assert.False(t, ok)
+
+	identity, ok := CallerIdentityFromContext(WithCallerIdentity(context.Background(), "core-data"))
+	assert.True(t, ok)
+	assert.Equal(t, "core-data", identity)
+}
@@ -16,23 +16,54 @@ package pkg
 
 import (
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
 )
 
 // ErrSecretStore error for unexpected problems with the secret store.
 type ErrSecretStore struct {
 	description string
+	// cause is the typed error of the failed response, when the error comes from one
+	cause error
 }
 
 func (e ErrSecretStore) Error() string {
 	return fmt.Sprintf("Error found on handling secrets from underlying data-store: %s", e.description)
 }
 
+// Unwrap returns the typed error of the failed secret store response causing the error, i.e. an ErrSealed, or nil.
+func (e ErrSecretStore) Unwrap() error {
+	return e.cause
+}
+
 // NewErrSecretStore creates an ErrSecretStore error type.
 func NewErrSecretStore(description string) ErrSecretStore {
 	return ErrSecretStore{description: description}
 }
 
+// NewErrSecretStoreFromResponse creates an ErrSecretStore for a failed secret store response, wrapping the typed
+// error of the response status so that errors.Is and errors.As also match it.
+func NewErrSecretStoreFromResponse(description string, statusCode int, errors []string) ErrSecretStore {
+	cause := NewErrFromResponse(description, statusCode, errors)
+	if _, generic := cause.(ErrSecretStore); generic {
+		cause = nil
+	}
+	return ErrSecretStore{description: description, cause: cause}
+}
+
+// NewErrSecretStoreNotFound creates an ErrSecretStore for a sub-path at which no secret exists, wrapping an
+// ErrSecretNotFound like NewErrSecretStoreFromResponse does for a 404 response, so that errors.Is and errors.As
+// match it with the providers which are not reached over HTTP too.
+func NewErrSecretStoreNotFound(subPath string) ErrSecretStore {
+	description := fmt.Sprintf("No secret exists at the subpath: '%s'", subPath)
+	return ErrSecretStore{
+		description: description,
+		cause:       ErrSecretNotFound{responseError{description: fmt.Sprintf("request to read '%s'", subPath), statusCode: http.StatusNotFound}},
+	}
+}
+
 // ErrSecretsNotFound error when a secret cannot be found. This aids in differentiating between empty("") values and non-existent keys
 type ErrSecretsNotFound struct {
 	keys []string
@@ -46,3 +77,365 @@ func (scnf ErrSecretsNotFound) Error() string {
 func NewErrSecretsNotFound(keys []string) ErrSecretsNotFound {
 	return ErrSecretsNotFound{keys: keys}
 }
+
+// ErrSecretVersionConflict error when a check-and-set write is rejected because the secret is no longer at the
+// expected version, i.e. another writer stored a new version first.
+type ErrSecretVersionConflict struct {
+	subPath         string
+	expectedVersion int
+}
+
+func (e ErrSecretVersionConflict) Error() string {
+	return fmt.Sprintf("Secret at the subpath: '%s' is no longer at the expected version %d", e.subPath, e.expectedVersion)
+}
+
+// ExpectedVersion returns the version the secret was expected to be at by the rejected write.
+func (e ErrSecretVersionConflict) ExpectedVersion() int {
+	return e.expectedVersion
+}
+
+// NewErrSecretVersionConflict creates a new ErrSecretVersionConflict error.
+func NewErrSecretVersionConflict(subPath string, expectedVersion int) ErrSecretVersionConflict {
+	return ErrSecretVersionConflict{subPath: subPath, expectedVersion: expectedVersion}
+}
+
+// ErrMissingCapabilities error when the client's token lacks capabilities it needs on a path, i.e. the "read"
+// capability on the path of the service's secrets.
+type ErrMissingCapabilities struct {
+	path    string
+	missing []string
+	granted []string
+}
+
+func (e ErrMissingCapabilities) Error() string {
+	return fmt.Sprintf("Token is missing the capabilities %v on the path: '%s', granted capabilities are %v", e.missing, e.path, e.granted)
+}
+
+// Missing returns the capabilities which the token lacks.
+func (e ErrMissingCapabilities) Missing() []string {
+	return e.missing
+}
+
+// NewErrMissingCapabilities creates a new ErrMissingCapabilities error.
+func NewErrMissingCapabilities(path string, missing []string, granted []string) ErrMissingCapabilities {
+	return ErrMissingCapabilities{path: path, missing: missing, granted: granted}
+}
+
+// ErrMultipleSecrets error when the secrets of some of the sub-paths read together could not be retrieved.
+type ErrMultipleSecrets struct {
+	errors map[string]error
+}
+
+func (e ErrMultipleSecrets) Error() string {
+	subPaths := make([]string, 0, len(e.errors))
+	for subPath := range e.errors {
+		subPaths = append(subPaths, subPath)
+	}
+	sort.Strings(subPaths)
+
+	failures := make([]string, len(subPaths))
+	for index, subPath := range subPaths {
+		failures[index] = fmt.Sprintf("'%s': %s", subPath, e.errors[subPath].Error())
+	}
+	return fmt.Sprintf("Unable to get the secrets of %d sub-paths: %s", len(subPaths), strings.Join(failures, "; "))
+}
+
+// Errors returns the error of each sub-path whose secrets could not be retrieved.
+func (e ErrMultipleSecrets) Errors() map[string]error {
+	return e.errors
+}
+
+// NewErrMultipleSecrets creates a new ErrMultipleSecrets error.
+func NewErrMultipleSecrets(errors map[string]error) ErrMultipleSecrets {
+	return ErrMultipleSecrets{errors: errors}
+}
+
+// ErrSecretEngineNotFound error when no secrets engine of the expected type is mounted at a mount point, either as
+// nothing is mounted there or as an engine of another type is.
+type ErrSecretEngineNotFound struct {
+	mountPoint  string
+	engine      string
+	mountedType string
+}
+
+func (e ErrSecretEngineNotFound) Error() string {
+	if e.mountedType != "" {
+		return fmt.Sprintf("No %s secrets engine is mounted at '%s', a %s secrets engine is", e.engine, e.mountPoint, e.mountedType)
+	}
+	return fmt.Sprintf("No %s secrets engine is mounted at '%s'", e.engine, e.mountPoint)
+}
+
+// MountPoint returns the mount point which was checked.
+func (e ErrSecretEngineNotFound) MountPoint() string {
+	return e.mountPoint
+}
+
+// Engine returns the type of secrets engine which was expected.
+func (e ErrSecretEngineNotFound) Engine() string {
+	return e.engine
+}
+
+// MountedType returns the type of the secrets engine mounted at the mount point instead, or "" when there is none.
+func (e ErrSecretEngineNotFound) MountedType() string {
+	return e.mountedType
+}
+
+// NewErrSecretEngineNotFound creates a new ErrSecretEngineNotFound error.
+func NewErrSecretEngineNotFound(mountPoint string, engine string, mountedType string) ErrSecretEngineNotFound {
+	return ErrSecretEngineNotFound{mountPoint: mountPoint, engine: engine, mountedType: mountedType}
+}
+
+// ErrRateLimited error when the secret store rejected a request as exceeding a rate limit quota, i.e. Vault
+// responding 429 Too Many Requests. RetryAfter is the store's hint of how long to wait before trying again.
+type ErrRateLimited struct {
+	responseError
+	retryAfter time.Duration
+	limit      int
+	remaining  int
+}
+
+func (e ErrRateLimited) Error() string {
+	message := fmt.Sprintf("Request was rate limited by the secret store with status %d", e.statusCode)
+	if e.description != "" {
+		message = e.describe("Request was rate limited by the secret store")
+	}
+	if e.retryAfter > 0 {
+		message += fmt.Sprintf(", retry after %v", e.retryAfter)
+	}
+	if e.limit > 0 {
+		message += fmt.Sprintf(" (%d of %d requests remaining)", e.remaining, e.limit)
+	}
+	return message
+}
+
+// RetryAfter returns how long the secret store asked the client to wait before retrying, or zero without a hint.
+func (e ErrRateLimited) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Limit returns the request quota of the rate limit, or zero when the secret store did not report it.
+func (e ErrRateLimited) Limit() int {
+	return e.limit
+}
+
+// Remaining returns the requests remaining in the current rate limit window.
+func (e ErrRateLimited) Remaining() int {
+	return e.remaining
+}
+
+// Is reports whether target is an ErrRateLimited, so that errors.Is(err, ErrRateLimited{}) matches any of them.
+func (e ErrRateLimited) Is(target error) bool {
+	_, ok := target.(ErrRateLimited)
+	return ok
+}
+
+// NewErrRateLimited creates a new ErrRateLimited error.
+func NewErrRateLimited(statusCode int, retryAfter time.Duration, limit int, remaining int) ErrRateLimited {
+	return ErrRateLimited{
+		responseError: responseError{statusCode: statusCode},
+		retryAfter:    retryAfter,
+		limit:         limit,
+		remaining:     remaining,
+	}
+}
+
+// ErrCircuitOpen error when a request is rejected without calling the secret store, as recent requests failed
+// and the circuit breaker is open until the secret store is probed again.
+type ErrCircuitOpen struct {
+	retryAt time.Time
+}
+
+func (e ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("Circuit breaker is open after repeated secret store failures, next attempt at %s",
+		e.retryAt.Format(time.RFC3339))
+}
+
+// RetryAt returns when the circuit breaker next lets a request through to probe the secret store.
+func (e ErrCircuitOpen) RetryAt() time.Time {
+	return e.retryAt
+}
+
+// NewErrCircuitOpen creates a new ErrCircuitOpen error.
+func NewErrCircuitOpen(retryAt time.Time) ErrCircuitOpen {
+	return ErrCircuitOpen{retryAt: retryAt}
+}
+
+// ErrUnsealIncomplete error when the secret store is still sealed after all the provided key shares were applied.
+type ErrUnsealIncomplete struct {
+	applied   int
+	threshold int
+	progress  int
+}
+
+func (e ErrUnsealIncomplete) Error() string {
+	return fmt.Sprintf("Secret store is still sealed after applying %d key shares, %d of the %d required key shares are submitted",
+		e.applied, e.progress, e.threshold)
+}
+
+// Applied returns the number of key shares which were applied.
+func (e ErrUnsealIncomplete) Applied() int {
+	return e.applied
+}
+
+// Threshold returns the number of key shares required to unseal the secret store.
+func (e ErrUnsealIncomplete) Threshold() int {
+	return e.threshold
+}
+
+// Progress returns the number of key shares the secret store holds towards the threshold, which includes shares
+// submitted before the call.
+func (e ErrUnsealIncomplete) Progress() int {
+	return e.progress
+}
+
+// NewErrUnsealIncomplete creates a new ErrUnsealIncomplete error.
+func NewErrUnsealIncomplete(applied int, threshold int, progress int) ErrUnsealIncomplete {
+	return ErrUnsealIncomplete{applied: applied, threshold: threshold, progress: progress}
+}
+
+// responseError carries the HTTP status of a failed secret store response and the error strings of its body
+type responseError struct {
+	description string
+	statusCode  int
+	errors      []string
+}
+
+// StatusCode returns the HTTP status the secret store responded with.
+func (e responseError) StatusCode() int {
+	return e.statusCode
+}
+
+// Errors returns the error strings of the response body, i.e. the "errors" of a Vault response.
+func (e responseError) Errors() []string {
+	return e.errors
+}
+
+func (e responseError) describe(summary string) string {
+	message := fmt.Sprintf("%s, %s failed with status %d", summary, e.description, e.statusCode)
+	if len(e.errors) > 0 {
+		message += ": " + strings.Join(e.errors, "; ")
+	}
+	return message
+}
+
+// ErrSecretNotFound error when nothing exists at the path of a request to the secret store. Unlike
+// ErrSecretsNotFound, which names the keys missing from the secrets at a path, the path itself is missing.
+type ErrSecretNotFound struct {
+	responseError
+}
+
+func (e ErrSecretNotFound) Error() string {
+	return e.describe("No secret exists at the path")
+}
+
+// Is reports whether target is an ErrSecretNotFound, so that errors.Is(err, ErrSecretNotFound{}) matches any of them.
+func (e ErrSecretNotFound) Is(target error) bool {
+	_, ok := target.(ErrSecretNotFound)
+	return ok
+}
+
+// ErrPermissionDenied error when the token is not allowed to make a request, lacking a policy granting it.
+type ErrPermissionDenied struct {
+	responseError
+}
+
+func (e ErrPermissionDenied) Error() string {
+	return e.describe("Permission denied by the secret store")
+}
+
+// Is reports whether target is an ErrPermissionDenied, so that errors.Is(err, ErrPermissionDenied{}) matches any of
+// them, including an ErrTokenExpired.
+func (e ErrPermissionDenied) Is(target error) bool {
+	_, ok := target.(ErrPermissionDenied)
+	return ok
+}
+
+// ErrTokenExpired error when the token of a request is no longer valid, as it expired or was revoked. It is a kind
+// of ErrPermissionDenied, as which the secret store reports it.
+type ErrTokenExpired struct {
+	responseError
+}
+
+func (e ErrTokenExpired) Error() string {
+	return e.describe("Token is expired or revoked")
+}
+
+// Is reports whether target is an ErrTokenExpired or an ErrPermissionDenied.
+func (e ErrTokenExpired) Is(target error) bool {
+	switch target.(type) {
+	case ErrTokenExpired, ErrPermissionDenied:
+		return true
+	}
+	return false
+}
+
+// ErrSealed error when the secret store cannot serve a request as it is sealed.
+type ErrSealed struct {
+	responseError
+}
+
+func (e ErrSealed) Error() string {
+	return e.describe("Secret store is sealed")
+}
+
+// Is reports whether target is an ErrSealed, so that errors.Is(err, ErrSealed{}) matches any of them.
+func (e ErrSealed) Is(target error) bool {
+	_, ok := target.(ErrSealed)
+	return ok
+}
+
+// ErrAlreadyExists error when the secret store refuses to create what already exists, i.e. when it is initialized
+// again or a secrets engine is enabled at a path in use. Re-runs of a bootstrap can treat it as success.
+type ErrAlreadyExists struct {
+	responseError
+}
+
+func (e ErrAlreadyExists) Error() string {
+	return e.describe("Already exists in the secret store")
+}
+
+// Is reports whether target is an ErrAlreadyExists, so that errors.Is(err, ErrAlreadyExists{}) matches any of them.
+func (e ErrAlreadyExists) Is(target error) bool {
+	_, ok := target.(ErrAlreadyExists)
+	return ok
+}
+
+// NewErrFromResponse creates the typed error of a failed secret store response from its status and the error
+// strings of its body. description names the request, i.e. "request to read secrets". A status without a type of
+// its own gives an ErrSecretStore.
+func NewErrFromResponse(description string, statusCode int, errors []string) error {
+	response := responseError{description: description, statusCode: statusCode, errors: errors}
+
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrSecretNotFound{response}
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		// Vault reports most token problems as "permission denied", so only the explicit ones are told apart
+		if containsError(errors, "invalid token", "bad token", "token expired", "token is expired") {
+			return ErrTokenExpired{response}
+		}
+		return ErrPermissionDenied{response}
+	case statusCode == http.StatusServiceUnavailable && containsError(errors, "sealed"):
+		return ErrSealed{response}
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited{responseError: response}
+	case statusCode == http.StatusBadRequest &&
+		containsError(errors, "already initialized", "already in use", "existing mount"):
+		return ErrAlreadyExists{response}
+	default:
+		return ErrSecretStore{description: response.describe("Unexpected response from the secret store")}
+	}
+}
+
+// containsError reports whether any of the error strings contains any of the phrases, ignoring case
+func containsError(errors []string, phrases ...string) bool {
+	for _, err := range errors {
+		err = strings.ToLower(err)
+		for _, phrase := range phrases {
+			if strings.Contains(err, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewErrFromResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		errors     []string
+		expected   error
+	}{
+		{"Not found", http.StatusNotFound, nil, ErrSecretNotFound{}},
+		{"Permission denied", http.StatusForbidden, []string{"1 error occurred:\n\t* permission denied\n\n"}, ErrPermissionDenied{}},
+		{"Token expired", http.StatusForbidden, []string{"2 errors occurred:\n\t* permission denied\n\t* invalid token\n\n"}, ErrTokenExpired{}},
+		{"Sealed", http.StatusServiceUnavailable, []string{"Vault is sealed"}, ErrSealed{}},
+		{"Unavailable", http.StatusServiceUnavailable, []string{"standby node"}, ErrSecretStore{}},
+		{"Already initialized", http.StatusBadRequest, []string{"Vault is already initialized"}, ErrAlreadyExists{}},
+		{"Mount in use", http.StatusBadRequest, []string{"path is already in use at secret/"}, ErrAlreadyExists{}},
+		{"Bad request", http.StatusBadRequest, []string{"missing client token"}, ErrSecretStore{}},
+		{"Rate limited", http.StatusTooManyRequests, []string{"rate limit quota exceeded"}, ErrRateLimited{}},
+		{"Server error", http.StatusInternalServerError, []string{"internal error"}, ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewErrFromResponse("request to read secrets", test.statusCode, test.errors)
+			require.IsType(t, test.expected, err)
+			assert.Contains(t, err.Error(), "request to read secrets")
+			if _, generic := err.(ErrSecretStore); generic {
+				return
+			}
+
+			assert.True(t, errors.Is(err, test.expected))
+			status, ok := err.(interface{ StatusCode() int })
+			require.True(t, ok)
+			assert.Equal(t, test.statusCode, status.StatusCode())
+		})
+	}
+}
+
+func TestErrorMatching(t *testing.T) {
+	vaultErrors := []string{"invalid token"}
+	err := fmt.Errorf("unable to get secrets: %w",
+		NewErrSecretStoreFromResponse("Received a '403' response from the secret store", http.StatusForbidden, vaultErrors))
+
+	assert.True(t, errors.Is(err, ErrTokenExpired{}))
+	assert.True(t, errors.Is(err, ErrPermissionDenied{}), "expired tokens are denied permission")
+	assert.False(t, errors.Is(err, ErrSealed{}))
+
+	var expired ErrTokenExpired
+	require.True(t, errors.As(err, &expired))
+	assert.Equal(t, http.StatusForbidden, expired.StatusCode())
+	assert.Equal(t, vaultErrors, expired.Errors())
+
+	var storeErr ErrSecretStore
+	require.True(t, errors.As(err, &storeErr), "still an ErrSecretStore for existing callers")
+
+	generic := NewErrSecretStoreFromResponse("Received a '500' response from the secret store", http.StatusInternalServerError, nil)
+	assert.Nil(t, generic.Unwrap())
+
+	notFound := NewErrSecretStoreNotFound("/redisdb")
+	assert.True(t, errors.Is(notFound, ErrSecretNotFound{}))
+	assert.Contains(t, notFound.Error(), "No secret exists at the subpath: '/redisdb'")
+}
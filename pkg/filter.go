@@ -0,0 +1,44 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+// FilterSecrets returns the subset of data for the specified keys. If no keys are specified all of data is returned.
+// An ErrSecretsNotFound error is returned listing every requested key which is not present in data.
+// This gives all of the secret store providers the same GetSecrets semantics.
+func FilterSecrets(data map[string]string, keys ...string) (map[string]string, error) {
+	// Do not filter any of the secrets
+	if len(keys) <= 0 {
+		return data, nil
+	}
+
+	values := make(map[string]string)
+	var notFound []string
+
+	for _, key := range keys {
+		value, success := data[key]
+		if !success {
+			notFound = append(notFound, key)
+			continue
+		}
+
+		values[key] = value
+	}
+
+	if len(notFound) > 0 {
+		return nil, NewErrSecretsNotFound(notFound)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,51 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterSecrets(t *testing.T) {
+	data := map[string]string{"one": "uno", "two": "dos", "three": "tres"}
+
+	tests := []struct {
+		name        string
+		keys        []string
+		expected    map[string]string
+		expectError bool
+	}{
+		{"All keys", nil, data, false},
+		{"Some keys", []string{"one", "two"}, map[string]string{"one": "uno", "two": "dos"}, false},
+		{"Missing key", []string{"one", "four"}, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := FilterSecrets(data, test.keys...)
+			if test.expectError {
+				require.Error(t, err)
+				assert.IsType(t, ErrSecretsNotFound{}, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
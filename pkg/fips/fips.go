@@ -0,0 +1,171 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package fips restricts the cryptography of the module to FIPS 140 approved algorithms. The mode is always on in
+// binaries built with the "fips" build tag and is otherwise switched on at runtime, either by setting the EnvVar
+// environment variable to true or by calling SetEnabled. In FIPS mode TLS is limited to TLS 1.2 and 1.3 with
+// AES-GCM cipher suites over the NIST curves, and local crypto using other algorithms, such as the X25519 encryption
+// of key shares, returns an ErrNotApproved instead.
+//
+// The mode only selects the algorithms; a validated cryptographic module, i.e. a FIPS validated Go toolchain, is
+// still required for a compliant deployment.
+package fips
+
+import (
+	"crypto/elliptic"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+// EnvVar is the environment variable switching FIPS mode on at startup when set to true
+const EnvVar = "EDGEX_SECRETS_FIPS_MODE"
+
+// runtimeEnabled is 1 while FIPS mode is switched on at runtime
+var runtimeEnabled int32
+
+// CipherSuites are the FIPS approved TLS 1.2 cipher suites, negotiated in the order listed. The TLS 1.3 cipher suites
+// are not configurable in crypto/tls; a FIPS validated toolchain limits them to the approved AES-GCM suites.
+var CipherSuites = []uint16{
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+}
+
+// CurvePreferences are the FIPS approved curves of the TLS key exchange
+var CurvePreferences = []tls.CurveID{tls.CurveP256, tls.CurveP384, tls.CurveP521}
+
+func init() {
+	if on, err := strconv.ParseBool(os.Getenv(EnvVar)); err == nil && on {
+		SetEnabled(true)
+	}
+}
+
+// Enabled reports whether FIPS mode is on
+func Enabled() bool {
+	return buildTagEnabled || atomic.LoadInt32(&runtimeEnabled) == 1
+}
+
+// SetEnabled switches FIPS mode on or off at runtime. FIPS mode cannot be switched off in binaries built with the
+// "fips" build tag. It must be switched on before the clients are created, as their TLS settings are fixed then.
+func SetEnabled(enabled bool) {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&runtimeEnabled, value)
+}
+
+// ErrNotApproved error when an algorithm which is not FIPS approved is used in FIPS mode
+type ErrNotApproved struct {
+	algorithm string
+}
+
+func (e ErrNotApproved) Error() string {
+	return fmt.Sprintf("%s is not a FIPS approved algorithm and is disabled in FIPS mode", e.algorithm)
+}
+
+// Algorithm returns the algorithm which was rejected
+func (e ErrNotApproved) Algorithm() string {
+	return e.algorithm
+}
+
+// NewErrNotApproved creates an ErrNotApproved error for the algorithm
+func NewErrNotApproved(algorithm string) ErrNotApproved {
+	return ErrNotApproved{algorithm: algorithm}
+}
+
+// Reject returns an ErrNotApproved for the algorithm in FIPS mode, and nil otherwise. It guards the uses of
+// algorithms which are never approved.
+func Reject(algorithm string) error {
+	if Enabled() {
+		return NewErrNotApproved(algorithm)
+	}
+	return nil
+}
+
+// CheckCurve returns an ErrNotApproved in FIPS mode unless curve is P-256, P-384 or P-521
+func CheckCurve(curve elliptic.Curve) error {
+	if !Enabled() {
+		return nil
+	}
+
+	switch curve {
+	case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		return nil
+	}
+
+	name := "the custom elliptic curve"
+	if curve != nil && curve.Params() != nil && curve.Params().Name != "" {
+		name = curve.Params().Name
+	}
+	return NewErrNotApproved(name)
+}
+
+// ConfigureTLS restricts config to TLS 1.2 or later with the approved CipherSuites and CurvePreferences in FIPS
+// mode, and leaves it unchanged otherwise. A MinVersion below TLS 1.2 is raised to it, while TLS 1.3 stays allowed
+// and a MinVersion or MaxVersion of TLS 1.3 is kept. A MaxVersion below TLS 1.2 is an error. Cipher suites and curves
+// already set in config must all be approved; they are kept, so that the order of preference set by the caller is
+// respected.
+func ConfigureTLS(config *tls.Config) error {
+	if !Enabled() {
+		return nil
+	}
+
+	if config.MaxVersion != 0 && config.MaxVersion < tls.VersionTLS12 {
+		return NewErrNotApproved("TLS versions below 1.2")
+	}
+	for _, suite := range config.CipherSuites {
+		if !containsSuite(CipherSuites, suite) {
+			return NewErrNotApproved(fmt.Sprintf("the TLS cipher suite %s", tls.CipherSuiteName(suite)))
+		}
+	}
+	for _, curve := range config.CurvePreferences {
+		if !containsCurve(CurvePreferences, curve) {
+			return NewErrNotApproved(fmt.Sprintf("the TLS curve %d", curve))
+		}
+	}
+
+	if config.MinVersion < tls.VersionTLS12 {
+		config.MinVersion = tls.VersionTLS12
+	}
+	if len(config.CipherSuites) == 0 {
+		config.CipherSuites = append([]uint16(nil), CipherSuites...)
+	}
+	if len(config.CurvePreferences) == 0 {
+		config.CurvePreferences = append([]tls.CurveID(nil), CurvePreferences...)
+	}
+	return nil
+}
+
+func containsSuite(suites []uint16, suite uint16) bool {
+	for _, approved := range suites {
+		if approved == suite {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCurve(curves []tls.CurveID, curve tls.CurveID) bool {
+	for _, approved := range curves {
+		if approved == curve {
+			return true
+		}
+	}
+	return false
+}
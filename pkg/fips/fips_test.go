@@ -0,0 +1,136 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package fips
+
+import (
+	"crypto/elliptic"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enable switches FIPS mode on for the test, returning the function switching it back off
+func enable(t *testing.T) func() {
+	SetEnabled(true)
+	require.True(t, Enabled())
+	return func() { SetEnabled(false) }
+}
+
+func TestSetEnabled(t *testing.T) {
+	if buildTagEnabled {
+		t.Skip("FIPS mode cannot be switched off with the fips build tag")
+	}
+
+	assert.False(t, Enabled())
+	assert.NoError(t, Reject("MD5"))
+	assert.NoError(t, CheckCurve(elliptic.P224()))
+
+	SetEnabled(true)
+	assert.True(t, Enabled())
+	SetEnabled(false)
+	assert.False(t, Enabled())
+}
+
+func TestReject(t *testing.T) {
+	defer enable(t)()
+
+	err := Reject("MD5")
+	require.Error(t, err)
+	var notApproved ErrNotApproved
+	require.True(t, errors.As(err, &notApproved))
+	assert.Equal(t, "MD5", notApproved.Algorithm())
+	assert.Contains(t, err.Error(), "MD5 is not a FIPS approved algorithm")
+}
+
+func TestCheckCurve(t *testing.T) {
+	defer enable(t)()
+
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		assert.NoError(t, CheckCurve(curve), curve.Params().Name)
+	}
+
+	err := CheckCurve(elliptic.P224())
+	require.Error(t, err)
+	assert.Equal(t, "P-224", err.(ErrNotApproved).Algorithm())
+}
+
+func TestConfigureTLS(t *testing.T) {
+	defer enable(t)()
+
+	tests := []struct {
+		name        string
+		config      *tls.Config
+		expected    *tls.Config
+		expectError bool
+	}{
+		{"Defaults", &tls.Config{ServerName: "vault"}, &tls.Config{
+			ServerName:       "vault",
+			MinVersion:       tls.VersionTLS12,
+			CipherSuites:     CipherSuites,
+			CurvePreferences: CurvePreferences,
+		}, false},
+		{"Approved preferences kept", &tls.Config{
+			CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			CurvePreferences: []tls.CurveID{tls.CurveP384},
+		}, &tls.Config{
+			MinVersion:       tls.VersionTLS12,
+			CipherSuites:     []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			CurvePreferences: []tls.CurveID{tls.CurveP384},
+		}, false},
+		{"TLS 1.3 kept", &tls.Config{MinVersion: tls.VersionTLS13, MaxVersion: tls.VersionTLS13}, &tls.Config{
+			MinVersion:       tls.VersionTLS13,
+			MaxVersion:       tls.VersionTLS13,
+			CipherSuites:     CipherSuites,
+			CurvePreferences: CurvePreferences,
+		}, false},
+		{"TLS 1.0 raised", &tls.Config{MinVersion: tls.VersionTLS10}, &tls.Config{
+			MinVersion:       tls.VersionTLS12,
+			CipherSuites:     CipherSuites,
+			CurvePreferences: CurvePreferences,
+		}, false},
+		{"TLS 1.1 maximum", &tls.Config{MaxVersion: tls.VersionTLS11}, nil, true},
+		{"ChaCha20 cipher suite", &tls.Config{
+			CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256},
+		}, nil, true},
+		{"X25519 curve", &tls.Config{CurvePreferences: []tls.CurveID{tls.X25519}}, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ConfigureTLS(test.config)
+			if test.expectError {
+				require.Error(t, err)
+				assert.IsType(t, ErrNotApproved{}, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, test.config)
+		})
+	}
+}
+
+func TestConfigureTLSDisabled(t *testing.T) {
+	if buildTagEnabled {
+		t.Skip("FIPS mode cannot be switched off with the fips build tag")
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS13}
+	require.NoError(t, ConfigureTLS(config))
+	assert.Equal(t, &tls.Config{MinVersion: tls.VersionTLS13}, config)
+}
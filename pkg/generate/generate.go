@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package generate generates secret values, passwords, API keys and key pairs, from crypto/rand. The values are
+// used by the rotation framework to rotate secrets and by the secrets Importer to seed secrets which are not given
+// a value in the manifest.
+package generate
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Lowercase is the lowercase ASCII letters
+	Lowercase = "abcdefghijklmnopqrstuvwxyz"
+	// Uppercase is the uppercase ASCII letters
+	Uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// Digits is the decimal digits
+	Digits = "0123456789"
+	// Symbols is the ASCII punctuation which doesn't need quoting in URLs, shells or connection strings
+	Symbols = "-_.~!@#%^*+="
+	// Alphanumeric is the charset of the passwords generated when no charset is given
+	Alphanumeric = Lowercase + Uppercase + Digits
+
+	// DefaultPasswordLength is the length of the passwords generated when no length is given
+	DefaultPasswordLength = 32
+	// DefaultKeySize is the number of random bytes of the API keys generated when no size is given
+	DefaultKeySize = 32
+)
+
+const (
+	// KindPassword names the Password values in NewValue specs
+	KindPassword = "password"
+	// KindHex names the Hex values in NewValue specs
+	KindHex = "hex"
+	// KindBase64 names the Base64 values in NewValue specs
+	KindBase64 = "base64"
+)
+
+// Value generates a new secret value each time it is called
+type Value func() (string, error)
+
+// Password generates a password of length characters picked uniformly from charset, or from Alphanumeric when
+// charset is empty
+func Password(length int, charset string) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("password length must be positive, got %d", length)
+	}
+	if charset == "" {
+		charset = Alphanumeric
+	}
+
+	characters := []rune(charset)
+	max := big.NewInt(int64(len(characters)))
+	password := make([]rune, length)
+	for index := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("unable to generate a password: %w", err)
+		}
+		password[index] = characters[n.Int64()]
+	}
+	return string(password), nil
+}
+
+// Hex generates an API key of size random bytes, hex encoded
+func Hex(size int) (string, error) {
+	key, err := randomBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// Base64 generates an API key of size random bytes, encoded as unpadded URL-safe base64 so it can be used in
+// headers and query strings as is
+func Base64(size int) (string, error) {
+	key, err := randomBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// PasswordValue returns a Value generating passwords with Password
+func PasswordValue(length int, charset string) Value {
+	return func() (string, error) {
+		return Password(length, charset)
+	}
+}
+
+// HexValue returns a Value generating API keys with Hex
+func HexValue(size int) Value {
+	return func() (string, error) {
+		return Hex(size)
+	}
+}
+
+// Base64Value returns a Value generating API keys with Base64
+func Base64Value(size int) Value {
+	return func() (string, error) {
+		return Base64(size)
+	}
+}
+
+// NewValue returns the Value of a spec "<kind>" or "<kind>:<length>", where kind is KindPassword, KindHex or
+// KindBase64 and length is the password length or key size, i.e. "password:16". Passwords are Alphanumeric.
+func NewValue(spec string) (Value, error) {
+	kind, lengthSpec := spec, ""
+	index := strings.Index(spec, ":")
+	if index >= 0 {
+		kind, lengthSpec = spec[:index], spec[index+1:]
+	}
+
+	length := DefaultPasswordLength
+	if kind != KindPassword {
+		length = DefaultKeySize
+	}
+	if index >= 0 {
+		var err error
+		if length, err = strconv.Atoi(lengthSpec); err != nil || length <= 0 {
+			return nil, fmt.Errorf("invalid length in the generated value spec '%s'", spec)
+		}
+	}
+
+	switch kind {
+	case KindPassword:
+		return PasswordValue(length, ""), nil
+	case KindHex:
+		return HexValue(length), nil
+	case KindBase64:
+		return Base64Value(length), nil
+	default:
+		return nil, fmt.Errorf("unknown kind of generated value '%s'", kind)
+	}
+}
+
+func randomBytes(size int) ([]byte, error) {
+	if size <= 0 {
+		return nil, errors.New("key size must be positive")
+	}
+
+	key := make([]byte, size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("unable to generate a key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,94 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package generate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+)
+
+// MinRSABits is the smallest RSA key size generated
+const MinRSABits = 2048
+
+// KeyPair is a private key, PKCS #8 encoded in a "PRIVATE KEY" PEM block, and its public key, PKIX encoded in a
+// "PUBLIC KEY" PEM block
+type KeyPair struct {
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// RSAKeyPair generates an RSA key pair of the given size in bits, at least MinRSABits
+func RSAKeyPair(bits int) (KeyPair, error) {
+	if bits < MinRSABits {
+		return KeyPair{}, fmt.Errorf("RSA key size must be at least %d bits, got %d", MinRSABits, bits)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("unable to generate an RSA key: %w", err)
+	}
+	return encodeKeyPair(key, key.Public())
+}
+
+// ECDSAKeyPair generates an ECDSA key pair on the curve, P-256 when curve is nil. Only P-256, P-384 and P-521 are
+// available in FIPS mode.
+func ECDSAKeyPair(curve elliptic.Curve) (KeyPair, error) {
+	if curve == nil {
+		curve = elliptic.P256()
+	}
+	if err := fips.CheckCurve(curve); err != nil {
+		return KeyPair{}, err
+	}
+
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("unable to generate an ECDSA key: %w", err)
+	}
+	return encodeKeyPair(key, key.Public())
+}
+
+// Ed25519KeyPair generates an Ed25519 key pair. EdDSA is approved by FIPS 186-5, so it is also available in FIPS mode.
+func Ed25519KeyPair() (KeyPair, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("unable to generate an Ed25519 key: %w", err)
+	}
+	return encodeKeyPair(private, public)
+}
+
+func encodeKeyPair(private crypto.PrivateKey, public crypto.PublicKey) (KeyPair, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("unable to encode the private key: %w", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return KeyPair{}, fmt.Errorf("unable to encode the public key: %w", err)
+	}
+
+	return KeyPair{
+		PrivateKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}),
+		PublicKey:  pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}),
+	}, nil
+}
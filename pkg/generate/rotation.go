@@ -0,0 +1,62 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package generate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/rotation"
+)
+
+// Secrets returns a rotation.Generator replacing the value of each key with a new one from its Value. The other
+// keys of the current secrets are kept, i.e. the username next to a rotated password.
+func Secrets(values map[string]Value) rotation.Generator {
+	return rotation.GeneratorFunc(func(_ context.Context, subPath string, current map[string]string) (map[string]string, error) {
+		next := copySecrets(current)
+		for key, value := range values {
+			generated, err := value()
+			if err != nil {
+				return nil, fmt.Errorf("unable to generate '%s' of the secrets at '%s': %w", key, subPath, err)
+			}
+			next[key] = generated
+		}
+		return next, nil
+	})
+}
+
+// KeyPairSecrets returns a rotation.Generator replacing the privateKey and publicKey keys with the PEM of a new
+// key pair from newKeyPair, i.e. Ed25519KeyPair. The other keys of the current secrets are kept.
+func KeyPairSecrets(privateKey string, publicKey string, newKeyPair func() (KeyPair, error)) rotation.Generator {
+	return rotation.GeneratorFunc(func(_ context.Context, subPath string, current map[string]string) (map[string]string, error) {
+		pair, err := newKeyPair()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate the key pair of the secrets at '%s': %w", subPath, err)
+		}
+
+		next := copySecrets(current)
+		next[privateKey] = string(pair.PrivateKey)
+		next[publicKey] = string(pair.PublicKey)
+		return next, nil
+	})
+}
+
+func copySecrets(secrets map[string]string) map[string]string {
+	copied := make(map[string]string, len(secrets))
+	for key, value := range secrets {
+		copied[key] = value
+	}
+	return copied
+}
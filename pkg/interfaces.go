@@ -14,7 +14,11 @@
 
 package pkg
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 // Caller interface used to abstract the implementation details for issuing an HTTP request. This allows for easier testing by the way of mocks.
 type Caller interface {
@@ -23,3 +27,47 @@ type Caller interface {
 
 // TokenExpiredCallback is the callback function to handle the case when the vault token has already expired
 type TokenExpiredCallback func(expiredToken string) (replacementToken string, retry bool)
+
+// ReauthenticationCallback is the callback function notified when the token could no longer be renewed and the client
+// logged in again with its auth method. reason is why the token could not be renewed and err is the login error,
+// which is nil when the new token is in use.
+type ReauthenticationCallback func(reason error, err error)
+
+// WarningCallback is the callback function notified with the warnings of a successful secret store response, such
+// as Vault warning about a deprecated path. path is the API path of the request.
+type WarningCallback func(path string, warnings []string)
+
+// SecretUpdatedCallback is the callback function notified with the sub-path of secrets which were successfully
+// stored through the client
+type SecretUpdatedCallback func(subPath string)
+
+// Metrics records a client's interactions with the secret store, so an adapter can export them to Prometheus, the
+// EdgeX telemetry or another metrics system. The metrics.Collector implementation serves them to Prometheus as is.
+type Metrics interface {
+	// RequestCompleted records a request for the operation, i.e. "GET sys/health", its status code, 0 when no
+	// response was received, and its latency including any retries
+	RequestCompleted(operation string, status int, latency time.Duration)
+	// TokenRenewed records a renewal of the client's own token
+	TokenRenewed(success bool)
+	// CacheLookup records a lookup of the secrets of a sub-path in a cache
+	CacheLookup(hit bool)
+}
+
+// Tracer starts the spans of the requests to the secret store. It is implemented by adapting a tracing library,
+// i.e. Start calling an OpenTelemetry trace.Tracer and Inject the propagator of the OpenTelemetry SDK.
+type Tracer interface {
+	// Start starts a span named for the operation, i.e. "GET sys/health", as a child of the span in ctx and returns
+	// the context holding the new span
+	Start(ctx context.Context, operation string) (context.Context, Span)
+	// Inject adds the trace context of ctx to the headers of the request, i.e. the W3C traceparent header, so the
+	// spans of the secret store join the trace
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttribute sets an attribute of the span, i.e. "http.status_code"
+	SetAttribute(key string, value interface{})
+	// End ends the span, with the error when the request failed without a response
+	End(err error)
+}
@@ -0,0 +1,280 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package lease tracks the leases of dynamic secrets, renewing them in the background and revoking them on shutdown.
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	defaultRenewFraction = 2.0 / 3.0
+	defaultMaxRetries    = 3
+	defaultRetryInterval = time.Second
+)
+
+var (
+	// ErrMaxTTLReached is reported when a renewal no longer extends a lease, as its max TTL was reached
+	ErrMaxTTLReached = errors.New("lease has reached its max TTL and can no longer be renewed")
+	// ErrAlreadyTracked is returned by Track when a lease with the same id is already tracked
+	ErrAlreadyTracked = errors.New("lease is already tracked")
+	// ErrShutdown is returned by Track once the LeaseManager has been shut down
+	ErrShutdown = errors.New("lease manager has been shut down")
+)
+
+// Client renews and revokes leases. The secrets.DynamicSecretClient satisfies this interface.
+type Client interface {
+	RenewLease(ctx context.Context, leaseId string, increment time.Duration) (types.Lease, error)
+	RevokeLease(ctx context.Context, leaseId string) error
+}
+
+// Options configures when a LeaseManager renews leases and how it retries. Zero values select the defaults.
+type Options struct {
+	// RenewFraction is the fraction of the lease duration which elapses before the lease is renewed, defaults to 2/3
+	RenewFraction float64
+	// Increment is the extension requested on each renewal. Defaults to zero, which requests the lease's
+	// original duration.
+	Increment time.Duration
+	// MaxRetries is the number of times a failed renewal is retried before the renewal of that lease fails,
+	// defaults to 3. A negative value disables retries.
+	MaxRetries int
+	// RetryInterval is the wait before the first retry, which doubles for every following retry. Defaults to 1s.
+	RetryInterval time.Duration
+	// OnFailure, when set, is called with the lease and the error when its renewal fails and its renewal stops.
+	// Callers typically read new credentials, as the secret store revokes the old ones once the lease expires.
+	OnFailure func(lease types.Lease, err error)
+}
+
+// LeaseManager renews each tracked lease in its own goroutine until the lease is untracked, revoked or its
+// renewal fails. Leases which are not renewable are only tracked so they are revoked on Shutdown.
+type LeaseManager struct {
+	client   Client
+	lc       logger.LoggingClient
+	options  Options
+	failures chan error
+	// now abstracts the clock used to track the lease expiry, which is most useful for testing
+	now func() time.Time
+
+	mutex    sync.Mutex
+	leases   map[string]*trackedLease
+	shutdown bool
+}
+
+type trackedLease struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewLeaseManager creates a LeaseManager which renews and revokes leases with the client
+func NewLeaseManager(client Client, lc logger.LoggingClient, options Options) *LeaseManager {
+	if options.RenewFraction <= 0 || options.RenewFraction >= 1 {
+		options.RenewFraction = defaultRenewFraction
+	}
+	if options.Increment < 0 {
+		options.Increment = 0
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = defaultMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = defaultRetryInterval
+	}
+
+	return &LeaseManager{
+		client:   client,
+		lc:       lc,
+		options:  options,
+		failures: make(chan error, 1),
+		now:      time.Now,
+		leases:   make(map[string]*trackedLease),
+	}
+}
+
+// Failures returns the channel on which the error is sent when the renewal of a lease fails. The send doesn't
+// block, so a failure is dropped when the previous one has not been received.
+func (m *LeaseManager) Failures() <-chan error {
+	return m.failures
+}
+
+// Track starts tracking the lease returned with a dynamic secret, renewing it before it expires when it is renewable
+func (m *LeaseManager) Track(lease types.Lease) error {
+	if lease.LeaseId == "" {
+		return errors.New("lease id cannot be empty to track a lease")
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.shutdown {
+		return ErrShutdown
+	}
+	if _, ok := m.leases[lease.LeaseId]; ok {
+		return fmt.Errorf("%w: '%s'", ErrAlreadyTracked, lease.LeaseId)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tracked := &trackedLease{cancel: cancel, done: make(chan struct{})}
+	m.leases[lease.LeaseId] = tracked
+
+	if lease.Renewable && lease.LeaseDuration > 0 {
+		go m.run(ctx, tracked.done, lease)
+	} else {
+		close(tracked.done)
+	}
+
+	return nil
+}
+
+// Leases returns the ids of the tracked leases
+func (m *LeaseManager) Leases() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	ids := make([]string, 0, len(m.leases))
+	for id := range m.leases {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Untrack stops renewing and tracking the lease without revoking it, so it is left to expire
+func (m *LeaseManager) Untrack(leaseId string) {
+	m.mutex.Lock()
+	tracked, ok := m.leases[leaseId]
+	delete(m.leases, leaseId)
+	m.mutex.Unlock()
+
+	if ok {
+		tracked.stop()
+	}
+}
+
+// Revoke stops tracking the lease and revokes it, which invalidates the dynamic secret
+func (m *LeaseManager) Revoke(ctx context.Context, leaseId string) error {
+	m.Untrack(leaseId)
+	return m.client.RevokeLease(ctx, leaseId)
+}
+
+// Shutdown stops all the renewals and revokes every tracked lease. All the leases are revoked even when some
+// revocations fail, the returned error then lists the failures.
+func (m *LeaseManager) Shutdown(ctx context.Context) error {
+	m.mutex.Lock()
+	m.shutdown = true
+	leases := m.leases
+	m.leases = make(map[string]*trackedLease)
+	m.mutex.Unlock()
+
+	var failures []string
+	for id, tracked := range leases {
+		tracked.stop()
+		if err := m.client.RevokeLease(ctx, id); err != nil {
+			failures = append(failures, fmt.Sprintf("'%s': %v", id, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to revoke %d of %d leases: %s", len(failures), len(leases), strings.Join(failures, "; "))
+	}
+
+	m.lc.Debugf("revoked %d leases on shutdown", len(leases))
+	return nil
+}
+
+func (t *trackedLease) stop() {
+	t.cancel()
+	<-t.done
+}
+
+func (m *LeaseManager) run(ctx context.Context, done chan struct{}, lease types.Lease) {
+	defer close(done)
+
+	duration := time.Duration(lease.LeaseDuration) * time.Second
+	expiry := m.now().Add(duration)
+	for {
+		timer := time.NewTimer(time.Duration(float64(duration) * m.options.RenewFraction))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		renewed, err := m.renew(ctx, lease.LeaseId)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			duration = time.Duration(renewed.LeaseDuration) * time.Second
+			newExpiry := m.now().Add(duration)
+			if duration <= 0 || !newExpiry.After(expiry) {
+				err = ErrMaxTTLReached
+			}
+			expiry = newExpiry
+		}
+		if err != nil {
+			m.fail(lease, err)
+			return
+		}
+
+		m.lc.Debugf("lease '%s' renewed, new duration: %v", lease.LeaseId, duration)
+		lease.LeaseDuration = renewed.LeaseDuration
+	}
+}
+
+// renew renews the lease, retrying with an exponential backoff
+func (m *LeaseManager) renew(ctx context.Context, leaseId string) (types.Lease, error) {
+	wait := m.options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		lease, err := m.client.RenewLease(ctx, leaseId, m.options.Increment)
+		if err == nil || attempt >= m.options.MaxRetries {
+			return lease, err
+		}
+
+		m.lc.Warnf("unable to renew lease '%s', retrying in %v: %v", leaseId, wait, err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return types.Lease{}, ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+func (m *LeaseManager) fail(lease types.Lease, err error) {
+	err = fmt.Errorf("renewal of lease '%s' stopped: %w", lease.LeaseId, err)
+	m.lc.Error(err.Error())
+
+	select {
+	case m.failures <- err:
+	default:
+	}
+
+	if m.options.OnFailure != nil {
+		m.options.OnFailure(lease, err)
+	}
+}
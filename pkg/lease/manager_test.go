@@ -0,0 +1,170 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// fakeClient renews leases for the configured durations in order, repeating the last one, or always fails when
+// renewErr is set. Revocations of the ids in revokeErrs fail.
+type fakeClient struct {
+	mutex      sync.Mutex
+	renewals   map[string]int
+	durations  []int
+	renewErr   error
+	revoked    []string
+	revokeErrs map[string]error
+}
+
+func (f *fakeClient) RenewLease(_ context.Context, leaseId string, _ time.Duration) (types.Lease, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.renewals[leaseId]++
+	if f.renewErr != nil {
+		return types.Lease{}, f.renewErr
+	}
+	index := f.renewals[leaseId] - 1
+	if index >= len(f.durations) {
+		index = len(f.durations) - 1
+	}
+	return types.Lease{LeaseId: leaseId, LeaseDuration: f.durations[index], Renewable: true}, nil
+}
+
+func (f *fakeClient) RevokeLease(_ context.Context, leaseId string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if err := f.revokeErrs[leaseId]; err != nil {
+		return err
+	}
+	f.revoked = append(f.revoked, leaseId)
+	return nil
+}
+
+func (f *fakeClient) renewCount(leaseId string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.renewals[leaseId]
+}
+
+func newFakeClient(durations ...int) *fakeClient {
+	return &fakeClient{renewals: make(map[string]int), durations: durations}
+}
+
+// a 1s lease renewed at 5% of its duration is renewed every 50ms
+var fastOptions = Options{RenewFraction: 0.05, RetryInterval: time.Millisecond}
+
+func TestRenewsTrackedLeases(t *testing.T) {
+	client := newFakeClient(1)
+	manager := NewLeaseManager(client, logger.NewMockClient(), fastOptions)
+
+	require.NoError(t, manager.Track(types.Lease{LeaseId: "renewable", LeaseDuration: 1, Renewable: true}))
+	require.NoError(t, manager.Track(types.Lease{LeaseId: "fixed", LeaseDuration: 1}))
+	assert.True(t, errors.Is(manager.Track(types.Lease{LeaseId: "fixed"}), ErrAlreadyTracked))
+	require.Error(t, manager.Track(types.Lease{}))
+
+	assert.Eventually(t, func() bool { return client.renewCount("renewable") >= 3 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 0, client.renewCount("fixed"), "leases which are not renewable are not expected to be renewed")
+
+	ids := manager.Leases()
+	sort.Strings(ids)
+	assert.Equal(t, []string{"fixed", "renewable"}, ids)
+
+	manager.Untrack("renewable")
+	calls := client.renewCount("renewable")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, calls, client.renewCount("renewable"), "no renewals are expected once untracked")
+	assert.Empty(t, client.revoked, "untracked leases are not expected to be revoked")
+	assert.Empty(t, manager.Failures())
+}
+
+func TestRenewalFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      *fakeClient
+		expectedErr error
+	}{
+		{"Renewal error", &fakeClient{renewals: make(map[string]int), renewErr: errors.New("permission denied")}, nil},
+		{"Max TTL reached", newFakeClient(1, 0), ErrMaxTTLReached},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var failed types.Lease
+			options := fastOptions
+			options.MaxRetries = 2
+			options.OnFailure = func(lease types.Lease, err error) { failed = lease }
+			manager := NewLeaseManager(test.client, logger.NewMockClient(), options)
+
+			require.NoError(t, manager.Track(types.Lease{LeaseId: "lease", LeaseDuration: 1, Renewable: true}))
+
+			select {
+			case err := <-manager.Failures():
+				assert.Contains(t, err.Error(), "lease")
+				if test.expectedErr != nil {
+					assert.True(t, errors.Is(err, test.expectedErr))
+				} else {
+					assert.Equal(t, 3, test.client.renewCount("lease"), "the failed renewal is expected to be retried")
+				}
+			case <-time.After(time.Second):
+				require.Fail(t, "expected the renewal to fail")
+			}
+
+			// the failed lease is still revoked on shutdown
+			require.NoError(t, manager.Shutdown(context.Background()))
+			assert.Equal(t, "lease", failed.LeaseId)
+			assert.Equal(t, []string{"lease"}, test.client.revoked)
+		})
+	}
+}
+
+func TestRevokeAndShutdown(t *testing.T) {
+	client := newFakeClient(1)
+	client.revokeErrs = map[string]error{"failing": errors.New("connection refused")}
+	manager := NewLeaseManager(client, logger.NewMockClient(), fastOptions)
+
+	for _, id := range []string{"revoked", "other", "failing"} {
+		require.NoError(t, manager.Track(types.Lease{LeaseId: id, LeaseDuration: 1, Renewable: true}))
+	}
+
+	require.NoError(t, manager.Revoke(context.Background(), "revoked"))
+	assert.Equal(t, []string{"revoked"}, client.revoked)
+
+	err := manager.Shutdown(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to revoke 1 of 2 leases")
+	assert.Contains(t, err.Error(), "connection refused")
+	assert.Equal(t, []string{"revoked", "other"}, client.revoked)
+	assert.Empty(t, manager.Leases())
+
+	calls := client.renewCount("other")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, calls, client.renewCount("other"), "no renewals are expected after Shutdown")
+	assert.Equal(t, ErrShutdown, manager.Track(types.Lease{LeaseId: "late"}))
+}
@@ -0,0 +1,54 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"sort"
+	"strings"
+)
+
+// ChildKeys returns the sorted keys directly under prefix from the full secret paths, so the secret store providers
+// which only store full paths give ListSecretPaths the same semantics as a Vault KV LIST. Keys of nested paths end
+// with a "/", and paths which are not under prefix are ignored.
+func ChildKeys(prefix string, paths []string) []string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	unique := make(map[string]struct{})
+	for _, fullPath := range paths {
+		fullPath = strings.Trim(fullPath, "/")
+		if !strings.HasPrefix(fullPath, prefix) || fullPath == strings.TrimSuffix(prefix, "/") {
+			continue
+		}
+
+		child := strings.TrimPrefix(fullPath, prefix)
+		if index := strings.Index(child, "/"); index >= 0 {
+			child = child[:index+1]
+		}
+		if child != "" {
+			unique[child] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(unique))
+	for key := range unique {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,50 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChildKeys(t *testing.T) {
+	paths := []string{
+		"/edgex/core-data/redisdb",
+		"/edgex/core-data/mqtt",
+		"/edgex/device-virtual/credentials/camera",
+		"/edgex/device-virtual/credentials/sensor",
+		"/edgex-other/secret",
+		"/edgex",
+	}
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{"Root", "", []string{"edgex", "edgex-other/", "edgex/"}},
+		{"Nested paths", "/edgex/", []string{"core-data/", "device-virtual/"}},
+		{"Secrets", "edgex/core-data", []string{"mqtt", "redisdb"}},
+		{"Deeply nested", "edgex/device-virtual", []string{"credentials/"}},
+		{"No secrets", "/unknown", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ChildKeys(test.prefix, paths))
+		})
+	}
+}
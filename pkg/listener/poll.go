@@ -15,6 +15,7 @@
 package listener
 
 import (
+	"context"
 	"reflect"
 	"sync"
 	"time"
@@ -69,11 +70,11 @@ func NewInMemoryCacheListener(client secrets.SecretClient, updateChan chan map[s
 }
 
 // GetKeys retrieves the secrets via the secret client
-func (c *InMemoryCacheListener) GetKeys() (map[string]string, error) {
+func (c *InMemoryCacheListener) GetKeys(ctx context.Context) (map[string]string, error) {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
 
-	secrets, err := c.secretClient.GetSecrets(c.path, c.keys...)
+	secrets, err := c.secretClient.GetSecrets(ctx, c.path, c.keys...)
 	if err != nil {
 		return nil, err
 	}
@@ -83,11 +84,11 @@ func (c *InMemoryCacheListener) GetKeys() (map[string]string, error) {
 	return c.cache, nil
 }
 
-func (c *InMemoryCacheListener) SetSecrets(secrets map[string]string) error {
+func (c *InMemoryCacheListener) SetSecrets(ctx context.Context, secrets map[string]string) error {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
 
-	if err := c.secretClient.StoreSecrets(c.path, secrets); err != nil {
+	if err := c.secretClient.StoreSecrets(ctx, c.path, secrets); err != nil {
 		return err
 	}
 
@@ -157,7 +158,7 @@ func (c *InMemoryCacheListener) update() {
 			func() {
 				c.cacheMutex.Lock()
 				defer c.cacheMutex.Unlock()
-				secrets, err := c.secretClient.GetSecrets(c.path, c.keys...)
+				secrets, err := c.secretClient.GetSecrets(context.Background(), c.path, c.keys...)
 				if err != nil {
 					c.errorChan <- err
 					errorCount++
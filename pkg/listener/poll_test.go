@@ -16,6 +16,7 @@
 package listener
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -52,7 +53,7 @@ type MockSecretClient struct {
 	secretStore *map[string]string
 }
 
-func (mssm MockSecretClient) GetSecrets(path string, keys ...string) (map[string]string, error) {
+func (mssm MockSecretClient) GetSecrets(_ context.Context, path string, keys ...string) (map[string]string, error) {
 	if path != testPath {
 		return nil, pkg.NewErrSecretsNotFound(keys)
 	}
@@ -76,7 +77,7 @@ func (mssm MockSecretClient) GetSecrets(path string, keys ...string) (map[string
 	return secrets, nil
 }
 
-func (mssm MockSecretClient) StoreSecrets(path string, secrets map[string]string) error {
+func (mssm MockSecretClient) StoreSecrets(_ context.Context, path string, secrets map[string]string) error {
 	if path != testPath {
 		return pkg.NewErrSecretStore(fmt.Sprintf("incorrect path for storing secrets: %s", path))
 	}
@@ -101,10 +102,18 @@ func (mssm MockSecretClient) GetTokenDetails() (*types.TokenMetadata, error) {
 	return nil, nil
 }
 
-func (mssm MockSecretClient) GenerateConsulToken(serviceKey string) (string, error) {
+func (mssm MockSecretClient) GenerateConsulToken(_ context.Context, serviceKey string) (string, error) {
 	panic("GenerateConsulToken not implemented")
 }
 
+func (mssm MockSecretClient) RegisterSecretUpdatedCallback(_ string, _ pkg.SecretUpdatedCallback) {
+	panic("RegisterSecretUpdatedCallback not implemented")
+}
+
+func (mssm MockSecretClient) ListSecretPaths(_ context.Context, subPath string) ([]string, error) {
+	panic("ListSecretPaths not implemented")
+}
+
 func TestGetKeys(t *testing.T) {
 	testClient := newTestMockSecretClient()
 	tests := []struct {
@@ -138,7 +147,7 @@ func TestGetKeys(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			c := NewInMemoryCacheListener(test.client, make(chan map[string]string), make(chan error), []int{0}, test.path, test.keys)
-			actual, err := c.GetKeys()
+			actual, err := c.GetKeys(context.Background())
 
 			if test.expectError && err == nil {
 				t.Error("Expected an error")
@@ -219,7 +228,7 @@ func TestStoreSecrets(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			c := NewInMemoryCacheListener(test.client, make(chan map[string]string), make(chan error), []int{0}, test.path, nil)
-			err := c.SetSecrets(test.secrets)
+			err := c.SetSecrets(context.Background(), test.secrets)
 
 			if test.expectError && err == nil {
 				t.Error("Expected an error")
@@ -241,7 +250,7 @@ func TestStoreSecrets(t *testing.T) {
 			}
 
 			// then retrieve to see if secrets got stored
-			actual, _ := c.GetKeys()
+			actual, _ := c.GetKeys(context.Background())
 			if !reflect.DeepEqual(test.expectedResult, actual) {
 				t.Errorf("Expected result does not match the observed.\nExpected: %v\nObserved: %v\n", test.expectedResult, actual)
 				return
@@ -254,7 +263,7 @@ func TestStoreSecrets(t *testing.T) {
 func TestGetKeysError(t *testing.T) {
 	testClient := newTestMockSecretClient()
 	c := NewInMemoryCacheListener(testClient, make(chan map[string]string), make(chan error, 10), []int{0}, testPath, []string{"doesNotExist"})
-	_, err := c.GetKeys()
+	_, err := c.GetKeys(context.Background())
 	if err == nil {
 		t.Errorf("Expected an error")
 		return
@@ -339,7 +348,7 @@ func TestNoUpdate(t *testing.T) {
 	errChan := make(chan error)
 	updateChan := make(chan map[string]string)
 	c := NewInMemoryCacheListener(testClient, updateChan, errChan, []int{0}, testPath, []string{"one", "two"})
-	_, err := c.GetKeys()
+	_, err := c.GetKeys(context.Background())
 	if err != nil {
 		t.Errorf("Unexpected error occurred: %s", err.Error())
 		return
@@ -0,0 +1,172 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package listener
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets"
+)
+
+// SecretChangedCallback is invoked by the Watcher with the sub-path and the metadata of a secret whose current
+// version has changed.
+type SecretChangedCallback func(subPath string, metadata types.SecretMetadata)
+
+// Watcher polls the KV v2 metadata of the watched secrets and invokes their callbacks when the current version
+// changes, i.e. when rotated credentials have been stored, so services can reload them without restarting.
+type Watcher struct {
+	// client reads the metadata of the watched secrets.
+	client secrets.VersionedSecretClient
+	// interval is the time between polls of the watched secrets.
+	interval time.Duration
+	// errorChan communicates errors from the background process to the caller, errors are dropped when it is full.
+	errorChan chan error
+	// stopChan signals when the background process should shut down.
+	stopChan chan struct{}
+	// callbacks holds the callbacks of each watched sub-path.
+	callbacks map[string][]SecretChangedCallback
+	// versions holds the last known current version of each watched sub-path, set by its first successful poll.
+	versions map[string]int
+	// isRunning holds the state of the watcher.
+	isRunning bool
+	// timerFunc abstracts the logic used to create a timer, which is most useful for testing.
+	timerFunc func(duration time.Duration) *time.Timer
+	// mutex protects the state of the watcher from race conditions.
+	mutex sync.Mutex
+}
+
+// NewWatcher creates a new Watcher polling the watched secrets with client every interval.
+// errorChan may be nil when the caller isn't interested in polling errors.
+func NewWatcher(client secrets.VersionedSecretClient, interval time.Duration, errorChan chan error) *Watcher {
+	return &Watcher{
+		client:    client,
+		interval:  interval,
+		errorChan: errorChan,
+		stopChan:  make(chan struct{}, 1),
+		callbacks: make(map[string][]SecretChangedCallback),
+		versions:  make(map[string]int),
+		timerFunc: time.NewTimer,
+	}
+}
+
+// Watch registers callback to be invoked when the current version of the secret at subPath changes.
+// The version present when the sub-path is first polled is not reported as a change.
+func (w *Watcher) Watch(subPath string, callback SecretChangedCallback) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.callbacks[subPath] = append(w.callbacks[subPath], callback)
+}
+
+// Unwatch removes all the callbacks of subPath.
+func (w *Watcher) Unwatch(subPath string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.callbacks, subPath)
+	delete(w.versions, subPath)
+}
+
+// Start invokes the background process which polls the watched secrets.
+func (w *Watcher) Start() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.isRunning {
+		return ErrInvalidListenerState{message: "This watcher has already started"}
+	}
+
+	w.isRunning = true
+	go w.watch()
+
+	return nil
+}
+
+// Stop terminates the background process which polls the watched secrets.
+func (w *Watcher) Stop() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.isRunning {
+		return ErrInvalidListenerState{message: "This watcher has not been started"}
+	}
+
+	w.isRunning = false
+	w.stopChan <- struct{}{}
+
+	return nil
+}
+
+// watch polls the watched secrets until stopped. This function is intended to be executed in a go-routine.
+func (w *Watcher) watch() {
+	for {
+		timer := w.timerFunc(w.interval)
+		select {
+		case <-timer.C:
+			w.poll()
+		case <-w.stopChan:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// poll reads the metadata of every watched secret, invoking the callbacks of those whose version has changed.
+// The callbacks are invoked without holding the mutex so they may call Watch or Unwatch.
+func (w *Watcher) poll() {
+	w.mutex.Lock()
+	subPaths := make([]string, 0, len(w.callbacks))
+	for subPath := range w.callbacks {
+		subPaths = append(subPaths, subPath)
+	}
+	w.mutex.Unlock()
+
+	for _, subPath := range subPaths {
+		metadata, err := w.client.GetSecretMetadata(context.Background(), subPath)
+		if err != nil {
+			w.reportError(err)
+			continue
+		}
+
+		w.mutex.Lock()
+		previous, known := w.versions[subPath]
+		callbacks := w.callbacks[subPath]
+		if _, watched := w.callbacks[subPath]; watched {
+			w.versions[subPath] = metadata.CurrentVersion
+		}
+		w.mutex.Unlock()
+
+		if !known || previous == metadata.CurrentVersion {
+			continue
+		}
+		for _, callback := range callbacks {
+			callback(subPath, metadata)
+		}
+	}
+}
+
+func (w *Watcher) reportError(err error) {
+	if w.errorChan == nil {
+		return
+	}
+
+	select {
+	case w.errorChan <- err:
+	default:
+	}
+}
@@ -0,0 +1,136 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package listener
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// mockVersionedClient reports the current version of each secret, or err when set
+type mockVersionedClient struct {
+	mutex    sync.Mutex
+	versions map[string]int
+	err      error
+}
+
+func (m *mockVersionedClient) setVersion(subPath string, version int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.versions[subPath] = version
+}
+
+func (m *mockVersionedClient) GetSecretMetadata(_ context.Context, subPath string) (types.SecretMetadata, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.err != nil {
+		return types.SecretMetadata{}, m.err
+	}
+	return types.SecretMetadata{CurrentVersion: m.versions[subPath]}, nil
+}
+
+func (m *mockVersionedClient) GetSecretVersion(_ context.Context, _ string, _ int) (types.SecretVersion, error) {
+	panic("GetSecretVersion not implemented")
+}
+
+func (m *mockVersionedClient) StoreSecretsCAS(_ context.Context, _ string, _ map[string]string, _ int) (types.SecretVersionMetadata, error) {
+	panic("StoreSecretsCAS not implemented")
+}
+
+func (m *mockVersionedClient) PatchSecrets(_ context.Context, _ string, _ map[string]string) (types.SecretVersionMetadata, error) {
+	panic("PatchSecrets not implemented")
+}
+
+func (m *mockVersionedClient) StoreSecretsMulti(_ context.Context, _ map[string]map[string]string) error {
+	panic("StoreSecretsMulti not implemented")
+}
+
+func (m *mockVersionedClient) UpdateSecretMetadata(_ context.Context, _ string, _ types.SecretMetadataUpdate) error {
+	panic("UpdateSecretMetadata not implemented")
+}
+
+func TestWatcher(t *testing.T) {
+	client := &mockVersionedClient{versions: map[string]int{"/redisdb": 1, "/mqtt": 3}}
+	watcher := NewWatcher(client, 10*time.Millisecond, nil)
+
+	changes := make(chan types.SecretMetadata, 10)
+	watcher.Watch("/redisdb", func(subPath string, metadata types.SecretMetadata) {
+		if subPath != "/redisdb" {
+			t.Errorf("unexpected sub-path '%s'", subPath)
+		}
+		changes <- metadata
+	})
+	watcher.Watch("/mqtt", func(subPath string, _ types.SecretMetadata) {
+		t.Errorf("unexpected change of '%s'", subPath)
+	})
+
+	if err := watcher.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.Start(); err == nil {
+		t.Error("expected an error starting the watcher twice")
+	}
+
+	// let the watcher record the initial versions, which are not changes
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case metadata := <-changes:
+		t.Fatalf("unexpected change to version %d", metadata.CurrentVersion)
+	default:
+	}
+
+	client.setVersion("/redisdb", 2)
+	select {
+	case metadata := <-changes:
+		if metadata.CurrentVersion != 2 {
+			t.Errorf("expected version 2, got %d", metadata.CurrentVersion)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the change of '/redisdb'")
+	}
+
+	if err := watcher.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := watcher.Stop(); err == nil {
+		t.Error("expected an error stopping the watcher twice")
+	}
+}
+
+func TestWatcherErrors(t *testing.T) {
+	client := &mockVersionedClient{versions: map[string]int{}, err: errors.New("sealed")}
+	errorChan := make(chan error, 1)
+	watcher := NewWatcher(client, 10*time.Millisecond, errorChan)
+	watcher.Watch("/redisdb", func(string, types.SecretMetadata) {})
+
+	if err := watcher.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	select {
+	case err := <-errorChan:
+		if err != client.err {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the polling error")
+	}
+}
@@ -0,0 +1,231 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package metrics collects the pkg.Metrics of the secret store clients in memory and serves them in the Prometheus
+// text exposition format, without depending on the Prometheus client library.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+const (
+	// RequestsTotal counts the requests by operation and status
+	RequestsTotal = "secretstore_requests_total"
+	// RequestDuration is the histogram of the request latencies by operation, in seconds
+	RequestDuration = "secretstore_request_duration_seconds"
+	// TokenRenewalsTotal counts the renewals of the client tokens by result, "success" or "failure"
+	TokenRenewalsTotal = "secretstore_token_renewals_total"
+	// CacheLookupsTotal counts the cache lookups by result, "hit" or "miss"
+	CacheLookupsTotal = "secretstore_cache_lookups_total"
+
+	exposition = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// DefaultBuckets are the upper bounds of the latency histogram buckets, in seconds, used when none are given
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var _ pkg.Metrics = (*Collector)(nil)
+
+// Histogram is the distribution of the latencies of an operation. Counts holds the number of observations at or
+// below each bucket bound, in the order of Buckets.
+type Histogram struct {
+	Buckets []float64
+	Counts  []uint64
+	Count   uint64
+	Sum     float64
+}
+
+// Snapshot is a copy of the collected metrics
+type Snapshot struct {
+	// Requests counts the requests by operation, then by status code
+	Requests      map[string]map[int]uint64
+	Latencies     map[string]Histogram
+	TokenRenewals map[bool]uint64
+	CacheLookups  map[bool]uint64
+}
+
+// Collector implements pkg.Metrics, collecting the metrics in memory. It is an http.Handler serving them to
+// Prometheus, and its Snapshot is for adapting them to other metrics systems.
+type Collector struct {
+	buckets       []float64
+	mutex         sync.Mutex
+	requests      map[string]map[int]uint64
+	latencies     map[string]*Histogram
+	tokenRenewals map[bool]uint64
+	cacheLookups  map[bool]uint64
+}
+
+// NewCollector creates a Collector with the latency histogram bucket bounds, in seconds, or DefaultBuckets when
+// none are given
+func NewCollector(buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	return &Collector{
+		buckets:       sorted,
+		requests:      make(map[string]map[int]uint64),
+		latencies:     make(map[string]*Histogram),
+		tokenRenewals: make(map[bool]uint64),
+		cacheLookups:  make(map[bool]uint64),
+	}
+}
+
+// RequestCompleted counts the request and observes its latency
+func (c *Collector) RequestCompleted(operation string, status int, latency time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.requests[operation] == nil {
+		c.requests[operation] = make(map[int]uint64)
+	}
+	c.requests[operation][status]++
+
+	histogram, ok := c.latencies[operation]
+	if !ok {
+		histogram = &Histogram{Buckets: c.buckets, Counts: make([]uint64, len(c.buckets))}
+		c.latencies[operation] = histogram
+	}
+	seconds := latency.Seconds()
+	for index, bound := range histogram.Buckets {
+		if seconds <= bound {
+			histogram.Counts[index]++
+		}
+	}
+	histogram.Count++
+	histogram.Sum += seconds
+}
+
+// TokenRenewed counts the token renewal
+func (c *Collector) TokenRenewed(success bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.tokenRenewals[success]++
+}
+
+// CacheLookup counts the cache lookup
+func (c *Collector) CacheLookup(hit bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.cacheLookups[hit]++
+}
+
+// Snapshot returns a copy of the metrics collected so far
+func (c *Collector) Snapshot() Snapshot {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	snapshot := Snapshot{
+		Requests:      make(map[string]map[int]uint64, len(c.requests)),
+		Latencies:     make(map[string]Histogram, len(c.latencies)),
+		TokenRenewals: make(map[bool]uint64, len(c.tokenRenewals)),
+		CacheLookups:  make(map[bool]uint64, len(c.cacheLookups)),
+	}
+	for operation, statuses := range c.requests {
+		snapshot.Requests[operation] = make(map[int]uint64, len(statuses))
+		for status, count := range statuses {
+			snapshot.Requests[operation][status] = count
+		}
+	}
+	for operation, histogram := range c.latencies {
+		copied := *histogram
+		copied.Counts = append([]uint64(nil), histogram.Counts...)
+		snapshot.Latencies[operation] = copied
+	}
+	for success, count := range c.tokenRenewals {
+		snapshot.TokenRenewals[success] = count
+	}
+	for hit, count := range c.cacheLookups {
+		snapshot.CacheLookups[hit] = count
+	}
+	return snapshot
+}
+
+// ServeHTTP serves the metrics in the Prometheus text exposition format
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", exposition)
+	_ = c.Write(w)
+}
+
+// Write writes the metrics to w in the Prometheus text exposition format
+func (c *Collector) Write(w io.Writer) error {
+	snapshot := c.Snapshot()
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "# HELP %s Requests to the secret store by operation and status code.\n", RequestsTotal)
+	fmt.Fprintf(&out, "# TYPE %s counter\n", RequestsTotal)
+	operations := make([]string, 0, len(snapshot.Requests))
+	for operation := range snapshot.Requests {
+		operations = append(operations, operation)
+	}
+	// every request observes its latency too, so the operations of both metrics are the same
+	sort.Strings(operations)
+
+	for _, operation := range operations {
+		statuses := make([]int, 0, len(snapshot.Requests[operation]))
+		for status := range snapshot.Requests[operation] {
+			statuses = append(statuses, status)
+		}
+		sort.Ints(statuses)
+		for _, status := range statuses {
+			fmt.Fprintf(&out, "%s{operation=%s,status=\"%d\"} %d\n", RequestsTotal, quote(operation), status,
+				snapshot.Requests[operation][status])
+		}
+	}
+
+	fmt.Fprintf(&out, "# HELP %s Latency of the requests to the secret store by operation.\n", RequestDuration)
+	fmt.Fprintf(&out, "# TYPE %s histogram\n", RequestDuration)
+	for _, operation := range operations {
+		histogram := snapshot.Latencies[operation]
+		for index, bound := range histogram.Buckets {
+			fmt.Fprintf(&out, "%s_bucket{operation=%s,le=\"%s\"} %d\n", RequestDuration, quote(operation),
+				strconv.FormatFloat(bound, 'g', -1, 64), histogram.Counts[index])
+		}
+		fmt.Fprintf(&out, "%s_bucket{operation=%s,le=\"+Inf\"} %d\n", RequestDuration, quote(operation), histogram.Count)
+		fmt.Fprintf(&out, "%s_sum{operation=%s} %s\n", RequestDuration, quote(operation),
+			strconv.FormatFloat(histogram.Sum, 'g', -1, 64))
+		fmt.Fprintf(&out, "%s_count{operation=%s} %d\n", RequestDuration, quote(operation), histogram.Count)
+	}
+
+	writeResults(&out, TokenRenewalsTotal, "Renewals of the secret store tokens by result.", snapshot.TokenRenewals,
+		"success", "failure")
+	writeResults(&out, CacheLookupsTotal, "Lookups of cached secrets by result.", snapshot.CacheLookups, "hit", "miss")
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+func writeResults(out *strings.Builder, name string, help string, counts map[bool]uint64, success string, failure string) {
+	fmt.Fprintf(out, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(out, "# TYPE %s counter\n", name)
+	fmt.Fprintf(out, "%s{result=\"%s\"} %d\n", name, success, counts[true])
+	fmt.Fprintf(out, "%s{result=\"%s\"} %d\n", name, failure, counts[false])
+}
+
+// quote quotes a label value, escaping the backslashes, quotes and line feeds as the exposition format requires
+func quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
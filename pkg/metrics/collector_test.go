@@ -0,0 +1,88 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector(0.1, 0.01)
+	collector.RequestCompleted("GET secret", http.StatusOK, 5*time.Millisecond)
+	collector.RequestCompleted("GET secret", http.StatusOK, 50*time.Millisecond)
+	collector.RequestCompleted("GET secret", http.StatusNotFound, time.Second)
+	collector.RequestCompleted("POST auth/token", 0, time.Millisecond)
+	collector.TokenRenewed(true)
+	collector.TokenRenewed(false)
+	collector.TokenRenewed(true)
+	collector.CacheLookup(false)
+
+	snapshot := collector.Snapshot()
+	assert.Equal(t, map[string]map[int]uint64{
+		"GET secret":      {http.StatusOK: 2, http.StatusNotFound: 1},
+		"POST auth/token": {0: 1},
+	}, snapshot.Requests)
+	assert.Equal(t, map[bool]uint64{true: 2, false: 1}, snapshot.TokenRenewals)
+	assert.Equal(t, map[bool]uint64{false: 1}, snapshot.CacheLookups)
+
+	histogram := snapshot.Latencies["GET secret"]
+	assert.Equal(t, []float64{0.01, 0.1}, histogram.Buckets)
+	assert.Equal(t, []uint64{1, 2}, histogram.Counts)
+	assert.Equal(t, uint64(3), histogram.Count)
+	assert.InDelta(t, 1.055, histogram.Sum, 1e-9)
+
+	// the snapshot is a copy
+	snapshot.Requests["GET secret"][http.StatusOK] = 10
+	histogram.Counts[0] = 10
+	assert.Equal(t, uint64(2), collector.Snapshot().Requests["GET secret"][http.StatusOK])
+	assert.Equal(t, uint64(1), collector.Snapshot().Latencies["GET secret"].Counts[0])
+}
+
+func TestCollectorServeHTTP(t *testing.T) {
+	collector := NewCollector(0.1)
+	collector.RequestCompleted(`GET "quoted"`, http.StatusOK, 5*time.Millisecond)
+	collector.CacheLookup(true)
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, exposition, recorder.Header().Get("Content-Type"))
+
+	expected := `# HELP secretstore_requests_total Requests to the secret store by operation and status code.
+# TYPE secretstore_requests_total counter
+secretstore_requests_total{operation="GET \"quoted\"",status="200"} 1
+# HELP secretstore_request_duration_seconds Latency of the requests to the secret store by operation.
+# TYPE secretstore_request_duration_seconds histogram
+secretstore_request_duration_seconds_bucket{operation="GET \"quoted\"",le="0.1"} 1
+secretstore_request_duration_seconds_bucket{operation="GET \"quoted\"",le="+Inf"} 1
+secretstore_request_duration_seconds_sum{operation="GET \"quoted\""} 0.005
+secretstore_request_duration_seconds_count{operation="GET \"quoted\""} 1
+# HELP secretstore_token_renewals_total Renewals of the secret store tokens by result.
+# TYPE secretstore_token_renewals_total counter
+secretstore_token_renewals_total{result="success"} 0
+secretstore_token_renewals_total{result="failure"} 0
+# HELP secretstore_cache_lookups_total Lookups of cached secrets by result.
+# TYPE secretstore_cache_lookups_total counter
+secretstore_cache_lookups_total{result="hit"} 1
+secretstore_cache_lookups_total{result="miss"} 0
+`
+	assert.Equal(t, expected, recorder.Body.String())
+}
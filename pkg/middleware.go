@@ -0,0 +1,38 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import "net/http"
+
+// CallerFunc adapts a function to a Caller
+type CallerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req)
+func (f CallerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Caller issuing the requests of a client, i.e. to log, audit or add headers to the requests
+// or to inject failures when testing. It is called for each attempt of a request which is retried.
+type Middleware func(next Caller) Caller
+
+// Chain wraps caller with the middleware, the first being the outermost, so it sees the requests first and the
+// responses last
+func Chain(caller Caller, middleware ...Middleware) Caller {
+	for index := len(middleware) - 1; index >= 0; index-- {
+		caller = middleware[index](caller)
+	}
+	return caller
+}
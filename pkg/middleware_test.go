@@ -0,0 +1,55 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Caller) Caller {
+			return CallerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next.Do(req)
+				calls = append(calls, name+" response")
+				return resp, err
+			})
+		}
+	}
+	caller := CallerFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "caller")
+		recorder := httptest.NewRecorder()
+		recorder.WriteHeader(http.StatusNoContent)
+		return recorder.Result(), nil
+	})
+
+	resp, err := Chain(caller, record("outer"), record("inner")).Do(httptest.NewRequest(http.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, []string{"outer request", "inner request", "caller", "inner response", "outer response"}, calls)
+
+	// without middleware the caller is called as is
+	calls = nil
+	_, err = Chain(caller).Do(httptest.NewRequest(http.MethodGet, "/", nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"caller"}, calls)
+}
@@ -0,0 +1,208 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package policy builds secret store ACL policies, rendering them as the HCL documents installed by
+// SecretStoreClient.InstallPolicy.
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The capabilities which can be granted on a path
+const (
+	Create = "create"
+	Read   = "read"
+	Update = "update"
+	Patch  = "patch"
+	Delete = "delete"
+	List   = "list"
+	Sudo   = "sudo"
+	Deny   = "deny"
+)
+
+var validCapabilities = map[string]bool{
+	Create: true, Read: true, Update: true, Patch: true, Delete: true, List: true, Sudo: true, Deny: true,
+}
+
+// PathRule grants capabilities on a path, i.e. "secret/data/edgex/core-data/*". The parameter constraints only
+// apply to requests writing data, so they require the create, update or patch capability.
+type PathRule struct {
+	Path         string
+	Capabilities []string
+	// AllowedParameters maps each parameter which may be set to its allowed values, no values allow any value.
+	// The "*" key allows any parameter.
+	AllowedParameters map[string][]string
+	// DeniedParameters maps each parameter which may not be set to its denied values, no values deny any value
+	DeniedParameters   map[string][]string
+	RequiredParameters []string
+	// MinWrappingTTL and MaxWrappingTTL, when set, require responses to be wrapped with a TTL in their range
+	MinWrappingTTL time.Duration
+	MaxWrappingTTL time.Duration
+}
+
+// PolicyBuilder collects the path rules of a policy, in the order they are added
+type PolicyBuilder struct {
+	rules []PathRule
+}
+
+// NewPolicyBuilder creates an empty PolicyBuilder
+func NewPolicyBuilder() *PolicyBuilder {
+	return &PolicyBuilder{}
+}
+
+// Path adds a rule granting the capabilities on the path
+func (b *PolicyBuilder) Path(path string, capabilities ...string) *PolicyBuilder {
+	return b.AddRule(PathRule{Path: path, Capabilities: capabilities})
+}
+
+// AddRule adds the rule, which may constrain the parameters
+func (b *PolicyBuilder) AddRule(rule PathRule) *PolicyBuilder {
+	b.rules = append(b.rules, rule)
+	return b
+}
+
+// Validate checks every rule, returning an error describing the first invalid one
+func (b *PolicyBuilder) Validate() error {
+	if len(b.rules) == 0 {
+		return fmt.Errorf("policy has no path rules")
+	}
+
+	paths := make(map[string]bool)
+	for _, rule := range b.rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("invalid rule for path '%s': %w", rule.Path, err)
+		}
+		if paths[rule.Path] {
+			return fmt.Errorf("path '%s' has more than one rule", rule.Path)
+		}
+		paths[rule.Path] = true
+	}
+
+	return nil
+}
+
+// Build validates the rules and renders the policy document
+func (b *PolicyBuilder) Build() (string, error) {
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+
+	var document strings.Builder
+	for index, rule := range b.rules {
+		if index > 0 {
+			document.WriteString("\n")
+		}
+		rule.render(&document)
+	}
+
+	return document.String(), nil
+}
+
+func (rule PathRule) validate() error {
+	path := rule.Path
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("path cannot be empty")
+	}
+	if strings.HasPrefix(path, "/") {
+		return fmt.Errorf("path cannot start with '/', paths are relative to the API version, i.e. 'secret/data/...'")
+	}
+	if strings.ContainsAny(path, "\"\n") {
+		return fmt.Errorf("path cannot contain quotes or new lines")
+	}
+	if index := strings.Index(path, "*"); index >= 0 && index != len(path)-1 {
+		return fmt.Errorf("the '*' glob is only allowed at the end of the path, use '+' to match a single segment")
+	}
+
+	if len(rule.Capabilities) == 0 {
+		return fmt.Errorf("at least one capability is required")
+	}
+	writes := false
+	seen := make(map[string]bool)
+	for _, capability := range rule.Capabilities {
+		if !validCapabilities[capability] {
+			return fmt.Errorf("unknown capability '%s'", capability)
+		}
+		if seen[capability] {
+			return fmt.Errorf("capability '%s' is listed more than once", capability)
+		}
+		seen[capability] = true
+		writes = writes || capability == Create || capability == Update || capability == Patch
+	}
+	if seen[Deny] && len(rule.Capabilities) > 1 {
+		return fmt.Errorf("the deny capability overrides all others and cannot be combined with them")
+	}
+
+	constrained := len(rule.AllowedParameters) > 0 || len(rule.DeniedParameters) > 0 || len(rule.RequiredParameters) > 0
+	if constrained && !writes {
+		return fmt.Errorf("parameter constraints require the create, update or patch capability")
+	}
+
+	if rule.MinWrappingTTL < 0 || rule.MaxWrappingTTL < 0 {
+		return fmt.Errorf("wrapping TTLs cannot be negative")
+	}
+	if rule.MaxWrappingTTL > 0 && rule.MinWrappingTTL > rule.MaxWrappingTTL {
+		return fmt.Errorf("min wrapping TTL %v exceeds max wrapping TTL %v", rule.MinWrappingTTL, rule.MaxWrappingTTL)
+	}
+
+	return nil
+}
+
+func (rule PathRule) render(document *strings.Builder) {
+	fmt.Fprintf(document, "path %s {\n", strconv.Quote(rule.Path))
+	fmt.Fprintf(document, "  capabilities = %s\n", quoteList(rule.Capabilities))
+	renderParameters(document, "allowed_parameters", rule.AllowedParameters)
+	renderParameters(document, "denied_parameters", rule.DeniedParameters)
+	if len(rule.RequiredParameters) > 0 {
+		fmt.Fprintf(document, "  required_parameters = %s\n", quoteList(rule.RequiredParameters))
+	}
+	if rule.MinWrappingTTL > 0 {
+		fmt.Fprintf(document, "  min_wrapping_ttl = %s\n", strconv.Quote(rule.MinWrappingTTL.String()))
+	}
+	if rule.MaxWrappingTTL > 0 {
+		fmt.Fprintf(document, "  max_wrapping_ttl = %s\n", strconv.Quote(rule.MaxWrappingTTL.String()))
+	}
+	document.WriteString("}\n")
+}
+
+// renderParameters renders the parameters sorted by name, so the same rules always render the same document
+func renderParameters(document *strings.Builder, name string, parameters map[string][]string) {
+	if len(parameters) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(parameters))
+	for parameter := range parameters {
+		names = append(names, parameter)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(document, "  %s = {\n", name)
+	for _, parameter := range names {
+		fmt.Fprintf(document, "    %s = %s\n", strconv.Quote(parameter), quoteList(parameters[parameter]))
+	}
+	document.WriteString("  }\n")
+}
+
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for index, value := range values {
+		quoted[index] = strconv.Quote(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
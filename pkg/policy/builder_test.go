@@ -0,0 +1,123 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	document, err := NewPolicyBuilder().
+		Path("secret/data/edgex/core-data/*", Read, List).
+		AddRule(PathRule{
+			Path:               "secret/data/edgex/core-data/redisdb",
+			Capabilities:       []string{Create, Update},
+			AllowedParameters:  map[string][]string{"username": {"redis5", "redis6"}, "password": {}},
+			DeniedParameters:   map[string][]string{"admin": {}},
+			RequiredParameters: []string{"password"},
+		}).
+		AddRule(PathRule{
+			Path:           "sys/wrapping/wrap",
+			Capabilities:   []string{Update},
+			MinWrappingTTL: time.Second,
+			MaxWrappingTTL: 90 * time.Second,
+		}).
+		Path("auth/token/create/+", Deny).
+		Build()
+	require.NoError(t, err)
+
+	expected := `path "secret/data/edgex/core-data/*" {
+  capabilities = ["read", "list"]
+}
+
+path "secret/data/edgex/core-data/redisdb" {
+  capabilities = ["create", "update"]
+  allowed_parameters = {
+    "password" = []
+    "username" = ["redis5", "redis6"]
+  }
+  denied_parameters = {
+    "admin" = []
+  }
+  required_parameters = ["password"]
+}
+
+path "sys/wrapping/wrap" {
+  capabilities = ["update"]
+  min_wrapping_ttl = "1s"
+  max_wrapping_ttl = "1m30s"
+}
+
+path "auth/token/create/+" {
+  capabilities = ["deny"]
+}
+`
+	assert.Equal(t, expected, document)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		rule          PathRule
+		expectedError string
+	}{
+		{"Valid", PathRule{Path: "secret/+/edgex/*", Capabilities: []string{Read}}, ""},
+		{"Empty path", PathRule{Path: " ", Capabilities: []string{Read}}, "path cannot be empty"},
+		{"Leading slash", PathRule{Path: "/v1/secret", Capabilities: []string{Read}}, "cannot start with '/'"},
+		{"Quoted path", PathRule{Path: `secret/"edgex"`, Capabilities: []string{Read}}, "quotes"},
+		{"Glob in the middle", PathRule{Path: "secret/*/edgex", Capabilities: []string{Read}}, "only allowed at the end"},
+		{"No capabilities", PathRule{Path: "secret/edgex"}, "at least one capability"},
+		{"Unknown capability", PathRule{Path: "secret/edgex", Capabilities: []string{"write"}}, "unknown capability 'write'"},
+		{"Duplicate capability", PathRule{Path: "secret/edgex", Capabilities: []string{Read, Read}}, "more than once"},
+		{"Deny combined", PathRule{Path: "secret/edgex", Capabilities: []string{Deny, Read}}, "cannot be combined"},
+		{"Constraints without writes", PathRule{
+			Path:               "secret/edgex",
+			Capabilities:       []string{Read},
+			RequiredParameters: []string{"password"},
+		}, "require the create, update or patch capability"},
+		{"Min wrapping TTL above max", PathRule{
+			Path:           "secret/edgex",
+			Capabilities:   []string{Read},
+			MinWrappingTTL: time.Minute,
+			MaxWrappingTTL: time.Second,
+		}, "exceeds max wrapping TTL"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewPolicyBuilder().AddRule(test.rule).Validate()
+			if test.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedError)
+		})
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	_, err := NewPolicyBuilder().Build()
+	require.Error(t, err)
+
+	_, err = NewPolicyBuilder().Path("secret/edgex", Read).Path("secret/edgex", List).Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "more than one rule")
+}
@@ -0,0 +1,296 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package aws provides a SecretClient backed by AWS Secrets Manager.
+// Secrets are stored as a JSON object of "key": "value" pairs in the secret's SecretString.
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	serviceName = "secretsmanager"
+	contentType = "application/x-amz-json-1.1"
+	targetFmt   = "secretsmanager.%s"
+
+	getSecretValueAction = "GetSecretValue"
+	putSecretValueAction = "PutSecretValue"
+	createSecretAction   = "CreateSecret"
+	listSecretsAction    = "ListSecrets"
+
+	resourceNotFound = "ResourceNotFoundException"
+)
+
+// Client is a SecretClient which stores and retrieves secrets from AWS Secrets Manager
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	creds      Credentials
+	region     string
+	endpoint   string
+	// now abstracts the clock used for request signing, which is most useful for testing
+	now func() time.Time
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs an AWS Secrets Manager Client. If requester is nil an HTTP client with the Transport
+// settings of the configuration is used.
+// Credentials and region which are not set in the configuration are read from the standard AWS environment variables.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	region := config.AWS.Region
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	if region == "" {
+		return nil, pkg.NewErrSecretStore("AWS region is required in config or AWS_REGION environment variable")
+	}
+
+	creds := Credentials{
+		AccessKeyId:     config.AWS.AccessKeyId,
+		SecretAccessKey: config.AWS.SecretAccessKey,
+		SessionToken:    config.AWS.SessionToken,
+	}
+	if creds.AccessKeyId == "" {
+		creds.AccessKeyId = os.Getenv("AWS_ACCESS_KEY_ID")
+		creds.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		creds.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if creds.AccessKeyId == "" || creds.SecretAccessKey == "" {
+		return nil, pkg.NewErrSecretStore("AWS credentials are required in config or environment variables")
+	}
+
+	// Host is only needed to override the regional endpoint, i.e. for VPC endpoints or local testing
+	endpoint := fmt.Sprintf("https://%s.%s.amazonaws.com/", serviceName, region)
+	if config.Host != "" {
+		var err error
+		if endpoint, err = config.BuildURL("/"); err != nil {
+			return nil, err
+		}
+	}
+
+	if requester == nil {
+		httpClient, err := pkg.NewHTTPClient(config.Transport, nil)
+		if err != nil {
+			return nil, err
+		}
+		requester = httpClient
+	}
+
+	return &Client{
+		Config:     config,
+		HttpCaller: requester,
+		lc:         lc,
+		creds:      creds,
+		region:     region,
+		endpoint:   endpoint,
+		now:        time.Now,
+	}, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	secretId := c.secretId(subPath)
+
+	var response struct {
+		SecretString string `json:"SecretString"`
+	}
+	found, err := c.call(ctx, getSecretValueAction, map[string]string{"SecretId": secretId}, &response)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+
+	data := make(map[string]string)
+	if err := json.Unmarshal([]byte(response.SecretString), &data); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("secret '%s' is not a JSON object of string values: %s", secretId, err.Error()))
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path, creating the AWS secret if it does not yet exist.
+// The stored value replaces any existing secret value at the sub-path.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	payload, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	secretId := c.secretId(subPath)
+	found, err := c.call(ctx, putSecretValueAction, map[string]string{
+		"SecretId":     secretId,
+		"SecretString": string(payload),
+	}, nil)
+	if err != nil || found {
+		return err
+	}
+
+	c.lc.Debugf("AWS secret '%s' does not exist, creating it", secretId)
+	found, err = c.call(ctx, createSecretAction, map[string]string{
+		"Name":         secretId,
+		"SecretString": string(payload),
+	}, nil)
+	if err == nil && !found {
+		err = pkg.NewErrSecretStore(fmt.Sprintf("unable to create AWS secret '%s'", secretId))
+	}
+	return err
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path. AWS secret names are filtered by the
+// sub-path prefix, following the NextToken of each page until all the names are listed.
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	prefix := strings.Trim(path.Join(c.Config.Path, subPath), "/")
+
+	type filter struct {
+		Key    string   `json:"Key"`
+		Values []string `json:"Values"`
+	}
+	input := struct {
+		Filters   []filter `json:"Filters,omitempty"`
+		NextToken string   `json:"NextToken,omitempty"`
+	}{}
+	if prefix != "" {
+		input.Filters = []filter{{Key: "name", Values: []string{prefix + "/"}}}
+	}
+
+	var names []string
+	for {
+		var response struct {
+			SecretList []struct {
+				Name string `json:"Name"`
+			} `json:"SecretList"`
+			NextToken string `json:"NextToken"`
+		}
+		if _, err := c.call(ctx, listSecretsAction, input, &response); err != nil {
+			return nil, err
+		}
+
+		for _, secret := range response.SecretList {
+			names = append(names, secret.Name)
+		}
+
+		if response.NextToken == "" {
+			break
+		}
+		input.NextToken = response.NextToken
+	}
+
+	return pkg.ChildKeys(prefix, names), nil
+}
+
+// GenerateConsulToken is not supported by AWS Secrets Manager
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the AWS Secrets Manager provider")
+}
+
+// secretId maps a sub-path to the name or ARN of the AWS secret
+func (c *Client) secretId(subPath string) string {
+	if id, ok := c.Config.AWS.SecretIds[subPath]; ok {
+		return id
+	}
+	return strings.Trim(path.Join(c.Config.Path, subPath), "/")
+}
+
+// call invokes the Secrets Manager action. The returned bool is false when AWS reports the secret does not exist.
+func (c *Client) call(ctx context.Context, action string, input interface{}, output interface{}) (bool, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Target", fmt.Sprintf(targetFmt, action))
+	SignRequest(req, body, c.creds, c.region, serviceName, c.now())
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make %s request to AWS Secrets Manager: %s", action, err.Error())
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var awsErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(respBody, &awsErr)
+		// __type may be prefixed with the namespace, i.e. "com.amazonaws...#ResourceNotFoundException"
+		if strings.HasSuffix(awsErr.Type, resourceNotFound) {
+			return false, nil
+		}
+		return false, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from AWS Secrets Manager for %s: %s %s",
+			resp.StatusCode, action, awsErr.Type, awsErr.Message), resp.StatusCode, []string{awsErr.Message})
+	}
+
+	if output != nil {
+		if err := json.Unmarshal(respBody, output); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
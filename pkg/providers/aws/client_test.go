@@ -0,0 +1,286 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// mockSecretsManager is a stub AWS Secrets Manager keeping the secret strings in memory
+type mockSecretsManager struct {
+	mutex   sync.Mutex
+	secrets map[string]string
+}
+
+func (m *mockSecretsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), signingAlgorithm+" Credential=test-key/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	var input map[string]string
+	_ = json.Unmarshal(body, &input)
+
+	notFound := func() {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","Message":"not found"}`))
+	}
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "secretsmanager.GetSecretValue":
+		value, ok := m.secrets[input["SecretId"]]
+		if !ok {
+			notFound()
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": value})
+	case "secretsmanager.PutSecretValue":
+		if _, ok := m.secrets[input["SecretId"]]; !ok {
+			notFound()
+			return
+		}
+		m.secrets[input["SecretId"]] = input["SecretString"]
+		_, _ = w.Write([]byte(`{}`))
+	case "secretsmanager.ListSecrets":
+		m.listSecrets(w, body)
+	case "secretsmanager.CreateSecret":
+		m.secrets[input["Name"]] = input["SecretString"]
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// listSecrets serves ListSecrets one name per page, so the client has to follow the NextToken
+func (m *mockSecretsManager) listSecrets(w http.ResponseWriter, body []byte) {
+	var input struct {
+		Filters []struct {
+			Key    string
+			Values []string
+		}
+		NextToken string
+	}
+	_ = json.Unmarshal(body, &input)
+
+	var names []string
+	for name := range m.secrets {
+		if len(input.Filters) == 0 || strings.HasPrefix(name, input.Filters[0].Values[0]) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	index := 0
+	if input.NextToken != "" {
+		index, _ = strconv.Atoi(input.NextToken)
+	}
+
+	response := map[string]interface{}{"SecretList": []map[string]string{}}
+	if index < len(names) {
+		response["SecretList"] = []map[string]string{{"Name": names[index]}}
+		if index+1 < len(names) {
+			response["NextToken"] = strconv.Itoa(index + 1)
+		}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func createTestClient(t *testing.T, sm *mockSecretsManager) (*Client, func()) {
+	ts := httptest.NewServer(sm)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	config := types.SecretConfig{
+		Type:     "aws",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "edgex/core-data",
+		AWS: types.AWSInfo{
+			Region:          "us-east-1",
+			AccessKeyId:     "test-key",
+			SecretAccessKey: "test-secret",
+			SecretIds:       map[string]string{"/mapped": "arn:aws:secretsmanager:us-east-1:123456789012:secret:mapped"},
+		},
+	}
+
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	valid := types.SecretConfig{AWS: types.AWSInfo{Region: "us-east-1", AccessKeyId: "id", SecretAccessKey: "secret"}}
+	noRegion := valid
+	noRegion.AWS.Region = ""
+	noCreds := valid
+	noCreds.AWS.AccessKeyId = ""
+
+	for _, env := range []string{"AWS_REGION", "AWS_ACCESS_KEY_ID"} {
+		if value, ok := os.LookupEnv(env); ok {
+			_ = os.Unsetenv(env)
+			defer func(env string, value string) { _ = os.Setenv(env, value) }(env, value)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		config      types.SecretConfig
+		expectError bool
+	}{
+		{"Valid", valid, false},
+		{"Invalid - no region", noRegion, true},
+		{"Invalid - no credentials", noCreds, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewSecretsClient(test.config, nil, logger.NewMockClient())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "https://secretsmanager.us-east-1.amazonaws.com/", client.endpoint)
+		})
+	}
+}
+
+func TestGetSecrets(t *testing.T) {
+	sm := &mockSecretsManager{secrets: map[string]string{
+		"edgex/core-data/redisdb":                                     `{"username":"redis5","password":"password"}`,
+		"arn:aws:secretsmanager:us-east-1:123456789012:secret:mapped": `{"apikey":"key"}`,
+		"edgex/core-data/invalid":                                     `not json`,
+	}}
+	client, closer := createTestClient(t, sm)
+	defer closer()
+
+	tests := []struct {
+		name        string
+		subPath     string
+		keys        []string
+		expected    map[string]string
+		expectError error
+	}{
+		{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+		{"Get mapped secret", "/mapped", nil, map[string]string{"apikey": "key"}, nil},
+		{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+		{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+		{"Invalid secret string", "/invalid", nil, nil, pkg.ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.IsType(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestStoreSecrets(t *testing.T) {
+	sm := &mockSecretsManager{secrets: map[string]string{
+		"edgex/core-data/redisdb": `{"username":"redis5","password":"password"}`,
+	}}
+	client, closer := createTestClient(t, sm)
+	defer closer()
+
+	// update existing secret
+	err := client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis6"}, actual)
+
+	// create new secret
+	err = client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.NoError(t, err)
+	actual, err = client.GetSecrets(context.Background(), "/mqtt", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	sm := &mockSecretsManager{secrets: map[string]string{
+		"edgex/core-data/redisdb":      `{"password":"password"}`,
+		"edgex/core-data/mqtt/broker":  `{"password":"password"}`,
+		"edgex/core-data/mqtt/client":  `{"password":"password"}`,
+		"edgex/core-command/redisdb":   `{"password":"password"}`,
+		"edgex/core-data-other/secret": `{"password":"password"}`,
+	}}
+	client, closer := createTestClient(t, sm)
+	defer closer()
+
+	tests := []struct {
+		name     string
+		subPath  string
+		expected []string
+	}{
+		{"Base path", "", []string{"mqtt/", "redisdb"}},
+		{"Nested path", "/mqtt", []string{"broker", "client"}},
+		{"Empty path", "/unknown", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.ListSecretPaths(context.Background(), test.subPath)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client, closer := createTestClient(t, &mockSecretsManager{})
+	defer closer()
+
+	_, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.Error(t, err)
+}
@@ -0,0 +1,312 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package azure provides a SecretClient backed by Azure Key Vault.
+// Each secret path is stored as a single Key Vault secret holding a JSON object of "key": "value" pairs.
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	keyVaultAPIVersion = "7.4"
+	secretAPIFmt       = "%s/secrets/%s?api-version=%s"
+	secretsAPIFmt      = "%s/secrets?api-version=%s"
+)
+
+// Client is a SecretClient which stores and retrieves secrets from Azure Key Vault
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	vaultURL   string
+	credential *credential
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs an Azure Key Vault Client. If requester is nil an HTTP client with the Transport
+// settings of the configuration is used.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	vaultURL := fmt.Sprintf("https://%s.vault.azure.net", config.Azure.VaultName)
+	if config.Host != "" {
+		var err error
+		if vaultURL, err = config.BuildURL(""); err != nil {
+			return nil, err
+		}
+	} else if config.Azure.VaultName == "" {
+		return nil, pkg.NewErrSecretStore("Azure VaultName or Host is required in config")
+	}
+
+	if requester == nil {
+		httpClient, err := pkg.NewHTTPClient(config.Transport, nil)
+		if err != nil {
+			return nil, err
+		}
+		requester = httpClient
+	}
+
+	return &Client{
+		Config:     config,
+		HttpCaller: requester,
+		lc:         lc,
+		vaultURL:   vaultURL,
+		credential: &credential{info: config.Azure, httpCaller: requester},
+	}, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	name := c.secretName(subPath)
+
+	var response struct {
+		Value string `json:"value"`
+	}
+	status, err := c.doRequest(ctx, http.MethodGet, c.secretURL(name), nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+
+	data := make(map[string]string)
+	if err := json.Unmarshal([]byte(response.Value), &data); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("secret '%s' is not a JSON object of string values: %s", name, err.Error()))
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path, adding a new version of the Key Vault secret.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	request := struct {
+		Value       string `json:"value"`
+		ContentType string `json:"contentType"`
+	}{
+		Value:       string(value),
+		ContentType: "application/json",
+	}
+
+	status, err := c.doRequest(ctx, http.MethodPut, c.secretURL(c.secretName(subPath)), request, nil)
+	if err == nil && status != http.StatusOK {
+		err = pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from Azure Key Vault", status), status, nil)
+	}
+	return err
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path. Key Vault secret names are flat, so the full
+// path of each secret is decoded from its name, and the keys of nested paths end with a "/" as they do with Vault.
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	paths := []string{}
+	listURL := fmt.Sprintf(secretsAPIFmt, c.vaultURL, keyVaultAPIVersion)
+	for listURL != "" {
+		var response struct {
+			Value []struct {
+				Id string `json:"id"`
+			} `json:"value"`
+			NextLink string `json:"nextLink"`
+		}
+		status, err := c.doRequest(ctx, http.MethodGet, listURL, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusNotFound {
+			break
+		}
+
+		for _, secret := range response.Value {
+			// the id is the secret URL, i.e. https://myvault.vault.azure.net/secrets/<name>
+			name := path.Base(secret.Id)
+			if !strings.HasPrefix(name, c.Config.Azure.SecretNamePrefix) {
+				continue
+			}
+			// names which are not an escaped path weren't stored by this client
+			if fullPath, ok := unescapeSecretName(strings.TrimPrefix(name, c.Config.Azure.SecretNamePrefix)); ok {
+				paths = append(paths, fullPath)
+			}
+		}
+
+		listURL = response.NextLink
+	}
+
+	return pkg.ChildKeys(c.fullPath(subPath), paths), nil
+}
+
+// GenerateConsulToken is not supported by Azure Key Vault
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the Azure Key Vault provider")
+}
+
+// secretName maps a sub-path to a Key Vault secret name, see escapeSecretName
+func (c *Client) secretName(subPath string) string {
+	return c.Config.Azure.SecretNamePrefix + escapeSecretName(c.fullPath(subPath))
+}
+
+// fullPath joins the base Path and sub-path the same way as the Vault provider, without the surrounding slashes
+func (c *Client) fullPath(subPath string) string {
+	return strings.Trim(path.Join(c.Config.Path, subPath), "/")
+}
+
+// escapeSecretName encodes a path in the alphanumeric characters and dashes allowed in Key Vault names. The dash
+// is the escape character: a "/" is encoded as "--" and any other byte, including a dash and an upper-case letter,
+// as a dash and two hex digits, i.e. "a/b", "a_b", "a-b" and "aB" are "a--b", "a-5Fb", "a-2Db" and "a-42". Key
+// Vault names are case-insensitive, so only lower-case letters and digits are kept as they are: names which
+// differ only in case decode to the same path, and distinct paths never share a name.
+func escapeSecretName(fullPath string) string {
+	var name strings.Builder
+	for i := 0; i < len(fullPath); i++ {
+		char := fullPath[i]
+		switch {
+		case (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9'):
+			name.WriteByte(char)
+		case char == '/':
+			name.WriteString("--")
+		default:
+			name.WriteString(fmt.Sprintf("-%02X", char))
+		}
+	}
+	return name.String()
+}
+
+// unescapeSecretName reverses escapeSecretName, returning false if name is not a valid encoding. The hex digits
+// of an escaped byte are read in either case, as Key Vault may return a name in another case than it was stored.
+func unescapeSecretName(name string) (string, bool) {
+	var fullPath strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] != '-' {
+			if name[i] >= 'A' && name[i] <= 'Z' {
+				// an upper-case letter is always escaped, so the name wasn't stored by this client
+				return "", false
+			}
+			fullPath.WriteByte(name[i])
+			continue
+		}
+		if i+1 < len(name) && name[i+1] == '-' {
+			fullPath.WriteByte('/')
+			i++
+			continue
+		}
+		if i+2 >= len(name) {
+			return "", false
+		}
+		char, err := strconv.ParseUint(name[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", false
+		}
+		fullPath.WriteByte(byte(char))
+		i += 2
+	}
+	return fullPath.String(), true
+}
+
+// secretURL returns the Key Vault secret API URL for name
+func (c *Client) secretURL(name string) string {
+	return fmt.Sprintf(secretAPIFmt, c.vaultURL, name, keyVaultAPIVersion)
+}
+
+// doRequest calls the Key Vault API at apiURL. A 404 status is returned without error so callers can
+// distinguish a missing secret.
+func (c *Client) doRequest(ctx context.Context, method string, apiURL string, input interface{}, output interface{}) (int, error) {
+	token, err := c.credential.getToken(ctx)
+	if err != nil {
+		c.lc.Errorf("unable to authenticate to Azure Key Vault: %s", err.Error())
+		return 0, err
+	}
+
+	var body []byte
+	if input != nil {
+		if body, err = json.Marshal(input); err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, apiURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make request to Azure Key Vault: %s", err.Error())
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return resp.StatusCode, nil
+	case resp.StatusCode != http.StatusOK:
+		return resp.StatusCode, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from Azure Key Vault: %s",
+			resp.StatusCode, string(respBody)), resp.StatusCode, []string{string(respBody)})
+	}
+
+	if output != nil {
+		if err := json.Unmarshal(respBody, output); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,283 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const testAccessToken = "test-access-token"
+
+// mockKeyVault is a stub Key Vault, Azure AD and instance metadata service
+type mockKeyVault struct {
+	mutex      sync.Mutex
+	secrets    map[string]string
+	tokenCalls int
+}
+
+func (m *mockKeyVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == "/tenant/oauth2/v2.0/token":
+		_ = r.ParseForm()
+		if r.PostForm.Get("client_secret") != "secret" || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.tokenCalls++
+		_, _ = w.Write([]byte(`{"access_token":"` + testAccessToken + `","expires_in":3599}`))
+	case r.URL.Path == "/metadata/identity/oauth2/token":
+		if r.Header.Get("Metadata") != "true" || r.URL.Query().Get("resource") != keyVaultResource {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.tokenCalls++
+		_, _ = w.Write([]byte(`{"access_token":"` + testAccessToken + `","expires_in":"3599"}`))
+	case r.URL.Path == "/secrets" && r.Method == http.MethodGet:
+		if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.listSecrets(w, r)
+	case strings.HasPrefix(r.URL.Path, "/secrets/"):
+		if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, "/secrets/")
+		switch r.Method {
+		case http.MethodGet:
+			value, ok := m.secrets[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"value": value})
+		case http.MethodPut:
+			var request map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			m.secrets[name] = request["value"]
+			_ = json.NewEncoder(w).Encode(request)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// listSecrets serves the secret list one secret per page, so the client has to follow the nextLink
+func (m *mockKeyVault) listSecrets(w http.ResponseWriter, r *http.Request) {
+	var names []string
+	for name := range m.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	index, _ := strconv.Atoi(r.URL.Query().Get("$skiptoken"))
+	response := map[string]interface{}{"value": []map[string]string{}}
+	if index < len(names) {
+		response["value"] = []map[string]string{{"id": "http://" + r.Host + "/secrets/" + names[index]}}
+		if index+1 < len(names) {
+			response["nextLink"] = fmt.Sprintf("http://%s/secrets?api-version=%s&$skiptoken=%d", r.Host, keyVaultAPIVersion, index+1)
+		}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func createTestClient(t *testing.T, kv *mockKeyVault, servicePrincipal bool) (*Client, func()) {
+	ts := httptest.NewServer(kv)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	config := types.SecretConfig{
+		Type:     "azure",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "/edgex/",
+		Azure: types.AzureInfo{
+			SecretNamePrefix: "svc-",
+			IdentityEndpoint: ts.URL + "/metadata/identity/oauth2/token",
+		},
+	}
+	if servicePrincipal {
+		config.Azure.TenantId = "tenant"
+		config.Azure.ClientId = "client"
+		config.Azure.ClientSecret = "secret"
+		config.Azure.AuthorityHost = ts.URL
+	}
+
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	client, err := NewSecretsClient(types.SecretConfig{Azure: types.AzureInfo{VaultName: "myvault"}}, nil, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, "https://myvault.vault.azure.net", client.vaultURL)
+
+	_, err = NewSecretsClient(types.SecretConfig{}, nil, logger.NewMockClient())
+	require.Error(t, err)
+}
+
+func TestSecretName(t *testing.T) {
+	client, closer := createTestClient(t, &mockKeyVault{}, false)
+	defer closer()
+
+	assert.Equal(t, "svc-edgex--core-2Ddata--redisdb", client.secretName("/core-data/redisdb"))
+	assert.Equal(t, "svc-edgex--app-5Frules-2Eengine", client.secretName("app_rules.engine"))
+
+	// the paths which were mapped to the same name are kept apart
+	names := map[string]string{}
+	for _, subPath := range []string{"a/b", "a_b", "a-b", "a--b", "a/-b", "a-/b"} {
+		name := client.secretName(subPath)
+		assert.NotContains(t, names, name, "%s and %s share a name", names[name], subPath)
+		names[name] = subPath
+
+		fullPath, ok := unescapeSecretName(strings.TrimPrefix(name, "svc-"))
+		require.True(t, ok)
+		assert.Equal(t, "edgex/"+subPath, fullPath)
+	}
+
+	// Key Vault names are case-insensitive, so the names of paths which differ only in case must differ otherwise
+	upper := client.secretName("Redis")
+	lower := client.secretName("redis")
+	assert.Equal(t, "svc-edgex---52edis", upper)
+	assert.False(t, strings.EqualFold(upper, lower), "%s and %s are the same Key Vault secret", upper, lower)
+	fullPath, ok := unescapeSecretName(strings.ToLower(strings.TrimPrefix(upper, "svc-")))
+	require.True(t, ok)
+	assert.Equal(t, "edgex/Redis", fullPath)
+
+	for _, name := range []string{"a-", "a-2", "a-ZZb", "aB"} {
+		_, ok := unescapeSecretName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestGetSecrets(t *testing.T) {
+	for _, servicePrincipal := range []bool{true, false} {
+		kv := &mockKeyVault{secrets: map[string]string{
+			"svc-edgex--redisdb": `{"username":"redis5","password":"password"}`,
+		}}
+		client, closer := createTestClient(t, kv, servicePrincipal)
+
+		tests := []struct {
+			name        string
+			subPath     string
+			keys        []string
+			expected    map[string]string
+			expectError error
+		}{
+			{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+			{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+			{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+			{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+				if test.expectError != nil {
+					require.Error(t, err)
+					assert.IsType(t, test.expectError, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			})
+		}
+
+		_, err := client.GetSecrets(context.Background(), "/unknown")
+		assert.True(t, errors.Is(err, pkg.ErrSecretNotFound{}))
+
+		// the access token is cached across requests
+		assert.Equal(t, 1, kv.tokenCalls)
+		closer()
+	}
+}
+
+func TestStoreSecrets(t *testing.T) {
+	kv := &mockKeyVault{secrets: map[string]string{}}
+	client, closer := createTestClient(t, kv, true)
+	defer closer()
+
+	err := client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"password":"mqtt"}`, kv.secrets["svc-edgex--mqtt"])
+
+	actual, err := client.GetSecrets(context.Background(), "/mqtt", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	kv := &mockKeyVault{secrets: map[string]string{
+		"svc-edgex--core-2Ddata--redisdb":      `{"password":"password"}`,
+		"svc-edgex--core-2Ddata--mqtt-2Dproxy": `{"password":"password"}`,
+		"svc-edgex--core-2Ddata--mqtt--broker": `{"password":"password"}`,
+		"svc-edgex--core-2Dcommand--redisdb":   `{"password":"password"}`,
+		"svc-edgex--core-5Fdata--redisdb":      `{"password":"password"}`,
+		"other-edgex--core-2Ddata--redisdb":    `{"password":"password"}`,
+		"svc-not-an-escaped-path-":             `{"password":"password"}`,
+	}}
+	client, closer := createTestClient(t, kv, false)
+	defer closer()
+
+	tests := []struct {
+		name     string
+		subPath  string
+		expected []string
+	}{
+		{"Base path", "", []string{"core-command/", "core-data/", "core_data/"}},
+		{"Sub-path", "/core-data", []string{"mqtt-proxy", "mqtt/", "redisdb"}},
+		{"Nested sub-path", "/core-data/mqtt", []string{"broker"}},
+		{"Secret", "/core-data/redisdb", []string{}},
+		{"Empty path", "/unknown", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.ListSecretPaths(context.Background(), test.subPath)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	keyVaultResource        = "https://vault.azure.net"
+	defaultAuthorityHost    = "https://login.microsoftonline.com"
+	defaultIdentityEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+	imdsAPIVersion          = "2018-02-01"
+
+	// tokens are refreshed this long before they actually expire
+	tokenExpiryMargin = 2 * time.Minute
+)
+
+// tokenResponse is the OAuth2 token response returned by both Azure AD and the instance metadata service.
+// The metadata service returns expires_in as a string while Azure AD returns a number.
+type tokenResponse struct {
+	AccessToken string      `json:"access_token"`
+	ExpiresIn   json.Number `json:"expires_in"`
+}
+
+// credential obtains and caches the Azure AD access token used to call Key Vault
+type credential struct {
+	info       types.AzureInfo
+	httpCaller pkg.Caller
+	mutex      sync.Mutex
+	token      string
+	expiry     time.Time
+}
+
+// getToken returns a cached access token, requesting a new one when it is about to expire
+func (c *credential) getToken(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token != "" && time.Now().Before(c.expiry) {
+		return c.token, nil
+	}
+
+	var req *http.Request
+	var err error
+	if c.info.ClientSecret != "" {
+		req, err = c.servicePrincipalRequest(ctx)
+	} else {
+		req, err = c.managedIdentityRequest(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpCaller.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("failed to obtain Azure AD token, status %d: %s", resp.StatusCode, string(body)))
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", err
+	}
+
+	expiresIn, err := token.ExpiresIn.Int64()
+	if err != nil {
+		return "", fmt.Errorf("invalid expires_in in Azure AD token response: %s", err.Error())
+	}
+
+	c.token = token.AccessToken
+	c.expiry = time.Now().Add(time.Duration(expiresIn)*time.Second - tokenExpiryMargin)
+
+	return c.token, nil
+}
+
+// servicePrincipalRequest builds the client credentials grant request to Azure AD
+func (c *credential) servicePrincipalRequest(ctx context.Context) (*http.Request, error) {
+	if c.info.TenantId == "" || c.info.ClientId == "" {
+		return nil, pkg.NewErrSecretStore("TenantId and ClientId are required for Azure service principal authentication")
+	}
+
+	authority := c.info.AuthorityHost
+	if authority == "" {
+		authority = defaultAuthorityHost
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.info.ClientId)
+	form.Set("client_secret", c.info.ClientSecret)
+	form.Set("scope", keyVaultResource+"/.default")
+
+	tokenURL := fmt.Sprintf("%s/%s/oauth2/v2.0/token", strings.TrimSuffix(authority, "/"), url.PathEscape(c.info.TenantId))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// managedIdentityRequest builds the token request to the instance metadata service
+func (c *credential) managedIdentityRequest(ctx context.Context) (*http.Request, error) {
+	endpoint := c.info.IdentityEndpoint
+	if endpoint == "" {
+		endpoint = defaultIdentityEndpoint
+	}
+
+	query := url.Values{}
+	query.Set("api-version", imdsAPIVersion)
+	query.Set("resource", keyVaultResource)
+	if c.info.ClientId != "" {
+		query.Set("client_id", c.info.ClientId)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata", "true")
+
+	return req, nil
+}
@@ -0,0 +1,333 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package conjur provides a SecretClient backed by CyberArk Conjur, authenticating with a host identity.
+// Each secret path is stored as a single Conjur variable holding a JSON object of "key": "value" pairs.
+package conjur
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	authenticateAPIFmt = "/authn/%s/%s/authenticate"
+	variableAPIFmt     = "/secrets/%s/variable/%s"
+	variablesAPIFmt    = "/resources/%s/variable?search=%s&limit=%d&offset=%d"
+
+	// listPageSize is the number of variables listed per request
+	listPageSize = 100
+
+	// Conjur access tokens are valid for 8 minutes, they are refreshed well before then
+	tokenLifetime = 5 * time.Minute
+)
+
+// Client is a SecretClient which stores and retrieves secrets from CyberArk Conjur
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	apiKey     string
+	mutex      sync.Mutex
+	token      string
+	expiry     time.Time
+	// now abstracts the clock used for the token expiry, which is most useful for testing
+	now func() time.Time
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs a Conjur Client. If requester is nil an HTTP client with the Transport settings is
+// used, or one trusting the RootCaCertPath when it is configured.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	if config.Conjur.Account == "" || config.Conjur.Login == "" {
+		return nil, pkg.NewErrSecretStore("Conjur Account and Login are required in config")
+	}
+
+	apiKey := config.Conjur.APIKey
+	if apiKey == "" && config.Conjur.APIKeyFile != "" {
+		contents, err := ioutil.ReadFile(config.Conjur.APIKeyFile)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read Conjur APIKeyFile: %s", err.Error()))
+		}
+		apiKey = strings.TrimSpace(string(contents))
+	}
+	if apiKey == "" {
+		return nil, pkg.NewErrSecretStore("Conjur APIKey or APIKeyFile is required in config")
+	}
+
+	if _, err := config.BuildURL(""); err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		httpClient, err := pkg.NewHTTPClient(config.Transport, nil)
+		if err != nil {
+			return nil, err
+		}
+		requester = httpClient
+		if config.RootCaCertPath != "" {
+			caReader, err := os.Open(config.RootCaCertPath)
+			if err != nil {
+				return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to open Conjur CA certificate %s: %s", config.RootCaCertPath, err.Error()))
+			}
+			if requester = pkg.NewRequester(lc).WithTLS(caReader, config.ServerName); requester == nil {
+				return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to load Conjur CA certificate %s", config.RootCaCertPath))
+			}
+		}
+	}
+
+	return &Client{
+		Config:     config,
+		HttpCaller: requester,
+		lc:         lc,
+		apiKey:     apiKey,
+		now:        time.Now,
+	}, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	variableId := c.variableId(subPath)
+
+	status, body, err := c.doRequest(ctx, http.MethodGet, c.variableAPI(variableId), nil)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+
+	data := make(map[string]string)
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("variable '%s' is not a JSON object of string values: %s", variableId, err.Error()))
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path as a new version of the Conjur variable.
+// Conjur variables can only be created by loading policy, so the variable must already be declared.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	variableId := c.variableId(subPath)
+	status, _, err := c.doRequest(ctx, http.MethodPost, c.variableAPI(variableId), value)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case http.StatusCreated:
+		return nil
+	case http.StatusNotFound:
+		return pkg.NewErrSecretStore(fmt.Sprintf("Conjur variable '%s' for subpath '%s' must be declared in policy before storing secrets",
+			variableId, subPath))
+	default:
+		return pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from Conjur storing variable '%s'", status, variableId),
+			status, nil)
+	}
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path from the ids of the variables the host
+// identity is permitted to see.
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	prefix := strings.Trim(path.Join(c.Config.Path, subPath), "/")
+	// resource ids are fully qualified, i.e. <account>:variable:<variable id>
+	idPrefix := c.Config.Conjur.Account + ":variable:" + c.Config.Conjur.VariablePrefix
+
+	var paths []string
+	for offset := 0; ; offset += listPageSize {
+		apiPath := fmt.Sprintf(variablesAPIFmt, url.PathEscape(c.Config.Conjur.Account),
+			url.QueryEscape(c.Config.Conjur.VariablePrefix+prefix), listPageSize, offset)
+		status, body, err := c.doRequest(ctx, http.MethodGet, apiPath, nil)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusNotFound {
+			break
+		}
+
+		var resources []struct {
+			Id string `json:"id"`
+		}
+		if err := json.Unmarshal(body, &resources); err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			if strings.HasPrefix(resource.Id, idPrefix) {
+				paths = append(paths, strings.TrimPrefix(resource.Id, idPrefix))
+			}
+		}
+
+		if len(resources) < listPageSize {
+			break
+		}
+	}
+
+	return pkg.ChildKeys(prefix, paths), nil
+}
+
+// GenerateConsulToken is not supported by Conjur
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the Conjur provider")
+}
+
+// variableId maps a sub-path to a Conjur variable id
+func (c *Client) variableId(subPath string) string {
+	return c.Config.Conjur.VariablePrefix + strings.Trim(path.Join(c.Config.Path, subPath), "/")
+}
+
+func (c *Client) variableAPI(variableId string) string {
+	return fmt.Sprintf(variableAPIFmt, url.PathEscape(c.Config.Conjur.Account), url.PathEscape(variableId))
+}
+
+// getToken returns a cached access token, authenticating the host identity when it is about to expire
+func (c *Client) getToken(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token != "" && c.now().Before(c.expiry) {
+		return c.token, nil
+	}
+
+	authURL, err := c.Config.BuildURL(fmt.Sprintf(authenticateAPIFmt,
+		url.PathEscape(c.Config.Conjur.Account), url.PathEscape(c.Config.Conjur.Login)))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL, strings.NewReader(c.apiKey))
+	if err != nil {
+		return "", err
+	}
+	// ask for the token pre-encoded so it can be used directly in the Authorization header
+	req.Header.Set("Accept-Encoding", "base64")
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("failed to authenticate Conjur host identity '%s', status %d",
+			c.Config.Conjur.Login, resp.StatusCode))
+	}
+
+	c.token = strings.TrimSpace(string(body))
+	c.expiry = c.now().Add(tokenLifetime)
+
+	return c.token, nil
+}
+
+// doRequest calls the Conjur API with the access token. A 404 status is returned without error so callers can
+// distinguish a missing variable.
+func (c *Client) doRequest(ctx context.Context, method string, apiPath string, body []byte) (int, []byte, error) {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		c.lc.Errorf("unable to authenticate to Conjur: %s", err.Error())
+		return 0, nil, err
+	}
+
+	requestURL, err := c.Config.BuildURL(apiPath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bodyReader)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Token token=\"%s\"", token))
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make request to Conjur: %s", err.Error())
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNotFound:
+		return resp.StatusCode, respBody, nil
+	case http.StatusUnauthorized:
+		// force re-authentication on the next request, the token may have been revoked
+		c.mutex.Lock()
+		c.token = ""
+		c.mutex.Unlock()
+	}
+
+	return resp.StatusCode, nil, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from Conjur: %s",
+		resp.StatusCode, string(respBody)), resp.StatusCode, []string{string(respBody)})
+}
@@ -0,0 +1,283 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package conjur
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testAccount = "edgex"
+	testLogin   = "host/edgex/core-data"
+	testAPIKey  = "test-api-key"
+)
+
+var testToken = base64.StdEncoding.EncodeToString([]byte(`{"protected":"test","payload":"test","signature":"test"}`))
+
+// mockConjur is a stub Conjur server holding the variables declared by policy
+type mockConjur struct {
+	mutex     sync.Mutex
+	variables map[string]string
+	authCalls int
+}
+
+func (m *mockConjur) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	segments := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	for i := range segments {
+		segments[i], _ = url.PathUnescape(segments[i])
+	}
+
+	switch {
+	case len(segments) == 4 && segments[0] == "authn" && segments[3] == "authenticate":
+		body, _ := ioutil.ReadAll(r.Body)
+		if segments[1] != testAccount || segments[2] != testLogin || string(body) != testAPIKey ||
+			r.Header.Get("Accept-Encoding") != "base64" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.authCalls++
+		_, _ = w.Write([]byte(testToken))
+	case len(segments) == 4 && segments[0] == "secrets" && segments[2] == "variable":
+		if r.Header.Get("Authorization") != `Token token="`+testToken+`"` {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		value, ok := m.variables[segments[3]]
+		if !ok || segments[1] != testAccount {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(value))
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			m.variables[segments[3]] = string(body)
+			w.WriteHeader(http.StatusCreated)
+		}
+	case len(segments) == 3 && segments[0] == "resources" && segments[2] == "variable":
+		if r.Header.Get("Authorization") != `Token token="`+testToken+`"` {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.listVariables(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// listVariables serves the variable resources matching the search, paged by limit and offset
+func (m *mockConjur) listVariables(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+	for id := range m.variables {
+		if strings.Contains(id, r.URL.Query().Get("search")) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	resources := []map[string]string{}
+	for index := offset; index < len(ids) && index < offset+limit; index++ {
+		resources = append(resources, map[string]string{"id": testAccount + ":variable:" + ids[index]})
+	}
+	_ = json.NewEncoder(w).Encode(resources)
+}
+
+func createTestClient(t *testing.T, conjur *mockConjur) (*Client, func()) {
+	ts := httptest.NewServer(conjur)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	config := types.SecretConfig{
+		Type:     "conjur",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "edgex/core-data",
+		Conjur: types.ConjurInfo{
+			Account: testAccount,
+			Login:   testLogin,
+			APIKey:  testAPIKey,
+		},
+	}
+
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	apiKeyFile := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, ioutil.WriteFile(apiKeyFile, []byte(testAPIKey+"\n"), 0600))
+
+	valid := types.SecretConfig{Protocol: "https", Host: "conjur", Port: 443,
+		Conjur: types.ConjurInfo{Account: testAccount, Login: testLogin, APIKeyFile: apiKeyFile}}
+	noAccount := valid
+	noAccount.Conjur.Account = ""
+	noAPIKey := valid
+	noAPIKey.Conjur.APIKeyFile = ""
+	missingAPIKeyFile := valid
+	missingAPIKeyFile.Conjur.APIKeyFile = "/missing/api-key"
+	noHost := valid
+	noHost.Host = ""
+
+	tests := []struct {
+		name        string
+		config      types.SecretConfig
+		expectError bool
+	}{
+		{"Valid", valid, false},
+		{"Invalid - no account", noAccount, true},
+		{"Invalid - no API key", noAPIKey, true},
+		{"Invalid - missing API key file", missingAPIKeyFile, true},
+		{"Invalid - no host", noHost, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewSecretsClient(test.config, nil, logger.NewMockClient())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testAPIKey, client.apiKey)
+		})
+	}
+}
+
+func TestGetSecrets(t *testing.T) {
+	conjur := &mockConjur{variables: map[string]string{
+		"edgex/core-data/redisdb": `{"username":"redis5","password":"password"}`,
+		"edgex/core-data/invalid": `not json`,
+	}}
+	client, closer := createTestClient(t, conjur)
+	defer closer()
+
+	tests := []struct {
+		name        string
+		subPath     string
+		keys        []string
+		expected    map[string]string
+		expectError error
+	}{
+		{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+		{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+		{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+		{"Invalid variable value", "/invalid", nil, nil, pkg.ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.IsType(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	// the access token is cached until it is close to expiring
+	assert.Equal(t, 1, conjur.authCalls)
+	client.now = func() time.Time { return time.Now().Add(tokenLifetime) }
+	_, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, 2, conjur.authCalls)
+}
+
+func TestStoreSecrets(t *testing.T) {
+	conjur := &mockConjur{variables: map[string]string{
+		"edgex/core-data/redisdb": `{"username":"redis5","password":"password"}`,
+	}}
+	client, closer := createTestClient(t, conjur)
+	defer closer()
+
+	err := client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis6"}, actual)
+
+	// variables which are not declared in policy can't be stored
+	err = client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.Error(t, err)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	conjur := &mockConjur{variables: map[string]string{
+		"edgex/core-data/redisdb":     `{"password":"password"}`,
+		"edgex/core-data/mqtt/broker": `{"password":"password"}`,
+		"edgex/core-data/mqtt/client": `{"password":"password"}`,
+		"edgex/core-command/redisdb":  `{"password":"password"}`,
+	}}
+	client, closer := createTestClient(t, conjur)
+	defer closer()
+
+	actual, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt/", "redisdb"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"broker", "client"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/unknown")
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client, closer := createTestClient(t, &mockConjur{})
+	defer closer()
+
+	_, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.Error(t, err)
+}
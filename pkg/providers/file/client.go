@@ -0,0 +1,382 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package file provides a SecretClient which persists the secrets to a local AES-GCM encrypted JSON file,
+// for edge devices which cannot run a secret store service. The file is replaced atomically on every store.
+package file
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	fileVersion = 1
+	kdfPBKDF2   = "pbkdf2-sha256"
+	kdfRawKey   = "raw"
+
+	keyLength  = 32
+	saltLength = 16
+	// defaultIterations follows the OWASP recommendation for PBKDF2-HMAC-SHA256
+	defaultIterations = 600000
+	// fipsMinIterations is the minimum PBKDF2 iteration count recommended by NIST SP 800-132, which a file read in
+	// FIPS mode must have been encrypted with
+	fipsMinIterations = 1000
+)
+
+// additionalData binds the ciphertext to this file format
+var additionalData = []byte("edgex-secrets-file-v1")
+
+// envelope is the JSON document written to the secrets file
+type envelope struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations,omitempty"`
+	Salt       []byte `json:"salt,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Client is a SecretClient which stores and retrieves secrets from a local encrypted file
+type Client struct {
+	Config     types.SecretConfig
+	lc         logger.LoggingClient
+	passphrase []byte
+	// rawKey is set when the key is read from the KeyFile rather than derived from the passphrase
+	rawKey     []byte
+	iterations int
+	// derivedKey caches the key derived from the passphrase for derivedSalt, as the derivation is deliberately slow
+	derivedKey  []byte
+	derivedSalt []byte
+	mutex       sync.Mutex
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs a file Client. When the secrets file already exists it is decrypted to verify the key.
+func NewSecretsClient(config types.SecretConfig, lc logger.LoggingClient) (*Client, error) {
+	if strings.TrimSpace(config.File.FilePath) == "" {
+		return nil, pkg.NewErrSecretStore("FilePath is required for the file secret store provider")
+	}
+
+	client := &Client{
+		Config:     config,
+		lc:         lc,
+		iterations: defaultIterations,
+	}
+
+	switch {
+	case config.File.KeyFile != "":
+		key, err := ioutil.ReadFile(config.File.KeyFile)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read key file: %s", err.Error()))
+		}
+		if len(key) != keyLength {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("key file must contain exactly %d bytes, found %d", keyLength, len(key)))
+		}
+		client.rawKey = key
+	case config.File.Passphrase != "":
+		client.passphrase = []byte(config.File.Passphrase)
+	default:
+		return nil, pkg.NewErrSecretStore("either Passphrase or KeyFile is required for the file secret store provider")
+	}
+
+	if _, err := client.load(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	all, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := all[c.fullPath(subPath)]
+	if !ok {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	all, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(all))
+	for fullPath := range all {
+		paths = append(paths, fullPath)
+	}
+
+	return pkg.ChildKeys(c.fullPath(subPath), paths), nil
+}
+
+// StoreSecrets stores the secrets at the provided sub-path, replacing any existing secrets at the sub-path.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	all, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	all[c.fullPath(subPath)] = secrets
+
+	return c.save(all)
+}
+
+// GenerateConsulToken is not supported by the file provider
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the file provider")
+}
+
+func (c *Client) fullPath(subPath string) string {
+	return path.Join("/", c.Config.Path, subPath)
+}
+
+// load reads and decrypts all the secrets from the file. A missing file is treated as an empty store.
+func (c *Client) load() (map[string]map[string]string, error) {
+	all := make(map[string]map[string]string)
+
+	contents, err := ioutil.ReadFile(c.Config.File.FilePath)
+	if os.IsNotExist(err) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read secrets file: %s", err.Error()))
+	}
+
+	var env envelope
+	if err := json.Unmarshal(contents, &env); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse secrets file: %s", err.Error()))
+	}
+	if env.Version != fileVersion {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unsupported secrets file version %d", env.Version))
+	}
+
+	key, err := c.key(env)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, pkg.NewErrSecretStore("secrets file has an invalid nonce")
+	}
+
+	plaintext, err := aead.Open(nil, env.Nonce, env.Ciphertext, additionalData)
+	if err != nil {
+		return nil, pkg.NewErrSecretStore("unable to decrypt secrets file, the key is incorrect or the file is corrupted")
+	}
+
+	if err := json.Unmarshal(plaintext, &all); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse decrypted secrets: %s", err.Error()))
+	}
+
+	return all, nil
+}
+
+// save encrypts the secrets and atomically replaces the file by renaming a fully written temporary file over it
+func (c *Client) save(all map[string]map[string]string) error {
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+
+	env := envelope{Version: fileVersion, KDF: kdfRawKey}
+	if c.rawKey == nil {
+		env.KDF = kdfPBKDF2
+		env.Iterations = c.iterations
+		env.Salt = c.derivedSalt
+		if env.Salt == nil {
+			env.Salt = make([]byte, saltLength)
+			if _, err := rand.Read(env.Salt); err != nil {
+				return err
+			}
+		}
+	}
+
+	key, err := c.key(env)
+	if err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	env.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return err
+	}
+	env.Ciphertext = aead.Seal(nil, env.Nonce, plaintext, additionalData)
+
+	contents, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(c.Config.File.FilePath, contents)
+}
+
+// key returns the AES key for the envelope, deriving it from the passphrase when the salt has changed
+func (c *Client) key(env envelope) ([]byte, error) {
+	switch env.KDF {
+	case kdfRawKey:
+		if c.rawKey == nil {
+			return nil, pkg.NewErrSecretStore("secrets file was encrypted with a key file, but no KeyFile is configured")
+		}
+		return c.rawKey, nil
+	case kdfPBKDF2:
+		if c.passphrase == nil {
+			return nil, pkg.NewErrSecretStore("secrets file was encrypted with a passphrase, but no Passphrase is configured")
+		}
+		if env.Iterations <= 0 || len(env.Salt) == 0 {
+			return nil, pkg.NewErrSecretStore("secrets file has invalid key derivation parameters")
+		}
+		if fips.Enabled() && (env.Iterations < fipsMinIterations || len(env.Salt) < saltLength) {
+			return nil, fips.NewErrNotApproved(fmt.Sprintf("PBKDF2 with %d iterations and a %d byte salt",
+				env.Iterations, len(env.Salt)))
+		}
+		if c.derivedKey == nil || string(c.derivedSalt) != string(env.Salt) || c.iterations != env.Iterations {
+			c.derivedKey = pbkdf2SHA256(c.passphrase, env.Salt, env.Iterations, keyLength)
+			c.derivedSalt = env.Salt
+			c.iterations = env.Iterations
+		}
+		return c.derivedKey, nil
+	default:
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unsupported key derivation '%s' in secrets file", env.KDF))
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// writeFileAtomic writes the contents to a temporary file in the same directory, syncs it and renames it over
+// filePath, so readers see either the previous or the new contents and never a partially written file.
+func writeFileAtomic(filePath string, contents []byte) error {
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(filePath)+".tmp-")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		// no-op once the rename has succeeded
+		_ = os.Remove(tmpName)
+	}()
+
+	if _, err := tmp.Write(contents); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpName, filePath); err != nil {
+		return err
+	}
+
+	// sync the directory so the rename itself is durable
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		_ = d.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// testIterations returns few iterations to keep the tests fast, the default iterations are deliberately slow. FIPS
+// mode, always on with the fips build tag, refuses fewer than fipsMinIterations.
+func testIterations() int {
+	if fips.Enabled() {
+		return fipsMinIterations
+	}
+	return 10
+}
+
+func createTestClient(t *testing.T, info types.FileInfo) *Client {
+	client, err := NewSecretsClient(types.SecretConfig{Type: "file", Path: "/edgex/core-data", File: info}, logger.NewMockClient())
+	require.NoError(t, err)
+	client.iterations = testIterations()
+	return client
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	dir := t.TempDir()
+	shortKey := filepath.Join(dir, "short.key")
+	require.NoError(t, ioutil.WriteFile(shortKey, []byte("short"), 0600))
+
+	tests := []struct {
+		name        string
+		info        types.FileInfo
+		expectError bool
+	}{
+		{"Valid - passphrase", types.FileInfo{FilePath: filepath.Join(dir, "secrets.json"), Passphrase: "pass"}, false},
+		{"Invalid - no file path", types.FileInfo{Passphrase: "pass"}, true},
+		{"Invalid - no key", types.FileInfo{FilePath: filepath.Join(dir, "secrets.json")}, true},
+		{"Invalid - missing key file", types.FileInfo{FilePath: filepath.Join(dir, "secrets.json"), KeyFile: filepath.Join(dir, "missing")}, true},
+		{"Invalid - short key file", types.FileInfo{FilePath: filepath.Join(dir, "secrets.json"), KeyFile: shortKey}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewSecretsClient(types.SecretConfig{File: test.info}, logger.NewMockClient())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStoreAndGetSecrets(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "tpm.key")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("0123456789abcdef0123456789abcdef"), 0600))
+
+	tests := []struct {
+		name string
+		info types.FileInfo
+	}{
+		{"Passphrase", types.FileInfo{FilePath: filepath.Join(dir, "passphrase", "secrets.json"), Passphrase: "pass"}},
+		{"Key file", types.FileInfo{FilePath: filepath.Join(dir, "keyfile", "secrets.json"), KeyFile: keyFile}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := createTestClient(t, test.info)
+
+			_, err := client.GetSecrets(ctx, "/redisdb")
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretStore{}, err)
+
+			err = client.StoreSecrets(ctx, "/redisdb", map[string]string{"username": "redis5", "password": "password"})
+			require.NoError(t, err)
+			err = client.StoreSecrets(ctx, "/mqtt", map[string]string{"password": "mqtt"})
+			require.NoError(t, err)
+
+			// a new client must be able to decrypt the persisted file
+			reopened := createTestClient(t, test.info)
+			actual, err := reopened.GetSecrets(ctx, "/redisdb", "password")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"password": "password"}, actual)
+
+			_, err = reopened.GetSecrets(ctx, "/redisdb", "missing")
+			require.Error(t, err)
+			assert.IsType(t, pkg.ErrSecretsNotFound{}, err)
+
+			contents, err := ioutil.ReadFile(test.info.FilePath)
+			require.NoError(t, err)
+			assert.False(t, strings.Contains(string(contents), "redis5"), "secrets must be encrypted at rest")
+
+			// only the secrets file remains, the temporary files have been renamed
+			files, err := ioutil.ReadDir(filepath.Dir(test.info.FilePath))
+			require.NoError(t, err)
+			assert.Len(t, files, 1)
+		})
+	}
+}
+
+func TestWrongPassphrase(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "secrets.json")
+	client := createTestClient(t, types.FileInfo{FilePath: filePath, Passphrase: "pass"})
+	require.NoError(t, client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"password": "password"}))
+
+	_, err := NewSecretsClient(types.SecretConfig{File: types.FileInfo{FilePath: filePath, Passphrase: "wrong"}}, logger.NewMockClient())
+	require.Error(t, err)
+}
+
+func TestFIPSMode(t *testing.T) {
+	dir := t.TempDir()
+	// a weak file can only be written outside of FIPS mode, which the fips build tag keeps on
+	writeWeak := !fips.Enabled()
+	weak := types.FileInfo{FilePath: filepath.Join(dir, "weak.json"), Passphrase: "pass"}
+	if writeWeak {
+		client := createTestClient(t, weak)
+		require.NoError(t, client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"password": "password"}))
+	}
+
+	approved := types.FileInfo{FilePath: filepath.Join(dir, "approved.json"), Passphrase: "pass"}
+	client := createTestClient(t, approved)
+	client.iterations = fipsMinIterations
+	require.NoError(t, client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"password": "password"}))
+
+	fips.SetEnabled(true)
+	defer fips.SetEnabled(false)
+
+	if writeWeak {
+		_, err := NewSecretsClient(types.SecretConfig{File: weak}, logger.NewMockClient())
+		require.Error(t, err)
+		assert.IsType(t, fips.ErrNotApproved{}, err)
+	}
+
+	client, err := NewSecretsClient(types.SecretConfig{Path: "/edgex/core-data", File: approved}, logger.NewMockClient())
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, actual)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	client := createTestClient(t, types.FileInfo{FilePath: filepath.Join(t.TempDir(), "secrets.json"), Passphrase: "pass"})
+	ctx := context.Background()
+
+	keys, err := client.ListSecretPaths(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	require.NoError(t, client.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "password"}))
+	require.NoError(t, client.StoreSecrets(ctx, "/mqtt/broker", map[string]string{"password": "mqtt"}))
+
+	keys, err = client.ListSecretPaths(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt/", "redisdb"}, keys)
+
+	keys, err = client.ListSecretPaths(ctx, "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"broker"}, keys)
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client := createTestClient(t, types.FileInfo{FilePath: filepath.Join(t.TempDir(), "secrets.json"), Passphrase: "pass"})
+
+	_, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.Error(t, err)
+}
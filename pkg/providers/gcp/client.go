@@ -0,0 +1,331 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package gcp provides a SecretClient backed by Google Cloud Secret Manager.
+// Each secret path is stored as a single Secret Manager secret whose payload is a JSON object of "key": "value" pairs.
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	defaultEndpoint = "https://secretmanager.googleapis.com/v1"
+	latestVersion   = "latest"
+
+	accessVersionFmt = "%s/projects/%s/secrets/%s/versions/%s:access"
+	addVersionFmt    = "%s/projects/%s/secrets/%s:addVersion"
+	createSecretFmt  = "%s/projects/%s/secrets?secretId=%s"
+	listSecretsFmt   = "%s/projects/%s/secrets?pageToken=%s"
+)
+
+// payload is the Secret Manager secret payload, Data is base64 encoded by the JSON encoding of []byte
+type payload struct {
+	Data []byte `json:"data"`
+}
+
+// Client is a SecretClient which stores and retrieves secrets from Google Cloud Secret Manager
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	projectId  string
+	endpoint   string
+	credential *credential
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs a GCP Secret Manager Client. If requester is nil an HTTP client with the Transport
+// settings of the configuration is used.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	if requester == nil {
+		httpClient, err := pkg.NewHTTPClient(config.Transport, nil)
+		if err != nil {
+			return nil, err
+		}
+		requester = httpClient
+	}
+
+	cred := &credential{
+		metadataEndpoint: config.GCP.MetadataEndpoint,
+		httpCaller:       requester,
+		now:              time.Now,
+	}
+
+	credentialsFile := config.GCP.CredentialsFile
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	projectId := config.GCP.ProjectId
+	if credentialsFile != "" {
+		var err error
+		if cred.key, cred.signer, err = loadServiceAccountKey(credentialsFile); err != nil {
+			return nil, err
+		}
+		if projectId == "" {
+			projectId = cred.key.ProjectId
+		}
+	}
+	if projectId == "" {
+		return nil, pkg.NewErrSecretStore("GCP ProjectId is required in config or the service account key")
+	}
+
+	// Host is only needed to override the public endpoint, i.e. for private service connect or local testing
+	endpoint := defaultEndpoint
+	if config.Host != "" {
+		var err error
+		if endpoint, err = config.BuildURL("/v1"); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Client{
+		Config:     config,
+		HttpCaller: requester,
+		lc:         lc,
+		projectId:  projectId,
+		endpoint:   endpoint,
+		credential: cred,
+	}, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+// The version read is the one pinned for the sub-path, otherwise the configured SecretVersion or "latest".
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	secretId := c.secretId(subPath)
+	version := c.secretVersion(subPath)
+
+	var response struct {
+		Payload payload `json:"payload"`
+	}
+	accessURL := fmt.Sprintf(accessVersionFmt, c.endpoint, url.PathEscape(c.projectId), secretId, url.PathEscape(version))
+	status, err := c.doRequest(ctx, http.MethodGet, accessURL, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("No secret exists at the subpath: '%s' with version '%s'", subPath, version),
+			http.StatusNotFound, nil)
+	}
+
+	data := make(map[string]string)
+	if err := json.Unmarshal(response.Payload.Data, &data); err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("secret '%s' is not a JSON object of string values: %s", secretId, err.Error()))
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path as a new version of the Secret Manager secret,
+// creating the secret with automatic replication if it does not yet exist.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	data, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	secretId := c.secretId(subPath)
+	project := url.PathEscape(c.projectId)
+	request := struct {
+		Payload payload `json:"payload"`
+	}{Payload: payload{Data: data}}
+
+	addURL := fmt.Sprintf(addVersionFmt, c.endpoint, project, secretId)
+	status, err := c.doRequest(ctx, http.MethodPost, addURL, request, nil)
+	if err != nil || status != http.StatusNotFound {
+		return err
+	}
+
+	c.lc.Debugf("GCP secret '%s' does not exist, creating it", secretId)
+	createRequest := map[string]interface{}{
+		"replication": map[string]interface{}{"automatic": map[string]interface{}{}},
+	}
+	createURL := fmt.Sprintf(createSecretFmt, c.endpoint, project, url.QueryEscape(secretId))
+	if status, err = c.doRequest(ctx, http.MethodPost, createURL, createRequest, nil); err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to create GCP secret '%s', project '%s' not found", secretId, c.projectId))
+	}
+
+	status, err = c.doRequest(ctx, http.MethodPost, addURL, request, nil)
+	if err == nil && status == http.StatusNotFound {
+		err = pkg.NewErrSecretStore(fmt.Sprintf("unable to add version to GCP secret '%s'", secretId))
+	}
+	return err
+}
+
+// ListSecretPaths lists the secrets under the provided sub-path. Secret Manager secret ids are flat, so the keys are
+// the remainder of each secret id after the sub-path's id and a dash, i.e. "mqtt-broker" rather than "mqtt/".
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	prefix := c.secretId(subPath) + "-"
+
+	keys := []string{}
+	pageToken := ""
+	for {
+		var response struct {
+			Secrets []struct {
+				Name string `json:"name"`
+			} `json:"secrets"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		listURL := fmt.Sprintf(listSecretsFmt, c.endpoint, url.PathEscape(c.projectId), url.QueryEscape(pageToken))
+		status, err := c.doRequest(ctx, http.MethodGet, listURL, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusNotFound {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to list GCP secrets, project '%s' not found", c.projectId))
+		}
+
+		for _, secret := range response.Secrets {
+			// the name is the resource name, i.e. projects/<project>/secrets/<id>
+			id := path.Base(secret.Name)
+			if strings.HasPrefix(id, prefix) && len(id) > len(prefix) {
+				keys = append(keys, strings.TrimPrefix(id, prefix))
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		pageToken = response.NextPageToken
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GenerateConsulToken is not supported by GCP Secret Manager
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the GCP Secret Manager provider")
+}
+
+// secretId maps a sub-path to a Secret Manager secret id. The base Path and sub-path are joined the same way as
+// the Vault provider, then the path segments are joined with dashes since ids may only contain alphanumeric
+// characters, dashes and underscores.
+func (c *Client) secretId(subPath string) string {
+	fullPath := strings.Trim(path.Join(c.Config.Path, subPath), "/")
+
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, fullPath)
+}
+
+// secretVersion returns the version to read for the sub-path
+func (c *Client) secretVersion(subPath string) string {
+	if version, ok := c.Config.GCP.SecretVersions[subPath]; ok && version != "" {
+		return version
+	}
+	if c.Config.GCP.SecretVersion != "" {
+		return c.Config.GCP.SecretVersion
+	}
+	return latestVersion
+}
+
+// doRequest calls the Secret Manager API. A 404 status is returned without error so callers can
+// distinguish a missing secret.
+func (c *Client) doRequest(ctx context.Context, method string, requestURL string, input interface{}, output interface{}) (int, error) {
+	token, err := c.credential.getToken(ctx)
+	if err != nil {
+		c.lc.Errorf("unable to authenticate to GCP Secret Manager: %s", err.Error())
+		return 0, err
+	}
+
+	var body []byte
+	if input != nil {
+		if body, err = json.Marshal(input); err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make request to GCP Secret Manager: %s", err.Error())
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return resp.StatusCode, nil
+	case resp.StatusCode != http.StatusOK:
+		return resp.StatusCode, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from GCP Secret Manager: %s",
+			resp.StatusCode, string(respBody)), resp.StatusCode, []string{string(respBody)})
+	}
+
+	if output != nil {
+		if err := json.Unmarshal(respBody, output); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,355 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package gcp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testAccessToken = "test-access-token"
+	testProject     = "test-project"
+	secretsPrefix   = "/v1/projects/" + testProject + "/secrets"
+)
+
+// mockSecretManager is a stub Secret Manager, OAuth2 token endpoint and metadata server
+type mockSecretManager struct {
+	mutex sync.Mutex
+	// secrets holds the payload of every version of each secret id, the first version is at index 0
+	secrets    map[string][]string
+	publicKey  *rsa.PublicKey
+	tokenCalls int
+}
+
+func (m *mockSecretManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == "/token":
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != jwtBearerGrantType || !m.validAssertion(r.PostForm.Get("assertion")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.tokenCalls++
+		_, _ = w.Write([]byte(`{"access_token":"` + testAccessToken + `","expires_in":3599,"token_type":"Bearer"}`))
+	case r.URL.Path == "/metadata/token":
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		m.tokenCalls++
+		_, _ = w.Write([]byte(`{"access_token":"` + testAccessToken + `","expires_in":3599,"token_type":"Bearer"}`))
+	case strings.HasPrefix(r.URL.Path, secretsPrefix):
+		if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		m.serveSecrets(w, r, strings.TrimPrefix(r.URL.Path, secretsPrefix))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// listSecrets serves the secret list one secret per page, so the client has to follow the nextPageToken
+func (m *mockSecretManager) listSecrets(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	index, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	response := map[string]interface{}{}
+	if index < len(ids) {
+		response["secrets"] = []map[string]string{{"name": "projects/" + testProject + "/secrets/" + ids[index]}}
+		if index+1 < len(ids) {
+			response["nextPageToken"] = strconv.Itoa(index + 1)
+		}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (m *mockSecretManager) serveSecrets(w http.ResponseWriter, r *http.Request, resource string) {
+	var request struct {
+		Payload payload `json:"payload"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&request)
+
+	switch {
+	case r.Method == http.MethodGet && resource == "":
+		m.listSecrets(w, r)
+	case r.Method == http.MethodPost && resource == "":
+		id := r.URL.Query().Get("secretId")
+		m.secrets[id] = []string{}
+		_, _ = w.Write([]byte(`{}`))
+	case r.Method == http.MethodPost && strings.HasSuffix(resource, ":addVersion"):
+		id := strings.TrimSuffix(strings.TrimPrefix(resource, "/"), ":addVersion")
+		if _, ok := m.secrets[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		m.secrets[id] = append(m.secrets[id], string(request.Payload.Data))
+		_, _ = w.Write([]byte(`{}`))
+	case r.Method == http.MethodGet && strings.HasSuffix(resource, ":access"):
+		parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(resource, "/"), ":access"), "/")
+		versions := m.secrets[parts[0]]
+		index := len(versions) - 1
+		if parts[2] != latestVersion {
+			number, _ := strconv.Atoi(parts[2])
+			index = number - 1
+		}
+		if index < 0 || index >= len(versions) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]payload{"payload": {Data: []byte(versions[index])}})
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// validAssertion verifies the RS256 signature of the JWT assertion
+func (m *mockSecretManager) validAssertion(assertion string) bool {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 || m.publicKey == nil {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	return rsa.VerifyPKCS1v15(m.publicKey, crypto.SHA256, digest[:], signature) == nil
+}
+
+// writeServiceAccountKey writes a service account key file using a new RSA key and the token URI of the mock
+func writeServiceAccountKey(t *testing.T, m *mockSecretManager, tokenURI string) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	m.publicKey = &privateKey.PublicKey
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	key, err := json.Marshal(serviceAccountKey{
+		Type:         "service_account",
+		ProjectId:    testProject,
+		PrivateKeyId: "key-id",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail:  "edgex@" + testProject + ".iam.gserviceaccount.com",
+		TokenURI:     tokenURI,
+	})
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, ioutil.WriteFile(keyFile, key, 0600))
+	return keyFile
+}
+
+func createTestClient(t *testing.T, sm *mockSecretManager, serviceAccount bool) (*Client, func()) {
+	ts := httptest.NewServer(sm)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	config := types.SecretConfig{
+		Type:     "gcp",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "edgex/core-data",
+		GCP: types.GCPInfo{
+			ProjectId:        testProject,
+			SecretVersions:   map[string]string{"/pinned": "1"},
+			MetadataEndpoint: ts.URL + "/metadata/token",
+		},
+	}
+	if serviceAccount {
+		config.GCP.ProjectId = ""
+		config.GCP.CredentialsFile = writeServiceAccountKey(t, sm, ts.URL+"/token")
+	}
+
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	invalidKey := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, ioutil.WriteFile(invalidKey, []byte(`{"type":"authorized_user"}`), 0600))
+
+	if value, ok := os.LookupEnv("GOOGLE_APPLICATION_CREDENTIALS"); ok {
+		_ = os.Unsetenv("GOOGLE_APPLICATION_CREDENTIALS")
+		defer func() { _ = os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", value) }()
+	}
+
+	tests := []struct {
+		name   string
+		config types.GCPInfo
+	}{
+		{"Invalid - no project", types.GCPInfo{}},
+		{"Invalid - missing credentials file", types.GCPInfo{ProjectId: testProject, CredentialsFile: "/missing/key.json"}},
+		{"Invalid - not a service account key", types.GCPInfo{ProjectId: testProject, CredentialsFile: invalidKey}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewSecretsClient(types.SecretConfig{GCP: test.config}, nil, logger.NewMockClient())
+			require.Error(t, err)
+		})
+	}
+
+	client, err := NewSecretsClient(types.SecretConfig{GCP: types.GCPInfo{ProjectId: testProject}}, nil, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, defaultEndpoint, client.endpoint)
+}
+
+func TestSecretId(t *testing.T) {
+	client := &Client{Config: types.SecretConfig{Path: "/edgex/core-data/"}}
+
+	assert.Equal(t, "edgex-core-data-redisdb", client.secretId("/redisdb"))
+	assert.Equal(t, "edgex-core-data-my_db-pass", client.secretId("my_db.pass"))
+}
+
+func TestGetSecrets(t *testing.T) {
+	for _, serviceAccount := range []bool{false, true} {
+		t.Run("Service account "+strconv.FormatBool(serviceAccount), func(t *testing.T) {
+			sm := &mockSecretManager{secrets: map[string][]string{
+				"edgex-core-data-redisdb": {`{"username":"redis5","password":"password"}`},
+				"edgex-core-data-pinned":  {`{"password":"v1"}`, `{"password":"v2"}`},
+				"edgex-core-data-invalid": {`not json`},
+			}}
+			client, closer := createTestClient(t, sm, serviceAccount)
+			defer closer()
+
+			tests := []struct {
+				name        string
+				subPath     string
+				keys        []string
+				expected    map[string]string
+				expectError error
+			}{
+				{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+				{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+				{"Get pinned version", "/pinned", nil, map[string]string{"password": "v1"}, nil},
+				{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+				{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+				{"Invalid payload", "/invalid", nil, nil, pkg.ErrSecretStore{}},
+			}
+
+			for _, test := range tests {
+				t.Run(test.name, func(t *testing.T) {
+					actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+					if test.expectError != nil {
+						require.Error(t, err)
+						assert.IsType(t, test.expectError, err)
+						return
+					}
+
+					require.NoError(t, err)
+					assert.Equal(t, test.expected, actual)
+				})
+			}
+
+			// the access token is cached across calls
+			assert.Equal(t, 1, sm.tokenCalls)
+		})
+	}
+}
+
+func TestStoreSecrets(t *testing.T) {
+	sm := &mockSecretManager{secrets: map[string][]string{
+		"edgex-core-data-redisdb": {`{"username":"redis5","password":"password"}`},
+	}}
+	client, closer := createTestClient(t, sm, true)
+	defer closer()
+
+	// add version to existing secret
+	err := client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis6"}, actual)
+	assert.Len(t, sm.secrets["edgex-core-data-redisdb"], 2)
+
+	// create new secret
+	err = client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.NoError(t, err)
+	actual, err = client.GetSecrets(context.Background(), "/mqtt", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	sm := &mockSecretManager{secrets: map[string][]string{
+		"edgex-core-data-redisdb":    {`{"password":"password"}`},
+		"edgex-core-data-mqtt-proxy": {`{"password":"password"}`},
+		"edgex-core-command-redisdb": {`{"password":"password"}`},
+	}}
+	client, closer := createTestClient(t, sm, true)
+	defer closer()
+
+	actual, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt-proxy", "redisdb"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"proxy"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/unknown")
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client, closer := createTestClient(t, &mockSecretManager{}, false)
+	defer closer()
+
+	_, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.Error(t, err)
+}
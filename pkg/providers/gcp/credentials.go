@@ -0,0 +1,224 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package gcp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+const (
+	cloudPlatformScope      = "https://www.googleapis.com/auth/cloud-platform"
+	defaultTokenURI         = "https://oauth2.googleapis.com/token"
+	defaultMetadataEndpoint = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	jwtBearerGrantType      = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+	// assertionLifetime is the maximum lifetime Google accepts for a self-signed JWT assertion
+	assertionLifetime = time.Hour
+	// tokens are refreshed this long before they actually expire
+	tokenExpiryMargin = 2 * time.Minute
+)
+
+// serviceAccountKey contains the fields used from a service account JSON key file
+type serviceAccountKey struct {
+	Type         string `json:"type"`
+	ProjectId    string `json:"project_id"`
+	PrivateKeyId string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// credential obtains and caches the OAuth2 access token used to call Secret Manager, either by exchanging a
+// JWT signed with the service account key or from the metadata server for workload identity.
+type credential struct {
+	key              *serviceAccountKey
+	signer           *rsa.PrivateKey
+	metadataEndpoint string
+	httpCaller       pkg.Caller
+	mutex            sync.Mutex
+	token            string
+	expiry           time.Time
+	// now abstracts the clock used for the JWT assertion, which is most useful for testing
+	now func() time.Time
+}
+
+// loadServiceAccountKey reads and parses the service account JSON key file
+func loadServiceAccountKey(filePath string) (*serviceAccountKey, *rsa.PrivateKey, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read GCP credentials file: %s", err.Error()))
+	}
+
+	var key serviceAccountKey
+	if err := json.Unmarshal(contents, &key); err != nil {
+		return nil, nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse GCP credentials file: %s", err.Error()))
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" {
+		return nil, nil, pkg.NewErrSecretStore("GCP credentials file is not a service account key")
+	}
+	if key.TokenURI == "" {
+		key.TokenURI = defaultTokenURI
+	}
+
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return nil, nil, pkg.NewErrSecretStore("GCP credentials file does not contain a PEM encoded private key")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		if parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return nil, nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to parse GCP service account private key: %s", err.Error()))
+		}
+	}
+
+	signer, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, pkg.NewErrSecretStore("GCP service account private key is not an RSA key")
+	}
+
+	return &key, signer, nil
+}
+
+// getToken returns a cached access token, requesting a new one when it is about to expire
+func (c *credential) getToken(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token != "" && c.now().Before(c.expiry) {
+		return c.token, nil
+	}
+
+	var req *http.Request
+	var err error
+	if c.key != nil {
+		req, err = c.serviceAccountRequest(ctx)
+	} else {
+		req, err = c.metadataRequest(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpCaller.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", pkg.NewErrSecretStore(fmt.Sprintf("failed to obtain GCP access token, status %d: %s", resp.StatusCode, string(body)))
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", err
+	}
+
+	c.token = token.AccessToken
+	c.expiry = c.now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpiryMargin)
+
+	return c.token, nil
+}
+
+// serviceAccountRequest builds the JWT bearer grant request exchanging a signed assertion for an access token
+func (c *credential) serviceAccountRequest(ctx context.Context) (*http.Request, error) {
+	assertion, err := c.signAssertion()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", jwtBearerGrantType)
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.key.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
+
+// signAssertion creates the RS256 signed JWT asserting the service account identity
+func (c *credential) signAssertion() (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": c.key.PrivateKeyId})
+	if err != nil {
+		return "", err
+	}
+
+	now := c.now()
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   c.key.ClientEmail,
+		"scope": cloudPlatformScope,
+		"aud":   c.key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(assertionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.signer, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// metadataRequest builds the token request to the metadata server for the workload identity
+func (c *credential) metadataRequest(ctx context.Context) (*http.Request, error) {
+	endpoint := c.metadataEndpoint
+	if endpoint == "" {
+		endpoint = defaultMetadataEndpoint
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	return req, nil
+}
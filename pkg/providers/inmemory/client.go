@@ -0,0 +1,240 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package inmemory provides a thread-safe SecretClient which keeps the secrets in memory.
+// It is intended for unit testing services without mocking HTTP or running a real secret store, and
+// simulates the expiry of the auth token so the caller's TokenExpiredCallback handling can be exercised.
+package inmemory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// Client is a SecretClient which keeps the secrets in memory
+type Client struct {
+	config   types.SecretConfig
+	callback pkg.TokenExpiredCallback
+	// secrets holds the "key": "value" secrets by their full path
+	secrets map[string]map[string]string
+	// token is the current simulated auth token, which never expires when tokenTTL is zero
+	token       string
+	tokenTTL    time.Duration
+	tokenExpiry time.Time
+	// now abstracts the clock used for the token expiry, which is most useful for testing
+	now   func() time.Time
+	mutex sync.Mutex
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs an in-memory Client using the AuthToken from config as its initial token.
+// callback is invoked when the simulated token has expired and can be nil.
+func NewSecretsClient(config types.SecretConfig, callback pkg.TokenExpiredCallback) *Client {
+	return &Client{
+		config:   config,
+		callback: callback,
+		secrets:  make(map[string]map[string]string),
+		token:    config.Authentication.AuthToken,
+		now:      time.Now,
+	}
+}
+
+// SetTokenTTL sets the time-to-live of the current token and any replacement token obtained from the callback.
+// A zero ttl means the token never expires.
+func (c *Client) SetTokenTTL(ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.tokenTTL = ttl
+	c.tokenExpiry = c.now().Add(ttl)
+}
+
+// ExpireToken immediately expires the current token, so the next call fires the TokenExpiredCallback
+func (c *Client) ExpireToken() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.tokenTTL == 0 {
+		c.tokenTTL = time.Hour
+	}
+	c.tokenExpiry = c.now()
+}
+
+// Token returns the current simulated auth token
+func (c *Client) Token() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.token
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	if err := c.checkRequest(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stored, ok := c.secrets[c.fullPath(subPath)]
+	if !ok {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	if len(stored) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	// return a copy so the caller can't modify the stored secrets
+	data := make(map[string]string, len(stored))
+	for k, v := range stored {
+		data[k] = v
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path, replacing any existing secrets at the sub-path.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.checkRequest(ctx); err != nil {
+		return err
+	}
+
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stored := make(map[string]string, len(secrets))
+	for k, v := range secrets {
+		if strings.TrimSpace(k) == "" {
+			return pkg.NewErrSecretStore("cannot store secrets with empty key")
+		}
+		stored[k] = v
+	}
+	c.secrets[c.fullPath(subPath)] = stored
+
+	return nil
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	if err := c.checkRequest(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	paths := make([]string, 0, len(c.secrets))
+	for fullPath := range c.secrets {
+		paths = append(paths, fullPath)
+	}
+
+	return pkg.ChildKeys(c.fullPath(subPath), paths), nil
+}
+
+// GenerateConsulToken generates a new random token for serviceKey
+func (c *Client) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	if strings.TrimSpace(serviceKey) == "" {
+		return "", pkg.NewErrSecretStore("serviceKey cannot be empty for generating Consul token")
+	}
+
+	if err := c.checkRequest(ctx); err != nil {
+		return "", err
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(random), nil
+}
+
+// checkRequest simulates the failures of a remote store: a cancelled context or an expired token.
+// An expired token fires the callback which may provide a replacement token. Must be called without the mutex
+// held, as the callback runs unlocked so that it is able to call the client.
+func (c *Client) checkRequest(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	expired := c.tokenTTL != 0 && !c.now().Before(c.tokenExpiry)
+	expiredToken := c.token
+	c.mutex.Unlock()
+
+	if !expired {
+		return nil
+	}
+
+	if c.callback == nil {
+		return errTokenExpired()
+	}
+
+	replacementToken, retry := c.callback(expiredToken)
+	if !retry {
+		return errTokenExpired()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// a concurrent request may have replaced the token already
+	if c.token == expiredToken {
+		c.token = replacementToken
+		c.tokenExpiry = c.now().Add(c.tokenTTL)
+	}
+
+	return nil
+}
+
+func (c *Client) fullPath(subPath string) string {
+	return path.Join("/", c.config.Path, subPath)
+}
+
+// errTokenExpired is the error of a remote store rejecting an expired token, which matches pkg.ErrTokenExpired
+func errTokenExpired() error {
+	return pkg.NewErrSecretStoreFromResponse("auth token has expired", http.StatusForbidden, []string{"token expired"})
+}
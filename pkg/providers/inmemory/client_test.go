@@ -0,0 +1,218 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+func TestStoreAndGetSecrets(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{Path: "/edgex/"}, nil)
+	ctx := context.Background()
+
+	err := client.StoreSecrets(ctx, "/redisdb", map[string]string{"username": "redis5", "password": "password"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		subPath     string
+		keys        []string
+		expected    map[string]string
+		expectError error
+	}{
+		{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get all keys without leading slash", "redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+		{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+		{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.GetSecrets(ctx, test.subPath, test.keys...)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.IsType(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	_, err = client.GetSecrets(ctx, "/unknown")
+	assert.True(t, errors.Is(err, pkg.ErrSecretNotFound{}))
+
+	err = client.StoreSecrets(ctx, "/redisdb", map[string]string{"": "empty"})
+	require.Error(t, err)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{Path: "/edgex/"}, nil)
+	ctx := context.Background()
+
+	require.NoError(t, client.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "password"}))
+	require.NoError(t, client.StoreSecrets(ctx, "/mqtt/broker", map[string]string{"password": "mqtt"}))
+	require.NoError(t, client.StoreSecrets(ctx, "mqtt/client", map[string]string{"password": "mqtt"}))
+
+	tests := []struct {
+		name     string
+		subPath  string
+		expected []string
+	}{
+		{"Base path", "", []string{"mqtt/", "redisdb"}},
+		{"Nested path", "/mqtt", []string{"broker", "client"}},
+		{"Nested path with trailing slash", "mqtt/", []string{"broker", "client"}},
+		{"Secret path", "/redisdb", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.ListSecretPaths(ctx, test.subPath)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSecretUpdatedCallback(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{Path: "/edgex/"}, nil)
+	ctx := context.Background()
+
+	var updated []string
+	client.RegisterSecretUpdatedCallback("/redisdb", func(subPath string) {
+		// the callback may read the stored secrets back through the client
+		_, err := client.GetSecrets(ctx, subPath)
+		assert.NoError(t, err)
+		updated = append(updated, subPath)
+	})
+
+	require.NoError(t, client.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "password"}))
+	require.NoError(t, client.StoreSecrets(ctx, "/mqtt", map[string]string{"password": "mqtt"}))
+	require.NoError(t, client.StoreSecrets(ctx, "/redisdb", nil))
+	require.Error(t, client.StoreSecrets(ctx, "/redisdb", map[string]string{"": "empty"}))
+
+	assert.Equal(t, []string{"/redisdb"}, updated)
+}
+
+func TestTokenExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	callbackCount := 0
+	callback := func(expiredToken string) (string, bool) {
+		callbackCount++
+		return fmt.Sprintf("%s-%d", expiredToken, callbackCount), callbackCount < 2
+	}
+
+	client := NewSecretsClient(types.SecretConfig{Authentication: types.AuthenticationInfo{AuthToken: "token"}}, callback)
+	client.now = func() time.Time { return now }
+	client.SetTokenTTL(time.Minute)
+
+	require.NoError(t, client.StoreSecrets(ctx, "/mqtt", map[string]string{"password": "mqtt"}))
+	assert.Equal(t, 0, callbackCount)
+
+	// first expiry is replaced by the callback
+	now = now.Add(2 * time.Minute)
+	_, err := client.GetSecrets(ctx, "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, 1, callbackCount)
+	assert.Equal(t, "token-1", client.Token())
+
+	// second expiry is not retried by the callback
+	client.ExpireToken()
+	_, err = client.GetSecrets(ctx, "/mqtt")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pkg.ErrTokenExpired{}))
+	assert.Equal(t, 2, callbackCount)
+}
+
+func TestTokenExpiryCallbackCallsClient(t *testing.T) {
+	var client *Client
+	// the callback runs without the mutex held, so it is able to call the client
+	callback := func(expiredToken string) (string, bool) {
+		assert.Equal(t, expiredToken, client.Token())
+		return expiredToken + "-renewed", true
+	}
+	client = NewSecretsClient(types.SecretConfig{Authentication: types.AuthenticationInfo{AuthToken: "token"}}, callback)
+	client.ExpireToken()
+
+	done := make(chan error)
+	go func() {
+		_, err := client.ListSecretPaths(context.Background(), "/")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the callback deadlocked on the client")
+	}
+	assert.Equal(t, "token-renewed", client.Token())
+}
+
+func TestCancelledContext(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetSecrets(ctx, "/mqtt")
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{}, nil)
+
+	first, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.NoError(t, err)
+	second, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	_, err = client.GenerateConsulToken(context.Background(), " ")
+	require.Error(t, err)
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	client := NewSecretsClient(types.SecretConfig{}, nil)
+	ctx := context.Background()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			subPath := fmt.Sprintf("/service-%d", i%5)
+			assert.NoError(t, client.StoreSecrets(ctx, subPath, map[string]string{"index": fmt.Sprint(i)}))
+			_, err := client.GetSecrets(ctx, subPath, "index")
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+}
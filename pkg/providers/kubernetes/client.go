@@ -0,0 +1,367 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package kubernetes provides a SecretClient backed by native Kubernetes Secrets, either through the
+// Kubernetes API or from secrets mounted as volumes into the pod.
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	serviceAccountDir     = "/var/run/secrets/kubernetes.io/serviceaccount"
+	defaultTokenFile      = serviceAccountDir + "/token"
+	defaultCaCertFile     = serviceAccountDir + "/ca.crt"
+	defaultNamespaceFile  = serviceAccountDir + "/namespace"
+	secretsAPIFmt         = "/api/v1/namespaces/%s/secrets"
+	kubernetesServiceHost = "KUBERNETES_SERVICE_HOST"
+	kubernetesServicePort = "KUBERNETES_SERVICE_PORT"
+)
+
+// secret is the subset of the Kubernetes Secret resource used by the Client
+type secret struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   secretMetadata    `json:"metadata"`
+	Type       string            `json:"type,omitempty"`
+	Data       map[string]string `json:"data"`
+}
+
+type secretMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// Client is a SecretClient which stores and retrieves native Kubernetes Secrets
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	namespace  string
+	tokenFile  string
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs a Kubernetes Secrets Client. Unset configuration defaults to the in-cluster settings
+// of the pod's service account. If requester is nil an HTTP client trusting the configured or in-cluster CA is used.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	client := &Client{
+		Config:    config,
+		lc:        lc,
+		namespace: config.Kubernetes.Namespace,
+		tokenFile: config.Kubernetes.TokenFile,
+	}
+
+	if client.namespace == "" {
+		namespace, err := ioutil.ReadFile(defaultNamespaceFile)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("Kubernetes Namespace not configured and unable to read %s: %s",
+				defaultNamespaceFile, err.Error()))
+		}
+		client.namespace = strings.TrimSpace(string(namespace))
+	}
+
+	// secrets read from mounted volumes don't need the API
+	if config.Kubernetes.MountPath != "" {
+		return client, nil
+	}
+
+	if client.tokenFile == "" {
+		client.tokenFile = defaultTokenFile
+	}
+
+	if client.Config.Host == "" {
+		client.Config.Protocol = "https"
+		client.Config.Host = os.Getenv(kubernetesServiceHost)
+		client.Config.Port, _ = strconv.Atoi(os.Getenv(kubernetesServicePort))
+		if client.Config.Host == "" {
+			return nil, pkg.NewErrSecretStore("Kubernetes API Host not configured and not running in-cluster")
+		}
+	}
+
+	if requester == nil {
+		caCertPath := config.RootCaCertPath
+		if caCertPath == "" {
+			caCertPath = defaultCaCertFile
+		}
+		caReader, err := os.Open(caCertPath)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to open Kubernetes CA certificate %s: %s", caCertPath, err.Error()))
+		}
+		if requester = pkg.NewRequester(lc).WithTLS(caReader, config.ServerName); requester == nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to load Kubernetes CA certificate %s", caCertPath))
+		}
+	}
+	client.HttpCaller = requester
+
+	return client, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	var data map[string]string
+	var err error
+	if c.Config.Kubernetes.MountPath != "" {
+		data, err = c.readMounted(subPath)
+	} else {
+		data, err = c.readSecret(ctx, subPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path, replacing the data of the Kubernetes Secret
+// or creating it if it does not exist yet.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	if c.Config.Kubernetes.MountPath != "" {
+		return pkg.NewErrSecretStore("secrets cannot be stored when reading Kubernetes Secrets from mounted volumes")
+	}
+
+	name := c.secretName(subPath)
+	resource := secret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   secretMetadata{Name: name, Namespace: c.namespace},
+		Type:       "Opaque",
+		Data:       make(map[string]string, len(secrets)),
+	}
+	for k, v := range secrets {
+		resource.Data[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+
+	secretsAPI := fmt.Sprintf(secretsAPIFmt, c.namespace)
+	status, err := c.doRequest(ctx, http.MethodPut, secretsAPI+"/"+name, resource, nil)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		c.lc.Debugf("Kubernetes Secret '%s' does not exist, creating it", name)
+		status, err = c.doRequest(ctx, http.MethodPost, secretsAPI, resource, nil)
+		if err == nil && status != http.StatusCreated {
+			err = pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response creating Kubernetes Secret '%s'", status, name),
+				status, nil)
+		}
+	}
+
+	return err
+}
+
+// ListSecretPaths lists the secrets under the provided sub-path. Kubernetes Secret names are flat, so the keys are
+// the remainder of each Secret name after the sub-path's name and a dash, i.e. "mqtt-broker" rather than "mqtt/".
+// When reading mounted volumes the Secrets are the directories under the mount path.
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	var names []string
+	if c.Config.Kubernetes.MountPath != "" {
+		files, err := ioutil.ReadDir(c.Config.Kubernetes.MountPath)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to list the Kubernetes Secrets mount path: %s", err.Error()))
+		}
+		for _, file := range files {
+			if file.IsDir() && !strings.HasPrefix(file.Name(), "..") {
+				names = append(names, file.Name())
+			}
+		}
+	} else {
+		var resources struct {
+			Items []secret `json:"items"`
+		}
+		status, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf(secretsAPIFmt, c.namespace), nil, &resources)
+		if err != nil {
+			return nil, err
+		}
+		if status == http.StatusNotFound {
+			return []string{}, nil
+		}
+		for _, resource := range resources.Items {
+			names = append(names, resource.Metadata.Name)
+		}
+	}
+
+	prefix := c.secretName(subPath) + "-"
+	keys := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			keys = append(keys, strings.TrimPrefix(name, prefix))
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// GenerateConsulToken is not supported by Kubernetes Secrets
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the Kubernetes provider")
+}
+
+// secretName maps a sub-path to a valid Kubernetes resource name by lower-casing it, joining the path segments
+// with dashes and replacing any character not allowed in a DNS-1123 subdomain.
+func (c *Client) secretName(subPath string) string {
+	fullPath := strings.Trim(path.Join(c.Config.Path, subPath), "/")
+
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '.' {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return '-'
+	}, fullPath)
+}
+
+func (c *Client) readSecret(ctx context.Context, subPath string) (map[string]string, error) {
+	var resource secret
+	status, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf(secretsAPIFmt, c.namespace)+"/"+c.secretName(subPath), nil, &resource)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+
+	data := make(map[string]string, len(resource.Data))
+	for k, v := range resource.Data {
+		value, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("invalid data for key '%s' at the subpath '%s': %s", k, subPath, err.Error()))
+		}
+		data[k] = string(value)
+	}
+
+	return data, nil
+}
+
+// readMounted reads the secret from its mounted volume, where every key is a file in the secret's directory
+func (c *Client) readMounted(subPath string) (map[string]string, error) {
+	dir := filepath.Join(c.Config.Kubernetes.MountPath, c.secretName(subPath))
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+	if err != nil {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read the secret at the subpath: '%s': %s", subPath, err.Error()))
+	}
+
+	data := make(map[string]string)
+	for _, file := range files {
+		// skip the "..data" style entries Kubernetes uses for atomic updates of the volume
+		if file.IsDir() || strings.HasPrefix(file.Name(), "..") {
+			continue
+		}
+		value, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data[file.Name()] = string(value)
+	}
+
+	return data, nil
+}
+
+// doRequest calls the Kubernetes API. A 404 status is returned without error so callers can handle missing secrets.
+func (c *Client) doRequest(ctx context.Context, method string, apiPath string, input interface{}, output interface{}) (int, error) {
+	token, err := ioutil.ReadFile(c.tokenFile)
+	if err != nil {
+		return 0, pkg.NewErrSecretStore(fmt.Sprintf("unable to read Kubernetes service account token: %s", err.Error()))
+	}
+
+	var body []byte
+	if input != nil {
+		if body, err = json.Marshal(input); err != nil {
+			return 0, err
+		}
+	}
+
+	targetURL, err := c.Config.BuildURL(apiPath)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make request to the Kubernetes API: %s", err.Error())
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from the Kubernetes API", resp.StatusCode),
+			resp.StatusCode, nil)
+	}
+
+	if output != nil {
+		if err := json.NewDecoder(resp.Body).Decode(output); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,251 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testNamespace = "edgex"
+	testToken     = "service-account-token"
+)
+
+// mockKubernetesAPI is a stub Kubernetes API server keeping Secrets in memory
+type mockKubernetesAPI struct {
+	mutex   sync.Mutex
+	secrets map[string]secret
+}
+
+func (m *mockKubernetesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefix := "/api/v1/namespaces/" + testNamespace + "/secrets"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+	switch r.Method {
+	case http.MethodGet:
+		if name == "" {
+			list := struct {
+				Items []secret `json:"items"`
+			}{}
+			for _, resource := range m.secrets {
+				list.Items = append(list.Items, resource)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+			return
+		}
+		resource, ok := m.secrets[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resource)
+	case http.MethodPut:
+		if _, ok := m.secrets[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var resource secret
+		_ = json.NewDecoder(r.Body).Decode(&resource)
+		m.secrets[name] = resource
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPost:
+		var resource secret
+		_ = json.NewDecoder(r.Body).Decode(&resource)
+		m.secrets[resource.Metadata.Name] = resource
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func createTestClient(t *testing.T, api *mockKubernetesAPI) (*Client, func()) {
+	ts := httptest.NewServer(api)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(testToken+"\n"), 0400))
+
+	config := types.SecretConfig{
+		Type:     "kubernetes",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "edgex",
+		Kubernetes: types.KubernetesInfo{
+			Namespace: testNamespace,
+			TokenFile: tokenFile,
+		},
+	}
+
+	client, err := NewSecretsClient(config, http.DefaultClient, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestSecretName(t *testing.T) {
+	client := Client{Config: types.SecretConfig{Path: "/EdgeX/"}}
+	assert.Equal(t, "edgex-core-data-redis-db", client.secretName("/core-data/redis_db"))
+}
+
+func TestGetSecrets(t *testing.T) {
+	api := &mockKubernetesAPI{secrets: map[string]secret{
+		"edgex-redisdb": {Data: map[string]string{"username": "cmVkaXM1", "password": "cGFzc3dvcmQ="}},
+		"edgex-invalid": {Data: map[string]string{"username": "not base64!"}},
+	}}
+	client, closer := createTestClient(t, api)
+	defer closer()
+
+	tests := []struct {
+		name        string
+		subPath     string
+		keys        []string
+		expected    map[string]string
+		expectError error
+	}{
+		{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+		{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+		{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+		{"Invalid data", "/invalid", nil, nil, pkg.ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.IsType(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestStoreSecrets(t *testing.T) {
+	api := &mockKubernetesAPI{secrets: map[string]secret{
+		"edgex-redisdb": {Data: map[string]string{"username": "cmVkaXM1"}},
+	}}
+	client, closer := createTestClient(t, api)
+	defer closer()
+
+	// update existing secret
+	err := client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis6"}, actual)
+
+	// create new secret
+	err = client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.NoError(t, err)
+	assert.Equal(t, testNamespace, api.secrets["edgex-mqtt"].Metadata.Namespace)
+	actual, err = client.GetSecrets(context.Background(), "/mqtt", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	api := &mockKubernetesAPI{secrets: map[string]secret{
+		"edgex-redisdb":      {Metadata: secretMetadata{Name: "edgex-redisdb"}},
+		"edgex-mqtt-broker":  {Metadata: secretMetadata{Name: "edgex-mqtt-broker"}},
+		"edgex-mqtt-client":  {Metadata: secretMetadata{Name: "edgex-mqtt-client"}},
+		"other-mqtt-proxy":   {Metadata: secretMetadata{Name: "other-mqtt-proxy"}},
+		"default-token-abcd": {Metadata: secretMetadata{Name: "default-token-abcd"}},
+	}}
+	client, closer := createTestClient(t, api)
+	defer closer()
+
+	actual, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt-broker", "mqtt-client", "redisdb"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"broker", "client"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/unknown")
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestMountedSecrets(t *testing.T) {
+	mountPath := t.TempDir()
+	secretDir := filepath.Join(mountPath, "edgex-redisdb")
+	require.NoError(t, os.MkdirAll(filepath.Join(secretDir, "..2021_01_01"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(secretDir, "username"), []byte("redis5"), 0400))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(secretDir, "..data"), []byte("ignored"), 0400))
+
+	config := types.SecretConfig{
+		Type: "kubernetes",
+		Path: "edgex",
+		Kubernetes: types.KubernetesInfo{
+			Namespace: testNamespace,
+			MountPath: mountPath,
+		},
+	}
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis5"}, actual)
+
+	_, err = client.GetSecrets(context.Background(), "/unknown")
+	require.Error(t, err)
+
+	err = client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.Error(t, err)
+
+	keys, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"redisdb"}, keys)
+}
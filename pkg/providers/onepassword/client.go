@@ -0,0 +1,348 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package onepassword provides a SecretClient backed by a 1Password Connect server.
+// Each secret path maps to an item in the configured vault whose title is the path, and each
+// "key": "value" pair maps to a field of the item whose label is the key.
+package onepassword
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	vaultsAPI   = "/v1/vaults"
+	itemsAPIFmt = "/v1/vaults/%s/items"
+	itemAPIFmt  = "/v1/vaults/%s/items/%s"
+
+	defaultCategory  = "API_CREDENTIAL"
+	concealedField   = "CONCEALED"
+	notesPurpose     = "NOTES"
+	connectTokenEnv  = "OP_CONNECT_TOKEN"
+	filterEqualsFmt  = "%s eq \"%s\""
+	filterTitleField = "title"
+	filterNameField  = "name"
+)
+
+// item is the subset of the 1Password item resource used by the Client
+type item struct {
+	Id       string   `json:"id,omitempty"`
+	Title    string   `json:"title"`
+	Category string   `json:"category"`
+	Vault    vaultRef `json:"vault"`
+	Fields   []field  `json:"fields"`
+}
+
+type vaultRef struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type field struct {
+	Id      string `json:"id,omitempty"`
+	Label   string `json:"label"`
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// Client is a SecretClient which stores and retrieves secrets from a 1Password Connect server
+type Client struct {
+	Config     types.SecretConfig
+	HttpCaller pkg.Caller
+	lc         logger.LoggingClient
+	token      string
+	// vaultId caches the id of the configured vault once resolved from its name
+	vaultId string
+	mutex   sync.Mutex
+	// updateCallbacks are notified after secrets are stored
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewSecretsClient constructs a 1Password Connect Client. If requester is nil an HTTP client with the Transport
+// settings of the configuration is used.
+func NewSecretsClient(config types.SecretConfig, requester pkg.Caller, lc logger.LoggingClient) (*Client, error) {
+	if config.OnePassword.Vault == "" {
+		return nil, pkg.NewErrSecretStore("1Password Vault is required in config")
+	}
+
+	token := config.OnePassword.Token
+	if token == "" && config.OnePassword.TokenFile != "" {
+		contents, err := ioutil.ReadFile(config.OnePassword.TokenFile)
+		if err != nil {
+			return nil, pkg.NewErrSecretStore(fmt.Sprintf("unable to read 1Password TokenFile: %s", err.Error()))
+		}
+		token = strings.TrimSpace(string(contents))
+	}
+	if token == "" {
+		token = os.Getenv(connectTokenEnv)
+	}
+	if token == "" {
+		return nil, pkg.NewErrSecretStore("1Password Connect token is required in config or OP_CONNECT_TOKEN environment variable")
+	}
+
+	if _, err := config.BuildURL(""); err != nil {
+		return nil, err
+	}
+
+	if requester == nil {
+		httpClient, err := pkg.NewHTTPClient(config.Transport, nil)
+		if err != nil {
+			return nil, err
+		}
+		requester = httpClient
+	}
+
+	return &Client{
+		Config:     config,
+		HttpCaller: requester,
+		lc:         lc,
+		token:      token,
+	}, nil
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys.
+func (c *Client) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	found, err := c.findItem(ctx, subPath)
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, pkg.NewErrSecretStoreNotFound(subPath)
+	}
+
+	data := make(map[string]string)
+	for _, f := range found.Fields {
+		if f.Label == "" || f.Purpose == notesPurpose {
+			continue
+		}
+		data[f.Label] = f.Value
+	}
+
+	if len(data) == 0 {
+		return nil, pkg.NewErrSecretStore(fmt.Sprintf("No secretKeyValues are present at the subpath: '%s'", subPath))
+	}
+
+	return pkg.FilterSecrets(data, keys...)
+}
+
+// StoreSecrets stores the secrets at the provided sub-path as concealed fields, replacing the fields of an
+// existing item or creating a new item.
+func (c *Client) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if err := c.storeSecrets(ctx, subPath, secrets); err != nil || len(secrets) == 0 {
+		return err
+	}
+
+	c.updateCallbacks.Notify(subPath)
+	return nil
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after secrets are stored at the sub-path
+func (c *Client) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// storeSecrets stores the secrets without notifying the callbacks
+func (c *Client) storeSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		// nothing to store
+		return nil
+	}
+
+	existing, err := c.findItem(ctx, subPath)
+	if err != nil {
+		return err
+	}
+
+	vaultId, err := c.resolveVaultId(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]field, 0, len(secrets))
+	for key, value := range secrets {
+		fields = append(fields, field{Label: key, Value: value, Type: concealedField})
+	}
+
+	if existing != nil {
+		existing.Fields = fields
+		_, err = c.doRequest(ctx, http.MethodPut, fmt.Sprintf(itemAPIFmt, url.PathEscape(vaultId), url.PathEscape(existing.Id)),
+			existing, nil)
+		return err
+	}
+
+	category := c.Config.OnePassword.Category
+	if category == "" {
+		category = defaultCategory
+	}
+	newItem := item{
+		Title:    c.itemTitle(subPath),
+		Category: category,
+		Vault:    vaultRef{Id: vaultId},
+		Fields:   fields,
+	}
+	_, err = c.doRequest(ctx, http.MethodPost, fmt.Sprintf(itemsAPIFmt, url.PathEscape(vaultId)), newItem, nil)
+	return err
+}
+
+// ListSecretPaths lists the keys directly under the provided sub-path from the titles of the items in the vault
+func (c *Client) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	vaultId, err := c.resolveVaultId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []item
+	if _, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf(itemsAPIFmt, url.PathEscape(vaultId)), nil, &summaries); err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		titles = append(titles, summary.Title)
+	}
+
+	return pkg.ChildKeys(c.itemTitle(subPath), titles), nil
+}
+
+// GenerateConsulToken is not supported by 1Password Connect
+func (c *Client) GenerateConsulToken(_ context.Context, _ string) (string, error) {
+	return "", pkg.NewErrSecretStore("generating Consul tokens is not supported by the 1Password Connect provider")
+}
+
+// itemTitle maps a sub-path to the title of the 1Password item
+func (c *Client) itemTitle(subPath string) string {
+	return strings.Trim(path.Join(c.Config.Path, subPath), "/")
+}
+
+// findItem returns the full item for the sub-path, or nil when no item has the title
+func (c *Client) findItem(ctx context.Context, subPath string) (*item, error) {
+	vaultId, err := c.resolveVaultId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []item
+	itemsPath := fmt.Sprintf(itemsAPIFmt, url.PathEscape(vaultId)) + "?filter=" +
+		url.QueryEscape(fmt.Sprintf(filterEqualsFmt, filterTitleField, c.itemTitle(subPath)))
+	if _, err := c.doRequest(ctx, http.MethodGet, itemsPath, nil, &summaries); err != nil {
+		return nil, err
+	}
+	if len(summaries) == 0 {
+		return nil, nil
+	}
+	if len(summaries) > 1 {
+		c.lc.Warnf("multiple 1Password items titled '%s', using the first", c.itemTitle(subPath))
+	}
+
+	var full item
+	status, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf(itemAPIFmt, url.PathEscape(vaultId), url.PathEscape(summaries[0].Id)),
+		nil, &full)
+	if err != nil || status == http.StatusNotFound {
+		return nil, err
+	}
+
+	return &full, nil
+}
+
+// resolveVaultId returns the id of the configured vault, looking it up by name on first use.
+// A Vault which doesn't match any vault name is used as the vault id.
+func (c *Client) resolveVaultId(ctx context.Context) (string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.vaultId != "" {
+		return c.vaultId, nil
+	}
+
+	var vaults []vaultRef
+	vaultsPath := vaultsAPI + "?filter=" + url.QueryEscape(fmt.Sprintf(filterEqualsFmt, filterNameField, c.Config.OnePassword.Vault))
+	if _, err := c.doRequest(ctx, http.MethodGet, vaultsPath, nil, &vaults); err != nil {
+		return "", err
+	}
+
+	c.vaultId = c.Config.OnePassword.Vault
+	if len(vaults) > 0 {
+		c.vaultId = vaults[0].Id
+	}
+
+	return c.vaultId, nil
+}
+
+// doRequest calls the Connect API. A 404 status is returned without error so callers can
+// distinguish a missing item.
+func (c *Client) doRequest(ctx context.Context, method string, apiPath string, input interface{}, output interface{}) (int, error) {
+	requestURL, err := c.Config.BuildURL(apiPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var body []byte
+	if input != nil {
+		if body, err = json.Marshal(input); err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HttpCaller.Do(req)
+	if err != nil {
+		c.lc.Errorf("unable to make request to 1Password Connect: %s", err.Error())
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return resp.StatusCode, nil
+	default:
+		return resp.StatusCode, pkg.NewErrSecretStoreFromResponse(fmt.Sprintf("Received a '%d' response from 1Password Connect: %s",
+			resp.StatusCode, string(respBody)), resp.StatusCode, []string{string(respBody)})
+	}
+
+	if output != nil {
+		if err := json.Unmarshal(respBody, output); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+
+	return resp.StatusCode, nil
+}
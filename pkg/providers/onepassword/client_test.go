@@ -0,0 +1,261 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package onepassword
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	testToken   = "test-connect-token"
+	testVaultId = "vaultid0123456789abcdefghi"
+)
+
+// mockConnect is a stub 1Password Connect server with a single vault named "edgex"
+type mockConnect struct {
+	mutex  sync.Mutex
+	items  map[string]*item
+	nextId int
+}
+
+func (m *mockConnect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON := func(v interface{}) { _ = json.NewEncoder(w).Encode(v) }
+	filterValue := func(field string) string {
+		return strings.TrimSuffix(strings.TrimPrefix(r.URL.Query().Get("filter"), field+` eq "`), `"`)
+	}
+	itemsPrefix := fmt.Sprintf(itemsAPIFmt, testVaultId)
+
+	switch {
+	case r.URL.Path == vaultsAPI:
+		vaults := []vaultRef{}
+		if filterValue(filterNameField) == "edgex" {
+			vaults = append(vaults, vaultRef{Id: testVaultId, Name: "edgex"})
+		}
+		writeJSON(vaults)
+	case r.URL.Path == itemsPrefix && r.Method == http.MethodGet:
+		summaries := []item{}
+		for _, i := range m.items {
+			if r.URL.Query().Get("filter") == "" || i.Title == filterValue(filterTitleField) {
+				summaries = append(summaries, item{Id: i.Id, Title: i.Title})
+			}
+		}
+		writeJSON(summaries)
+	case r.URL.Path == itemsPrefix && r.Method == http.MethodPost:
+		var created item
+		_ = json.NewDecoder(r.Body).Decode(&created)
+		m.nextId++
+		created.Id = "item" + strconv.Itoa(m.nextId)
+		m.items[created.Id] = &created
+		writeJSON(created)
+	case strings.HasPrefix(r.URL.Path, itemsPrefix+"/"):
+		id := strings.TrimPrefix(r.URL.Path, itemsPrefix+"/")
+		existing, ok := m.items[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			var updated item
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			existing.Fields = updated.Fields
+		}
+		writeJSON(existing)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func createTestClient(t *testing.T, connect *mockConnect) (*Client, func()) {
+	ts := httptest.NewServer(connect)
+
+	serverURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	config := types.SecretConfig{
+		Type:     "onepassword",
+		Protocol: "http",
+		Host:     serverURL.Hostname(),
+		Port:     port,
+		Path:     "edgex/core-data",
+		OnePassword: types.OnePasswordInfo{
+			Vault: "edgex",
+			Token: testToken,
+		},
+	}
+
+	client, err := NewSecretsClient(config, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	return client, ts.Close
+}
+
+func TestNewSecretsClient(t *testing.T) {
+	if value, ok := os.LookupEnv(connectTokenEnv); ok {
+		_ = os.Unsetenv(connectTokenEnv)
+		defer func() { _ = os.Setenv(connectTokenEnv, value) }()
+	}
+
+	valid := types.SecretConfig{Protocol: "http", Host: "connect", Port: 8080,
+		OnePassword: types.OnePasswordInfo{Vault: "edgex", Token: testToken}}
+	noVault := valid
+	noVault.OnePassword.Vault = ""
+	noToken := valid
+	noToken.OnePassword.Token = ""
+	missingTokenFile := noToken
+	missingTokenFile.OnePassword.TokenFile = "/missing/token"
+
+	tests := []struct {
+		name        string
+		config      types.SecretConfig
+		expectError bool
+	}{
+		{"Valid", valid, false},
+		{"Invalid - no vault", noVault, true},
+		{"Invalid - no token", noToken, true},
+		{"Invalid - missing token file", missingTokenFile, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewSecretsClient(test.config, nil, logger.NewMockClient())
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetSecrets(t *testing.T) {
+	connect := &mockConnect{items: map[string]*item{
+		"redis": {Id: "redis", Title: "edgex/core-data/redisdb", Fields: []field{
+			{Id: "username", Label: "username", Value: "redis5"},
+			{Id: "password", Label: "password", Value: "password", Type: concealedField},
+			{Id: "notesPlain", Label: "notesPlain", Value: "notes", Purpose: notesPurpose},
+		}},
+		"empty": {Id: "empty", Title: "edgex/core-data/empty"},
+	}}
+	client, closer := createTestClient(t, connect)
+	defer closer()
+
+	tests := []struct {
+		name        string
+		subPath     string
+		keys        []string
+		expected    map[string]string
+		expectError error
+	}{
+		{"Get all keys", "/redisdb", nil, map[string]string{"username": "redis5", "password": "password"}, nil},
+		{"Get one key", "/redisdb", []string{"password"}, map[string]string{"password": "password"}, nil},
+		{"Missing key", "/redisdb", []string{"missing"}, nil, pkg.ErrSecretsNotFound{}},
+		{"Missing secret", "/unknown", nil, nil, pkg.ErrSecretStore{}},
+		{"No fields", "/empty", nil, nil, pkg.ErrSecretStore{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := client.GetSecrets(context.Background(), test.subPath, test.keys...)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.IsType(t, test.expectError, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	assert.Equal(t, testVaultId, client.vaultId)
+}
+
+func TestStoreSecrets(t *testing.T) {
+	connect := &mockConnect{items: map[string]*item{
+		"redis": {Id: "redis", Title: "edgex/core-data/redisdb", Fields: []field{{Label: "username", Value: "redis5"}}},
+	}}
+	client, closer := createTestClient(t, connect)
+	defer closer()
+
+	// replace fields of existing item
+	err := client.StoreSecrets(context.Background(), "/redisdb", map[string]string{"username": "redis6"})
+	require.NoError(t, err)
+	actual, err := client.GetSecrets(context.Background(), "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis6"}, actual)
+
+	// create new item
+	err = client.StoreSecrets(context.Background(), "/mqtt", map[string]string{"password": "mqtt"})
+	require.NoError(t, err)
+	actual, err = client.GetSecrets(context.Background(), "/mqtt", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "mqtt"}, actual)
+	assert.Equal(t, defaultCategory, connect.items["item1"].Category)
+	assert.Equal(t, testVaultId, connect.items["item1"].Vault.Id)
+}
+
+func TestListSecretPaths(t *testing.T) {
+	connect := &mockConnect{items: map[string]*item{
+		"redis":  {Id: "redis", Title: "edgex/core-data/redisdb"},
+		"broker": {Id: "broker", Title: "edgex/core-data/mqtt/broker"},
+		"other":  {Id: "other", Title: "edgex/core-command/redisdb"},
+	}}
+	client, closer := createTestClient(t, connect)
+	defer closer()
+
+	actual, err := client.ListSecretPaths(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mqtt/", "redisdb"}, actual)
+
+	actual, err = client.ListSecretPaths(context.Background(), "/unknown")
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func TestGenerateConsulToken(t *testing.T) {
+	client, closer := createTestClient(t, &mockConnect{})
+	defer closer()
+
+	_, err := client.GenerateConsulToken(context.Background(), "core-data")
+	require.Error(t, err)
+}
@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// secretPatterns match the credentials RedactSecrets replaces. The patterns with groups keep the text matched by
+// them, i.e. the name of the header or JSON field, and replace the text between.
+var secretPatterns = []*regexp.Regexp{
+	// PEM encoded private keys
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+	// the values of the headers and JSON fields holding tokens and passwords
+	regexp.MustCompile(`(?i)((?:X-Vault-Token|Authorization)["']?\s*[:=]\s*["']?)(?:Bearer\s+)?[^\s"',]+`),
+	regexp.MustCompile(`(?i)("(?:client_token|token|password|secret_id|private_key|jwt|otp)"\s*:\s*")[^"]*(")`),
+	// Vault tokens, "hvs.", "hvb." and "hvr." and the legacy "s.", "b." and "r."
+	regexp.MustCompile(`\bhv[sbr]\.[A-Za-z0-9_-]{20,}`),
+	regexp.MustCompile(`\b[sbr]\.[A-Za-z0-9]{24}\b`),
+	// JSON Web Tokens, i.e. the Kubernetes service account and identity tokens
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	// AWS access key ids
+	regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+}
+
+// RedactSecrets replaces the credentials of known formats in text with types.Redacted: Vault tokens, JSON Web
+// Tokens, AWS access key ids, PEM private keys and the values of the X-Vault-Token and Authorization headers and
+// of the JSON fields named like tokens and passwords
+func RedactSecrets(text string) string {
+	for _, pattern := range secretPatterns {
+		if pattern.NumSubexp() > 0 {
+			text = pattern.ReplaceAllString(text, "${1}"+types.Redacted+"${2}")
+		} else {
+			text = pattern.ReplaceAllLiteralString(text, types.Redacted)
+		}
+	}
+	return text
+}
+
+// redactingLoggingClient is a logger.LoggingClient redacting the messages before logging them with lc
+type redactingLoggingClient struct {
+	lc logger.LoggingClient
+}
+
+// NewRedactingLoggingClient wraps lc so every message, and each argument of the messages logged with key value
+// arguments, is passed through RedactSecrets before being logged
+func NewRedactingLoggingClient(lc logger.LoggingClient) logger.LoggingClient {
+	return redactingLoggingClient{lc: lc}
+}
+
+func (r redactingLoggingClient) SetLogLevel(logLevel string) errors.EdgeX {
+	return r.lc.SetLogLevel(logLevel)
+}
+
+func (r redactingLoggingClient) LogLevel() string {
+	return r.lc.LogLevel()
+}
+
+func (r redactingLoggingClient) Debug(msg string, args ...interface{}) {
+	r.lc.Debug(RedactSecrets(msg), redactArgs(args)...)
+}
+
+func (r redactingLoggingClient) Error(msg string, args ...interface{}) {
+	r.lc.Error(RedactSecrets(msg), redactArgs(args)...)
+}
+
+func (r redactingLoggingClient) Info(msg string, args ...interface{}) {
+	r.lc.Info(RedactSecrets(msg), redactArgs(args)...)
+}
+
+func (r redactingLoggingClient) Trace(msg string, args ...interface{}) {
+	r.lc.Trace(RedactSecrets(msg), redactArgs(args)...)
+}
+
+func (r redactingLoggingClient) Warn(msg string, args ...interface{}) {
+	r.lc.Warn(RedactSecrets(msg), redactArgs(args)...)
+}
+
+func (r redactingLoggingClient) Debugf(msg string, args ...interface{}) {
+	r.lc.Debug(RedactSecrets(fmt.Sprintf(msg, args...)))
+}
+
+func (r redactingLoggingClient) Errorf(msg string, args ...interface{}) {
+	r.lc.Error(RedactSecrets(fmt.Sprintf(msg, args...)))
+}
+
+func (r redactingLoggingClient) Infof(msg string, args ...interface{}) {
+	r.lc.Info(RedactSecrets(fmt.Sprintf(msg, args...)))
+}
+
+func (r redactingLoggingClient) Tracef(msg string, args ...interface{}) {
+	r.lc.Trace(RedactSecrets(fmt.Sprintf(msg, args...)))
+}
+
+func (r redactingLoggingClient) Warnf(msg string, args ...interface{}) {
+	r.lc.Warn(RedactSecrets(fmt.Sprintf(msg, args...)))
+}
+
+// redactArgs formats the key value arguments as strings and redacts them
+func redactArgs(args []interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+	for index, arg := range args {
+		redacted[index] = RedactSecrets(fmt.Sprint(arg))
+	}
+	return redacted
+}
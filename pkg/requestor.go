@@ -27,6 +27,7 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/fileioperformer"
 )
 
@@ -46,8 +47,12 @@ func NewRequester(logger logger.LoggingClient) HTTPSRequester {
 }
 
 func (r *fluentRequester) Insecure() Caller {
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	// nothing is set which FIPS mode could reject
+	_ = fips.ConfigureTLS(tlsConfig)
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		TLSClientConfig: tlsConfig,
 	}
 	return &http.Client{Timeout: httpClientTimeoutDuration, Transport: tr}
 }
@@ -64,12 +69,18 @@ func (r *fluentRequester) WithTLS(caReader io.Reader, serverName string) Caller
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM(caCert)
 
+	tlsConfig := &tls.Config{
+		RootCAs:            caCertPool,
+		InsecureSkipVerify: false,
+		ServerName:         serverName,
+	}
+	if err := fips.ConfigureTLS(tlsConfig); err != nil {
+		r.logger.Error(err.Error())
+		return nil
+	}
+
 	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			RootCAs:            caCertPool,
-			InsecureSkipVerify: false,
-			ServerName:         serverName,
-		},
+		TLSClientConfig:     tlsConfig,
 		TLSHandshakeTimeout: httpClientTimeoutDuration,
 	}
 	return &http.Client{Timeout: httpClientTimeoutDuration, Transport: tr}
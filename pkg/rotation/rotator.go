@@ -0,0 +1,292 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package rotation rotates secrets on a schedule, generating the new values, applying them to the systems using
+// them, i.e. changing a database password, and storing them with check-and-set so concurrent rotations can't
+// overwrite each other.
+package rotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+var (
+	// ErrAlreadyRegistered is returned by Register when a rotation is already registered for the sub-path
+	ErrAlreadyRegistered = errors.New("a rotation is already registered for the sub-path")
+	// ErrNotRegistered is returned by Rotate when no rotation is registered for the sub-path
+	ErrNotRegistered = errors.New("no rotation is registered for the sub-path")
+)
+
+// Client reads and stores the versions of the rotated secrets. The secrets.VersionedSecretClient satisfies this
+// interface.
+type Client interface {
+	GetSecretVersion(ctx context.Context, subPath string, version int) (types.SecretVersion, error)
+	StoreSecretsCAS(ctx context.Context, subPath string, secrets map[string]string, cas int) (types.SecretVersionMetadata, error)
+}
+
+// Generator generates the new secrets of a sub-path, given its current secrets
+type Generator interface {
+	Generate(ctx context.Context, subPath string, current map[string]string) (map[string]string, error)
+}
+
+// GeneratorFunc adapts a function to a Generator
+type GeneratorFunc func(ctx context.Context, subPath string, current map[string]string) (map[string]string, error)
+
+// Generate calls f(ctx, subPath, current)
+func (f GeneratorFunc) Generate(ctx context.Context, subPath string, current map[string]string) (map[string]string, error) {
+	return f(ctx, subPath, current)
+}
+
+// Rotation is how and when the secrets at a sub-path are rotated
+type Rotation struct {
+	SubPath   string
+	Schedule  Schedule
+	Generator Generator
+	// Apply, when set, applies the new secrets to the system using them after they are stored, i.e. changes the
+	// Redis password. When it fails the previous secrets are stored back.
+	Apply func(ctx context.Context, subPath string, previous map[string]string, next map[string]string) error
+}
+
+// Options configures a Rotator
+type Options struct {
+	// OnFailure, when set, is called with the sub-path and the error when a scheduled rotation fails. The rotation
+	// is attempted again at its next scheduled time.
+	OnFailure func(subPath string, err error)
+}
+
+// Rotator rotates the secrets of each registered sub-path on its schedule, in its own goroutine, once started
+type Rotator struct {
+	client    Client
+	lc        logger.LoggingClient
+	options   Options
+	callbacks pkg.SecretUpdatedCallbacks
+	failures  chan error
+	// now abstracts the clock used for the schedules, which is most useful for testing
+	now func() time.Time
+
+	mutex     sync.Mutex
+	rotations map[string]*registration
+	ctx       context.Context
+	// rotating serializes the rotations of each sub-path, so a scheduled and a manual rotation don't race
+	rotating map[string]*sync.Mutex
+}
+
+type registration struct {
+	rotation Rotation
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewRotator creates a Rotator storing the rotated secrets with the client
+func NewRotator(client Client, lc logger.LoggingClient, options Options) *Rotator {
+	return &Rotator{
+		client:    client,
+		lc:        lc,
+		options:   options,
+		failures:  make(chan error, 1),
+		now:       time.Now,
+		rotations: make(map[string]*registration),
+		rotating:  make(map[string]*sync.Mutex),
+	}
+}
+
+// Failures returns the channel on which the error is sent when a scheduled rotation fails. The send doesn't block,
+// so a failure is dropped when the previous one has not been received.
+func (r *Rotator) Failures() <-chan error {
+	return r.failures
+}
+
+// Register adds the rotation of a sub-path, which is scheduled right away when the Rotator is started
+func (r *Rotator) Register(rotation Rotation) error {
+	if rotation.SubPath == "" {
+		return errors.New("sub-path cannot be empty to register a rotation")
+	}
+	if rotation.Schedule == nil || rotation.Generator == nil {
+		return fmt.Errorf("rotation of '%s' requires a schedule and a generator", rotation.SubPath)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.rotations[rotation.SubPath]; ok {
+		return fmt.Errorf("%w: '%s'", ErrAlreadyRegistered, rotation.SubPath)
+	}
+	registered := &registration{rotation: rotation}
+	r.rotations[rotation.SubPath] = registered
+	r.rotating[rotation.SubPath] = &sync.Mutex{}
+	if r.ctx != nil {
+		r.schedule(registered)
+	}
+	return nil
+}
+
+// Unregister removes the rotation of the sub-path, waiting for a rotation in progress to finish
+func (r *Rotator) Unregister(subPath string) {
+	r.mutex.Lock()
+	registered, ok := r.rotations[subPath]
+	delete(r.rotations, subPath)
+	var done chan struct{}
+	if ok && registered.cancel != nil {
+		registered.cancel()
+		done = registered.done
+	}
+	r.mutex.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after the secrets at the sub-path are rotated
+func (r *Rotator) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	r.callbacks.Register(subPath, callback)
+}
+
+// Start schedules the registered rotations until ctx is done or Stop is called
+func (r *Rotator) Start(ctx context.Context) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.ctx != nil {
+		return
+	}
+	r.ctx = ctx
+	for _, registered := range r.rotations {
+		r.schedule(registered)
+	}
+}
+
+// Stop stops scheduling rotations, waiting for those in progress to finish. The Rotator can be started again.
+func (r *Rotator) Stop() {
+	r.mutex.Lock()
+	var scheduled []*registration
+	for _, registered := range r.rotations {
+		if registered.cancel != nil {
+			registered.cancel()
+			scheduled = append(scheduled, registered)
+			registered.cancel = nil
+		}
+	}
+	r.ctx = nil
+	r.mutex.Unlock()
+
+	for _, registered := range scheduled {
+		<-registered.done
+	}
+}
+
+// schedule starts the goroutine rotating the secrets of the registration on its schedule, with the lock held
+func (r *Rotator) schedule(registered *registration) {
+	ctx, cancel := context.WithCancel(r.ctx)
+	registered.cancel = cancel
+	registered.done = make(chan struct{})
+
+	go func() {
+		defer close(registered.done)
+
+		subPath := registered.rotation.SubPath
+		for {
+			next := registered.rotation.Schedule.Next(r.now())
+			if next.IsZero() {
+				r.lc.Infof("rotation schedule of '%s' has no further rotation times", subPath)
+				return
+			}
+
+			timer := time.NewTimer(next.Sub(r.now()))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if _, err := r.rotate(ctx, registered.rotation); err != nil {
+				err = fmt.Errorf("scheduled rotation of the secrets at '%s' failed: %w", subPath, err)
+				r.lc.Error(err.Error())
+				select {
+				case r.failures <- err:
+				default:
+				}
+				if r.options.OnFailure != nil {
+					r.options.OnFailure(subPath, err)
+				}
+			}
+		}
+	}()
+}
+
+// Rotate rotates the secrets at the registered sub-path now, regardless of its schedule, and returns the metadata
+// of the new version
+func (r *Rotator) Rotate(ctx context.Context, subPath string) (types.SecretVersionMetadata, error) {
+	r.mutex.Lock()
+	registered, ok := r.rotations[subPath]
+	r.mutex.Unlock()
+	if !ok {
+		return types.SecretVersionMetadata{}, fmt.Errorf("%w: '%s'", ErrNotRegistered, subPath)
+	}
+
+	return r.rotate(ctx, registered.rotation)
+}
+
+// rotate reads the current version of the secrets, generates and stores the next version with the current one as
+// the check-and-set version, then applies it. A pkg.ErrSecretVersionConflict is returned when another writer
+// stored a version in between.
+func (r *Rotator) rotate(ctx context.Context, rotation Rotation) (types.SecretVersionMetadata, error) {
+	r.mutex.Lock()
+	rotating := r.rotating[rotation.SubPath]
+	r.mutex.Unlock()
+	rotating.Lock()
+	defer rotating.Unlock()
+
+	subPath := rotation.SubPath
+	current, err := r.client.GetSecretVersion(ctx, subPath, 0)
+	if err != nil {
+		return types.SecretVersionMetadata{}, fmt.Errorf("unable to read the secrets to rotate: %w", err)
+	}
+
+	next, err := rotation.Generator.Generate(ctx, subPath, current.Data)
+	if err != nil {
+		return types.SecretVersionMetadata{}, fmt.Errorf("unable to generate the rotated secrets: %w", err)
+	}
+
+	metadata, err := r.client.StoreSecretsCAS(ctx, subPath, next, current.Metadata.Version)
+	if err != nil {
+		return types.SecretVersionMetadata{}, fmt.Errorf("unable to store the rotated secrets: %w", err)
+	}
+
+	if rotation.Apply != nil {
+		if err := rotation.Apply(ctx, subPath, current.Data, next); err != nil {
+			if len(current.Data) > 0 {
+				if _, restoreErr := r.client.StoreSecretsCAS(ctx, subPath, current.Data, metadata.Version); restoreErr != nil {
+					r.lc.Errorf("unable to restore the secrets at '%s' after the rotation failed to apply: %s",
+						subPath, restoreErr.Error())
+				}
+			}
+			return types.SecretVersionMetadata{}, fmt.Errorf("unable to apply the rotated secrets: %w", err)
+		}
+	}
+
+	r.lc.Infof("rotated the secrets at '%s' to version %d", subPath, metadata.Version)
+	r.callbacks.Notify(subPath)
+	return metadata, nil
+}
@@ -0,0 +1,237 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package rotation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// stubClient keeps the versions of each secret in memory, rejecting stale check-and-set writes
+type stubClient struct {
+	mutex    sync.Mutex
+	versions map[string][]map[string]string
+}
+
+func (s *stubClient) GetSecretVersion(_ context.Context, subPath string, version int) (types.SecretVersion, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	versions := s.versions[subPath]
+	if len(versions) == 0 {
+		return types.SecretVersion{}, pkg.NewErrSecretStore("no secret")
+	}
+	if version == 0 {
+		version = len(versions)
+	}
+	return types.SecretVersion{Data: versions[version-1], Metadata: types.SecretVersionMetadata{Version: version}}, nil
+}
+
+func (s *stubClient) StoreSecretsCAS(_ context.Context, subPath string, secrets map[string]string, cas int) (types.SecretVersionMetadata, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.versions[subPath]) != cas {
+		return types.SecretVersionMetadata{}, pkg.NewErrSecretVersionConflict(subPath, cas)
+	}
+	s.versions[subPath] = append(s.versions[subPath], secrets)
+	return types.SecretVersionMetadata{Version: len(s.versions[subPath])}, nil
+}
+
+func (s *stubClient) latest(subPath string) map[string]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	versions := s.versions[subPath]
+	return versions[len(versions)-1]
+}
+
+func newPasswordGenerator() Generator {
+	var counter int
+	return GeneratorFunc(func(_ context.Context, _ string, current map[string]string) (map[string]string, error) {
+		counter++
+		return map[string]string{"username": current["username"], "password": "rotated-" + string(rune('0'+counter))}, nil
+	})
+}
+
+func TestRotate(t *testing.T) {
+	client := &stubClient{versions: map[string][]map[string]string{
+		"redisdb": {{"username": "redis5", "password": "initial"}},
+	}}
+	rotator := NewRotator(client, logger.NewMockClient(), Options{})
+
+	var applied map[string]string
+	require.NoError(t, rotator.Register(Rotation{
+		SubPath:   "redisdb",
+		Schedule:  Every(time.Hour),
+		Generator: newPasswordGenerator(),
+		Apply: func(_ context.Context, _ string, previous map[string]string, next map[string]string) error {
+			assert.Equal(t, "initial", previous["password"])
+			applied = next
+			return nil
+		},
+	}))
+
+	var notified []string
+	rotator.RegisterSecretUpdatedCallback("redisdb", func(subPath string) {
+		notified = append(notified, subPath)
+	})
+
+	metadata, err := rotator.Rotate(context.Background(), "redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, 2, metadata.Version)
+
+	expected := map[string]string{"username": "redis5", "password": "rotated-1"}
+	assert.Equal(t, expected, client.latest("redisdb"))
+	assert.Equal(t, expected, applied)
+	assert.Equal(t, []string{"redisdb"}, notified)
+}
+
+func TestRotateError(t *testing.T) {
+	failure := errors.New("failure")
+	failingGenerator := func(*stubClient) Generator {
+		return GeneratorFunc(func(context.Context, string, map[string]string) (map[string]string, error) {
+			return nil, failure
+		})
+	}
+	// concurrentGenerator has another writer store a version while the rotation generates the new secrets
+	concurrentGenerator := func(client *stubClient) Generator {
+		return GeneratorFunc(func(ctx context.Context, subPath string, current map[string]string) (map[string]string, error) {
+			_, _ = client.StoreSecretsCAS(ctx, subPath, map[string]string{"password": "concurrent"}, 1)
+			return current, nil
+		})
+	}
+	passwordGenerator := func(*stubClient) Generator { return newPasswordGenerator() }
+	failingApply := func(context.Context, string, map[string]string, map[string]string) error { return failure }
+
+	tests := []struct {
+		name      string
+		subPath   string
+		generator func(client *stubClient) Generator
+		apply     func(ctx context.Context, subPath string, previous map[string]string, next map[string]string) error
+		// latest is the password stored once the rotation failed
+		latest string
+		isType func(err error) bool
+	}{
+		{"Not registered", "mqtt", passwordGenerator, nil, "initial",
+			func(err error) bool { return errors.Is(err, ErrNotRegistered) }},
+		{"Missing secret", "missing", passwordGenerator, nil, "initial",
+			func(err error) bool { return errors.As(err, &pkg.ErrSecretStore{}) }},
+		{"Generator failure", "redisdb", failingGenerator, nil, "initial",
+			func(err error) bool { return errors.Is(err, failure) }},
+		{"Version conflict", "redisdb", concurrentGenerator, nil, "concurrent",
+			func(err error) bool { return errors.As(err, &pkg.ErrSecretVersionConflict{}) }},
+		{"Apply failure restores the secrets", "redisdb", passwordGenerator, failingApply, "initial",
+			func(err error) bool { return errors.Is(err, failure) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &stubClient{versions: map[string][]map[string]string{
+				"redisdb": {{"username": "redis5", "password": "initial"}},
+			}}
+			rotator := NewRotator(client, logger.NewMockClient(), Options{})
+			for _, subPath := range []string{"redisdb", "missing"} {
+				require.NoError(t, rotator.Register(Rotation{
+					SubPath:   subPath,
+					Schedule:  Every(time.Hour),
+					Generator: test.generator(client),
+					Apply:     test.apply,
+				}))
+			}
+			rotator.RegisterSecretUpdatedCallback(test.subPath, func(string) {
+				assert.Fail(t, "callback notified of a failed rotation")
+			})
+
+			_, err := rotator.Rotate(context.Background(), test.subPath)
+			require.Error(t, err)
+			assert.True(t, test.isType(err), err.Error())
+			assert.Equal(t, test.latest, client.latest("redisdb")["password"])
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	rotator := NewRotator(&stubClient{}, logger.NewMockClient(), Options{})
+
+	rotation := Rotation{SubPath: "redisdb", Schedule: Every(time.Hour), Generator: newPasswordGenerator()}
+	require.NoError(t, rotator.Register(rotation))
+	assert.True(t, errors.Is(rotator.Register(rotation), ErrAlreadyRegistered))
+	require.Error(t, rotator.Register(Rotation{SubPath: "mqtt", Schedule: Every(time.Hour)}))
+	require.Error(t, rotator.Register(Rotation{Schedule: Every(time.Hour), Generator: newPasswordGenerator()}))
+
+	rotator.Unregister("redisdb")
+	require.NoError(t, rotator.Register(rotation))
+}
+
+func TestScheduledRotation(t *testing.T) {
+	client := &stubClient{versions: map[string][]map[string]string{
+		"redisdb": {{"username": "redis5", "password": "initial"}},
+		"missing": nil,
+	}}
+
+	failures := make(chan string, 10)
+	rotator := NewRotator(client, logger.NewMockClient(), Options{
+		OnFailure: func(subPath string, err error) { failures <- subPath },
+	})
+
+	rotated := make(chan string, 10)
+	rotator.RegisterSecretUpdatedCallback("redisdb", func(subPath string) { rotated <- subPath })
+
+	interval := 10 * time.Millisecond
+	require.NoError(t, rotator.Register(Rotation{SubPath: "redisdb", Schedule: Every(interval), Generator: newPasswordGenerator()}))
+	rotator.Start(context.Background())
+	// registered after starting, so scheduled right away
+	require.NoError(t, rotator.Register(Rotation{SubPath: "missing", Schedule: Every(interval), Generator: newPasswordGenerator()}))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-rotated:
+		case <-time.After(time.Second):
+			require.Fail(t, "secrets not rotated on schedule")
+		}
+	}
+	select {
+	case subPath := <-failures:
+		assert.Equal(t, "missing", subPath)
+	case <-time.After(time.Second):
+		require.Fail(t, "failed rotation not reported")
+	}
+	select {
+	case err := <-rotator.Failures():
+		assert.True(t, errors.As(err, &pkg.ErrSecretStore{}))
+	case <-time.After(time.Second):
+		require.Fail(t, "failed rotation not sent on the failures channel")
+	}
+
+	rotator.Stop()
+	version, err := client.GetSecretVersion(context.Background(), "redisdb", 0)
+	require.NoError(t, err)
+	time.Sleep(5 * interval)
+	stopped, err := client.GetSecretVersion(context.Background(), "redisdb", 0)
+	require.NoError(t, err)
+	assert.Equal(t, version.Metadata.Version, stopped.Metadata.Version, "secrets rotated after stopping")
+}
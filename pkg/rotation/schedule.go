@@ -0,0 +1,190 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package rotation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronSearch bounds the search for the next time matching a cron expression, so expressions which never match,
+// i.e. "0 0 30 2 *", end the rotation rather than loop forever
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// Schedule determines when secrets are rotated
+type Schedule interface {
+	// Next returns the first rotation time after the given time, or the zero time when there is none
+	Next(after time.Time) time.Time
+}
+
+// Every returns a Schedule rotating at a fixed interval
+func Every(interval time.Duration) Schedule {
+	return intervalSchedule(interval)
+}
+
+type intervalSchedule time.Duration
+
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	if s <= 0 {
+		return time.Time{}
+	}
+	return after.Add(time.Duration(s))
+}
+
+// cronSchedule holds the values matched by each cron field
+type cronSchedule struct {
+	minutes, hours, days, months, weekdays map[int]bool
+	// anyDay and anyWeekday are set for "*" fields, as a day matches either restricted field of the two
+	anyDay, anyWeekday bool
+}
+
+// ParseCron parses a cron expression of the five standard fields, minute, hour, day of month, month and day of
+// week, each a "*", a value, a range "1-5" or a list of them, optionally with a step, i.e. "*/15". Sunday is 0 or
+// 7. The descriptors "@yearly", "@monthly", "@weekly", "@daily", "@hourly" and "@every <duration>" are accepted
+// too. The times are matched in the location of the time passed to Next.
+func ParseCron(expression string) (Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(expression, "@every ")))
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid cron interval '%s'", expression)
+		}
+		return Every(interval), nil
+	}
+
+	switch expression {
+	case "@yearly", "@annually":
+		expression = "0 0 1 1 *"
+	case "@monthly":
+		expression = "0 0 1 * *"
+	case "@weekly":
+		expression = "0 0 * * 0"
+	case "@daily", "@midnight":
+		expression = "0 0 * * *"
+	case "@hourly":
+		expression = "0 * * * *"
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression '%s' must have 5 fields", expression)
+	}
+
+	var schedule cronSchedule
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if schedule.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if schedule.weekdays, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if schedule.weekdays[7] {
+		schedule.weekdays[0] = true
+	}
+	schedule.anyDay = strings.HasPrefix(fields[2], "*")
+	schedule.anyWeekday = strings.HasPrefix(fields[4], "*")
+
+	return &schedule, nil
+}
+
+// parseCronField returns the values in [min, max] matched by the comma separated list of the field
+func parseCronField(field string, min int, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if index := strings.Index(part, "/"); index >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[index+1:]); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step in cron field '%s'", field)
+			}
+			part = part[:index]
+		}
+
+		low, high := min, max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var lowErr, highErr error
+			low, lowErr = strconv.Atoi(bounds[0])
+			high, highErr = strconv.Atoi(bounds[1])
+			if lowErr != nil || highErr != nil {
+				return nil, fmt.Errorf("invalid range in cron field '%s'", field)
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value in cron field '%s'", field)
+			}
+			low, high = value, value
+			if step > 1 {
+				// "5/15" means from 5 to the max in steps of 15
+				high = max
+			}
+		}
+
+		if low < min || high > max || low > high {
+			return nil, fmt.Errorf("cron field '%s' is out of the range %d-%d", field, min, max)
+		}
+		for value := low; value <= high; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	location := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		switch {
+		case !s.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+		case !s.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches applies the cron rule that, when both the day of month and the day of week are restricted, a day
+// matching either one matches
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	day := s.days[t.Day()]
+	weekday := s.weekdays[int(t.Weekday())]
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
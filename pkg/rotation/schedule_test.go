@@ -0,0 +1,84 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package rotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	// Wednesday
+	start := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{"Every minute", "* * * * *", time.Date(2026, time.January, 14, 10, 31, 0, 0, time.UTC)},
+		{"Step", "*/15 * * * *", time.Date(2026, time.January, 14, 10, 45, 0, 0, time.UTC)},
+		{"Daily at 2", "0 2 * * *", time.Date(2026, time.January, 15, 2, 0, 0, 0, time.UTC)},
+		{"List and range", "0 9-17 * * 1,5", time.Date(2026, time.January, 16, 9, 0, 0, 0, time.UTC)},
+		{"Sunday as 7", "0 0 * * 7", time.Date(2026, time.January, 18, 0, 0, 0, 0, time.UTC)},
+		{"Day of month or week", "0 0 1 * 5", time.Date(2026, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{"Next month", "0 0 1 * *", time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"Leap day", "0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"Monthly", "@monthly", time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"Weekly", "@weekly", time.Date(2026, time.January, 18, 0, 0, 0, 0, time.UTC)},
+		{"Every duration", "@every 1h", time.Date(2026, time.January, 14, 11, 30, 0, 0, time.UTC)},
+		{"Never matches", "0 0 30 2 *", time.Time{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule, err := ParseCron(test.expression)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, schedule.Next(start))
+		})
+	}
+}
+
+func TestParseCronError(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"Empty", ""},
+		{"Too few fields", "* * * *"},
+		{"Out of range", "60 * * * *"},
+		{"Invalid range", "5-1 * * * *"},
+		{"Invalid step", "*/0 * * * *"},
+		{"Not a number", "a * * * *"},
+		{"Unknown descriptor", "@sometimes"},
+		{"Invalid duration", "@every soon"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseCron(test.expression)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEvery(t *testing.T) {
+	start := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+	assert.Equal(t, start.Add(time.Hour), Every(time.Hour).Next(start))
+	assert.True(t, Every(0).Next(start).IsZero())
+}
@@ -0,0 +1,114 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package secure holds secrets in memory so they can be destroyed: Wipe overwrites byte slices and Buffer keeps a
+// secret in memory which is optionally locked, so it is never swapped to disk, and which Destroy zeroes. Go strings
+// cannot be overwritten, so secrets held as strings are only dropped for the garbage collector to reclaim.
+package secure
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// ErrLockUnsupported is returned by NewBuffer when locking memory is requested on a platform without mlock
+var ErrLockUnsupported = errors.New("locking memory is not supported on this platform")
+
+// Wipe overwrites b with zeros
+func Wipe(b []byte) {
+	for index := range b {
+		b[index] = 0
+	}
+	// keeps the writes from being optimized away as dead stores
+	runtime.KeepAlive(b)
+}
+
+// BufferOptions configures a Buffer
+type BufferOptions struct {
+	// Lock locks the memory of the buffer with mlock so it is never swapped to disk. The memory which a process
+	// can lock is limited, i.e. by RLIMIT_MEMLOCK on Linux.
+	Lock bool
+}
+
+// Buffer holds a secret until it is destroyed. It is formatted as types.Redacted.
+type Buffer struct {
+	mutex  sync.Mutex
+	data   []byte
+	locked bool
+}
+
+// NewBuffer moves secret into a new Buffer, wiping secret so that the Buffer holds the only copy
+func NewBuffer(secret []byte, options BufferOptions) (*Buffer, error) {
+	data := make([]byte, len(secret))
+	buffer := &Buffer{data: data}
+	if options.Lock && len(data) > 0 {
+		if err := lock(data); err != nil {
+			return nil, fmt.Errorf("unable to lock the memory of the secret: %w", err)
+		}
+		buffer.locked = true
+	}
+
+	copy(data, secret)
+	Wipe(secret)
+	return buffer, nil
+}
+
+// Bytes returns the secret, which is only valid until the Buffer is destroyed and must not be retained, or nil
+// once it is destroyed
+func (b *Buffer) Bytes() []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.data
+}
+
+// Destroy wipes the secret and unlocks its memory. Destroying a Buffer again does nothing.
+func (b *Buffer) Destroy() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.data == nil {
+		return nil
+	}
+	Wipe(b.data)
+
+	var err error
+	if b.locked {
+		err = unlock(b.data)
+		b.locked = false
+	}
+	b.data = nil
+	return err
+}
+
+// Destroyed reports whether the Buffer was destroyed
+func (b *Buffer) Destroyed() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.data == nil
+}
+
+// String returns types.Redacted
+func (b *Buffer) String() string {
+	return types.Redacted
+}
+
+// Format writes types.Redacted whatever the verb
+func (b *Buffer) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, types.Redacted)
+}
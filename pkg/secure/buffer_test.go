@@ -0,0 +1,62 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secure
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWipe(t *testing.T) {
+	secret := []byte("password")
+	Wipe(secret)
+	assert.Equal(t, make([]byte, 8), secret)
+}
+
+func TestBuffer(t *testing.T) {
+	for _, lock := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Lock %v", lock), func(t *testing.T) {
+			secret := []byte("password")
+			buffer, err := NewBuffer(secret, BufferOptions{Lock: lock})
+			if lock && err != nil {
+				// locking is unsupported or beyond the memory lock limit of the process
+				if errors.Is(err, ErrLockUnsupported) {
+					t.Skip(err.Error())
+				}
+				t.Skipf("unable to lock memory: %s", err.Error())
+			}
+			require.NoError(t, err)
+
+			// the source is wiped as the buffer holds the only copy
+			assert.Equal(t, make([]byte, 8), secret)
+			assert.Equal(t, []byte("password"), buffer.Bytes())
+			assert.Equal(t, "***** *****", fmt.Sprintf("%v %s", buffer, buffer))
+			assert.NotContains(t, fmt.Sprintf("%v %+v %#v %x", buffer, buffer, buffer, buffer), "password")
+
+			data := buffer.Bytes()
+			require.NoError(t, buffer.Destroy())
+			assert.True(t, buffer.Destroyed())
+			assert.Nil(t, buffer.Bytes())
+			assert.Equal(t, make([]byte, 8), data)
+
+			// destroying again does nothing
+			require.NoError(t, buffer.Destroy())
+		})
+	}
+}
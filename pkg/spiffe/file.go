@@ -0,0 +1,135 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
+)
+
+// FileSourceOptions are the locations of the SVID files written by spiffe-helper. Only the files of the SVID type
+// in use are required.
+type FileSourceOptions struct {
+	// JWTSVIDFile is the JWT-SVID, the jwt_svid_file_name of the spiffe-helper configuration
+	JWTSVIDFile string
+	// CertFile and KeyFile are the PEM encoded X.509-SVID certificate chain and private key, the svid_file_name and
+	// svid_key_file_name of the spiffe-helper configuration
+	CertFile string
+	KeyFile  string
+}
+
+// FileSource is a Source reading the SVIDs from the files which spiffe-helper rewrites whenever SPIRE rotates them
+type FileSource struct {
+	options     FileSourceOptions
+	mutex       sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+// NewFileSource creates a FileSource reading the files of options
+func NewFileSource(options FileSourceOptions) *FileSource {
+	return &FileSource{options: options}
+}
+
+// FetchJWTSVID reads the JWT-SVID file. spiffe-helper fetches the JWT-SVID for the jwt_audience of its
+// configuration, so the audience is not used to select it and is left to the caller to verify.
+func (s *FileSource) FetchJWTSVID(_ context.Context, _ string) (string, error) {
+	if s.options.JWTSVIDFile == "" {
+		return "", errors.New("JWTSVIDFile is required to read a JWT-SVID")
+	}
+
+	contents, err := ioutil.ReadFile(s.options.JWTSVIDFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read JWT-SVID file: %w", err)
+	}
+	defer secure.Wipe(contents)
+
+	jwt := strings.TrimSpace(string(contents))
+	if jwt == "" {
+		return "", errors.New("JWT-SVID file is empty")
+	}
+	return jwt, nil
+}
+
+// FetchX509SVID loads the X.509-SVID, reloading it when the certificate or key file has been modified. If the
+// modified files fail to load, for instance because only one of them has been replaced so far, the previously
+// loaded X.509-SVID is returned.
+func (s *FileSource) FetchX509SVID(_ context.Context) (*tls.Certificate, error) {
+	if s.options.CertFile == "" || s.options.KeyFile == "" {
+		return nil, errors.New("CertFile and KeyFile are required to read an X.509-SVID")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.reload(); err != nil && s.cert == nil {
+		return nil, err
+	}
+	return s.cert, nil
+}
+
+// reload loads the X.509-SVID when the files have been modified since the last load. Must be called with the mutex
+// held.
+func (s *FileSource) reload() error {
+	certInfo, err := os.Stat(s.options.CertFile)
+	if err != nil {
+		return fmt.Errorf("unable to read X.509-SVID certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(s.options.KeyFile)
+	if err != nil {
+		return fmt.Errorf("unable to read X.509-SVID key: %w", err)
+	}
+
+	if s.cert != nil && certInfo.ModTime().Equal(s.certModTime) && keyInfo.ModTime().Equal(s.keyModTime) {
+		return nil
+	}
+
+	certPEM, err := ioutil.ReadFile(s.options.CertFile)
+	if err != nil {
+		return fmt.Errorf("unable to read X.509-SVID certificate: %w", err)
+	}
+	keyPEM, err := ioutil.ReadFile(s.options.KeyFile)
+	if err != nil {
+		return fmt.Errorf("unable to read X.509-SVID key: %w", err)
+	}
+	defer secure.Wipe(keyPEM)
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("unable to load X.509-SVID: %w", err)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return fmt.Errorf("unable to parse X.509-SVID certificate: %w", err)
+	}
+	if _, err := IDFromCertificate(cert.Leaf); err != nil {
+		return err
+	}
+
+	s.cert = &cert
+	s.certModTime = certInfo.ModTime()
+	s.keyModTime = keyInfo.ModTime()
+	return nil
+}
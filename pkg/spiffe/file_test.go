@@ -0,0 +1,127 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package spiffe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeX509SVID writes a self-signed X.509-SVID for the SPIFFE ID, with the files' modification time set to modTime
+// so reloads are detected regardless of the file system's timestamp granularity
+func writeX509SVID(t *testing.T, certFile string, keyFile string, id string, modTime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if id != "" {
+		uri, err := url.Parse(id)
+		require.NoError(t, err)
+		template.URIs = []*url.URL{uri}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func TestFileSourceJWTSVID(t *testing.T) {
+	jwtFile := filepath.Join(t.TempDir(), "jwt_svid.token")
+	source := NewFileSource(FileSourceOptions{JWTSVIDFile: jwtFile})
+
+	_, err := source.FetchJWTSVID(context.Background(), "vault")
+	require.Error(t, err, "missing file")
+
+	first := makeJWTSVID(t, "spiffe://edgex/core-data")
+	require.NoError(t, ioutil.WriteFile(jwtFile, []byte(first+"\n"), 0600))
+	jwt, err := source.FetchJWTSVID(context.Background(), "vault")
+	require.NoError(t, err)
+	assert.Equal(t, first, jwt)
+
+	// the file rewritten by spiffe-helper on rotation is read on the next fetch
+	second := makeJWTSVID(t, "spiffe://edgex/core-command")
+	require.NoError(t, ioutil.WriteFile(jwtFile, []byte(second), 0600))
+	jwt, err = source.FetchJWTSVID(context.Background(), "vault")
+	require.NoError(t, err)
+	assert.Equal(t, second, jwt)
+
+	require.NoError(t, ioutil.WriteFile(jwtFile, nil, 0600))
+	_, err = source.FetchJWTSVID(context.Background(), "vault")
+	require.Error(t, err, "empty file")
+
+	_, err = NewFileSource(FileSourceOptions{}).FetchJWTSVID(context.Background(), "vault")
+	require.Error(t, err, "no file configured")
+}
+
+func TestFileSourceX509SVID(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "svid.pem")
+	keyFile := filepath.Join(dir, "svid_key.pem")
+	source := NewFileSource(FileSourceOptions{CertFile: certFile, KeyFile: keyFile})
+
+	_, err := source.FetchX509SVID(context.Background())
+	require.Error(t, err, "missing files")
+
+	modTime := time.Now().Add(-time.Minute)
+	writeX509SVID(t, certFile, keyFile, "spiffe://edgex/first", modTime)
+	cert, err := source.FetchX509SVID(context.Background())
+	require.NoError(t, err)
+	id, err := IDFromCertificate(cert.Leaf)
+	require.NoError(t, err)
+	assert.Equal(t, "/first", id.Path)
+
+	// a partially rotated SVID keeps using the previous one
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("partial"), 0600))
+	cert, err = source.FetchX509SVID(context.Background())
+	require.NoError(t, err)
+	id, _ = IDFromCertificate(cert.Leaf)
+	assert.Equal(t, "/first", id.Path)
+
+	writeX509SVID(t, certFile, keyFile, "spiffe://edgex/second", modTime.Add(time.Second))
+	cert, err = source.FetchX509SVID(context.Background())
+	require.NoError(t, err)
+	id, _ = IDFromCertificate(cert.Leaf)
+	assert.Equal(t, "/second", id.Path)
+
+	// a certificate without a SPIFFE ID is not an SVID
+	other := NewFileSource(FileSourceOptions{CertFile: filepath.Join(dir, "other.pem"), KeyFile: filepath.Join(dir, "other_key.pem")})
+	writeX509SVID(t, filepath.Join(dir, "other.pem"), filepath.Join(dir, "other_key.pem"), "", modTime)
+	_, err = other.FetchX509SVID(context.Background())
+	require.Error(t, err)
+}
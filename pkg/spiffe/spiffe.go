@@ -0,0 +1,173 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package spiffe provides the SPIFFE identity of the workload, its SPIFFE ID and its SVIDs (SPIFFE Verifiable
+// Identity Documents), which the Vault client logs in with through the "spiffe" auth method. An X.509-SVID is
+// presented as the TLS client certificate of the cert auth method, while a JWT-SVID is exchanged through the JWT
+// auth method.
+//
+// SPIRE serves the SVIDs through the Workload API over gRPC, which is not a dependency of this module. The SVIDs are
+// either read from the files which spiffe-helper keeps up to date from the Workload API, with NewFileSource, or
+// fetched by a Source registered with RegisterSource, i.e. one wrapping the workloadapi package of go-spiffe.
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Scheme is the URI scheme of SPIFFE IDs
+const Scheme = "spiffe"
+
+// Source provides the current SVIDs of the workload. SVIDs are short-lived and rotated by SPIRE before they expire,
+// so they are fetched again for each use rather than kept.
+type Source interface {
+	// FetchJWTSVID returns a JWT-SVID for the audience
+	FetchJWTSVID(ctx context.Context, audience string) (string, error)
+	// FetchX509SVID returns the X.509-SVID, i.e. its certificate chain, leaf first, and the private key
+	FetchX509SVID(ctx context.Context) (*tls.Certificate, error)
+}
+
+var (
+	sourcesMutex sync.RWMutex
+	sources      = make(map[string]Source)
+)
+
+// RegisterSource makes source available under name, which the Source of the SPIFFE auth settings selects. It is
+// typically called at startup with a Source fetching the SVIDs from the Workload API. RegisterSource panics if
+// source is nil or a source is already registered under name.
+func RegisterSource(name string, source Source) {
+	sourcesMutex.Lock()
+	defer sourcesMutex.Unlock()
+
+	if source == nil {
+		panic(fmt.Sprintf("spiffe: RegisterSource source is nil for '%s'", name))
+	}
+	if _, exists := sources[name]; exists {
+		panic(fmt.Sprintf("spiffe: RegisterSource called twice for '%s'", name))
+	}
+
+	sources[name] = source
+}
+
+// LookupSource returns the source registered under name
+func LookupSource(name string) (Source, bool) {
+	sourcesMutex.RLock()
+	defer sourcesMutex.RUnlock()
+
+	source, ok := sources[name]
+	return source, ok
+}
+
+// ID is a SPIFFE ID, i.e. spiffe://edgexfoundry.org/service/core-data
+type ID struct {
+	TrustDomain string
+	// Path is empty or starts with a slash
+	Path string
+}
+
+// String returns the SPIFFE ID as a URI
+func (id ID) String() string {
+	return Scheme + "://" + id.TrustDomain + id.Path
+}
+
+// MemberOf reports whether the ID belongs to the trust domain
+func (id ID) MemberOf(trustDomain string) bool {
+	return id.TrustDomain == trustDomain
+}
+
+// ParseID parses a SPIFFE ID as specified by the SPIFFE ID standard: the trust domain is made of lowercase letters,
+// digits, dots, dashes and underscores, and the path segments of letters, digits, dots, dashes and underscores,
+// without "." or ".." segments. Ports, user info, queries and fragments are not allowed.
+func ParseID(id string) (ID, error) {
+	rest := strings.TrimPrefix(id, Scheme+"://")
+	if rest == id {
+		return ID{}, fmt.Errorf("SPIFFE ID '%s' does not have the %s:// scheme", id, Scheme)
+	}
+
+	trustDomain, path := rest, ""
+	if index := strings.Index(rest, "/"); index >= 0 {
+		trustDomain, path = rest[:index], rest[index:]
+	}
+
+	if trustDomain == "" {
+		return ID{}, fmt.Errorf("SPIFFE ID '%s' has no trust domain", id)
+	}
+	for _, r := range trustDomain {
+		if !isTrustDomainChar(r) {
+			return ID{}, fmt.Errorf("SPIFFE ID '%s' has an invalid character %q in its trust domain", id, r)
+		}
+	}
+
+	if path != "" {
+		for _, segment := range strings.Split(path[1:], "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				return ID{}, fmt.Errorf("SPIFFE ID '%s' has an empty, '.' or '..' path segment", id)
+			}
+			for _, r := range segment {
+				if !isPathChar(r) {
+					return ID{}, fmt.Errorf("SPIFFE ID '%s' has an invalid character %q in its path", id, r)
+				}
+			}
+		}
+	}
+
+	return ID{TrustDomain: trustDomain, Path: path}, nil
+}
+
+// IDFromCertificate returns the SPIFFE ID of an X.509-SVID, which is its only URI SAN
+func IDFromCertificate(cert *x509.Certificate) (ID, error) {
+	if len(cert.URIs) != 1 {
+		return ID{}, fmt.Errorf("X.509-SVID must have exactly one URI SAN, found %d", len(cert.URIs))
+	}
+	return ParseID(cert.URIs[0].String())
+}
+
+// IDFromJWT returns the SPIFFE ID of a JWT-SVID, which is its subject. The signature is not verified, which is left
+// to the relying party, i.e. Vault.
+func IDFromJWT(jwt string) (ID, error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return ID{}, errors.New("JWT-SVID is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ID{}, fmt.Errorf("unable to decode JWT-SVID claims: %w", err)
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ID{}, fmt.Errorf("unable to parse JWT-SVID claims: %w", err)
+	}
+
+	return ParseID(claims.Subject)
+}
+
+func isTrustDomainChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '_'
+}
+
+func isPathChar(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '_'
+}
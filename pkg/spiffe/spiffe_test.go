@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSource struct{}
+
+func (stubSource) FetchJWTSVID(_ context.Context, _ string) (string, error) { return "jwt", nil }
+func (stubSource) FetchX509SVID(_ context.Context) (*tls.Certificate, error) {
+	return &tls.Certificate{}, nil
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		id          string
+		expected    ID
+		expectError bool
+	}{
+		{"spiffe://edgexfoundry.org/service/core-data", ID{TrustDomain: "edgexfoundry.org", Path: "/service/core-data"}, false},
+		{"spiffe://edgex", ID{TrustDomain: "edgex"}, false},
+		{"spiffe://edge_x-1.local/Core.Data", ID{TrustDomain: "edge_x-1.local", Path: "/Core.Data"}, false},
+		{"https://edgex/core-data", ID{}, true},
+		{"spiffe:///core-data", ID{}, true},
+		{"spiffe://EdgeX/core-data", ID{}, true},
+		{"spiffe://edgex:8080/core-data", ID{}, true},
+		{"spiffe://user@edgex/core-data", ID{}, true},
+		{"spiffe://edgex/core-data/", ID{}, true},
+		{"spiffe://edgex/../core-data", ID{}, true},
+		{"spiffe://edgex/core-data?query", ID{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			id, err := ParseID(test.id)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, id)
+			assert.Equal(t, test.id, id.String())
+		})
+	}
+}
+
+func TestIDFromCertificate(t *testing.T) {
+	one, _ := url.Parse("spiffe://edgex/core-data")
+	other, _ := url.Parse("spiffe://edgex/core-command")
+
+	id, err := IDFromCertificate(&x509.Certificate{URIs: []*url.URL{one}})
+	require.NoError(t, err)
+	assert.True(t, id.MemberOf("edgex"))
+	assert.False(t, id.MemberOf("other"))
+
+	_, err = IDFromCertificate(&x509.Certificate{})
+	require.Error(t, err)
+	_, err = IDFromCertificate(&x509.Certificate{URIs: []*url.URL{one, other}})
+	require.Error(t, err)
+}
+
+// makeJWTSVID builds an unsigned JWT-SVID for the subject
+func makeJWTSVID(t *testing.T, subject string) string {
+	payload, err := json.Marshal(map[string]interface{}{"sub": subject, "aud": "vault"})
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func TestIDFromJWT(t *testing.T) {
+	id, err := IDFromJWT(makeJWTSVID(t, "spiffe://edgex/core-data"))
+	require.NoError(t, err)
+	assert.Equal(t, ID{TrustDomain: "edgex", Path: "/core-data"}, id)
+
+	_, err = IDFromJWT(makeJWTSVID(t, "core-data"))
+	require.Error(t, err)
+	_, err = IDFromJWT("not-a-jwt")
+	require.Error(t, err)
+}
+
+func TestRegisterSource(t *testing.T) {
+	RegisterSource("test-register", stubSource{})
+
+	source, ok := LookupSource("test-register")
+	require.True(t, ok)
+	assert.Equal(t, stubSource{}, source)
+
+	_, ok = LookupSource("unknown")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { RegisterSource("test-register", stubSource{}) })
+	assert.Panics(t, func() { RegisterSource("test-nil", nil) })
+}
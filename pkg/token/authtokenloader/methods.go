@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/fileioperformer"
 )
 
@@ -45,7 +46,15 @@ func (p *tokenProvider) Load(path string) (authToken string, err error) {
 		return
 	}
 	defer readCloser.Close()
+	// the token file may hold the root token, so the copy read is not left behind
+	defer secure.Wipe(fileContents)
 
+	return ParseToken(fileContents, path)
+}
+
+// ParseToken returns the token of the contents of a token file, i.e. as decrypted from an encrypted token file.
+// path is only used in the error message.
+func ParseToken(fileContents []byte, path string) (authToken string, err error) {
 	var parsedContents vaultTokenFile
 	err = json.Unmarshal(fileContents, &parsedContents)
 	if err != nil {
@@ -0,0 +1,164 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package encryptedtoken reads and writes token files encrypted for a service, so plaintext Vault tokens never sit
+// on disk. A token file is encrypted with AES-256-GCM under a key derived with HKDF-SHA256 from the key material of a
+// KeySource, i.e. a machine secret or a key held by the TPM or the kernel keyring, and bound to the service key, so
+// the token file of one service cannot be decrypted as that of another. Decrypted tokens only exist in memory.
+//
+// TPM and keyring access is not built in, as it needs platform libraries which are not dependencies of this
+// module. Such key material is provided by a KeySource registered with RegisterKeySource.
+package encryptedtoken
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/token/authtokenloader"
+)
+
+const (
+	fileVersion = 1
+	kdfHKDF     = "hkdf-sha256"
+
+	keyLength  = 32
+	saltLength = 16
+	// additionalDataPrefix is followed by the service key in the additional data binding the ciphertext to this file
+	// format and to the service
+	additionalDataPrefix = "edgex-token-file-v1:"
+)
+
+// envelope is the JSON document of an encrypted token file
+type envelope struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Encrypt encrypts the contents of a token file, i.e. the JSON response of the token creation, for the service with
+// the key material, returning the contents of the encrypted token file
+func Encrypt(plaintext []byte, keyMaterial []byte, serviceKey string) ([]byte, error) {
+	env := envelope{Version: fileVersion, KDF: kdfHKDF, Salt: make([]byte, saltLength)}
+	if _, err := rand.Read(env.Salt); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(keyMaterial, env.Salt, serviceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	env.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return nil, err
+	}
+	env.Ciphertext = aead.Seal(nil, env.Nonce, plaintext, additionalData(serviceKey))
+
+	return json.Marshal(env)
+}
+
+// Decrypt decrypts the contents of an encrypted token file of the service with the key material. The caller wipes
+// the returned plaintext with secure.Wipe once the token is read from it.
+func Decrypt(contents []byte, keyMaterial []byte, serviceKey string) ([]byte, error) {
+	var env envelope
+	if err := json.Unmarshal(contents, &env); err != nil {
+		return nil, fmt.Errorf("invalid encrypted token file: %s", err.Error())
+	}
+	if env.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported encrypted token file version %d", env.Version)
+	}
+	if env.KDF != kdfHKDF {
+		return nil, fmt.Errorf("unsupported key derivation '%s' in encrypted token file", env.KDF)
+	}
+	if len(env.Salt) < saltLength {
+		return nil, errors.New("encrypted token file has an invalid salt")
+	}
+
+	aead, err := newAEAD(keyMaterial, env.Salt, serviceKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, errors.New("encrypted token file has an invalid nonce")
+	}
+
+	plaintext, err := aead.Open(nil, env.Nonce, env.Ciphertext, additionalData(serviceKey))
+	if err != nil {
+		// the key material is wrong, the file was encrypted for another service or it was tampered with
+		return nil, errors.New("unable to decrypt the encrypted token file for this service")
+	}
+	return plaintext, nil
+}
+
+// ReadToken reads the encrypted token file at path and returns the token, decrypted with the key material of the
+// source for the service
+func ReadToken(ctx context.Context, path string, source KeySource, serviceKey string) (string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	keyMaterial, err := source.Key(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to obtain the token file key: %s", err.Error())
+	}
+	defer secure.Wipe(keyMaterial)
+
+	plaintext, err := Decrypt(contents, keyMaterial, serviceKey)
+	if err != nil {
+		return "", err
+	}
+	defer secure.Wipe(plaintext)
+
+	return authtokenloader.ParseToken(plaintext, path)
+}
+
+// newAEAD derives the AES key of the service from the key material and salt
+func newAEAD(keyMaterial []byte, salt []byte, serviceKey string) (cipher.AEAD, error) {
+	if len(keyMaterial) == 0 {
+		return nil, errors.New("token file key material is empty")
+	}
+	if serviceKey == "" {
+		return nil, errors.New("service key is required for encrypted token files")
+	}
+
+	key := make([]byte, keyLength)
+	defer secure.Wipe(key)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, keyMaterial, salt, additionalData(serviceKey)), key); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func additionalData(serviceKey string) []byte {
+	return []byte(additionalDataPrefix + serviceKey)
+}
@@ -0,0 +1,113 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package encryptedtoken
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTokenFile  = `{"auth":{"client_token":"service-token"}}`
+	testServiceKey = "core-data"
+)
+
+var testKeyMaterial = []byte("machine-secret")
+
+func TestEncryptDecrypt(t *testing.T) {
+	contents, err := Encrypt([]byte(testTokenFile), testKeyMaterial, testServiceKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(contents), "service-token")
+
+	plaintext, err := Decrypt(contents, testKeyMaterial, testServiceKey)
+	require.NoError(t, err)
+	assert.Equal(t, testTokenFile, string(plaintext))
+
+	tampered := func() []byte {
+		var env envelope
+		require.NoError(t, json.Unmarshal(contents, &env))
+		env.Ciphertext[0] ^= 0xff
+		encoded, err := json.Marshal(env)
+		require.NoError(t, err)
+		return encoded
+	}
+
+	tests := []struct {
+		name        string
+		contents    []byte
+		keyMaterial []byte
+		serviceKey  string
+	}{
+		{"Invalid - other service", contents, testKeyMaterial, "core-command"},
+		{"Invalid - wrong key material", contents, []byte("other-secret"), testServiceKey},
+		{"Invalid - no key material", contents, nil, testServiceKey},
+		{"Invalid - no service key", contents, testKeyMaterial, ""},
+		{"Invalid - tampered", tampered(), testKeyMaterial, testServiceKey},
+		{"Invalid - plaintext file", []byte(testTokenFile), testKeyMaterial, testServiceKey},
+		{"Invalid - not JSON", []byte("token"), testKeyMaterial, testServiceKey},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Decrypt(test.contents, test.keyMaterial, test.serviceKey)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReadToken(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "machine-id")
+	require.NoError(t, ioutil.WriteFile(keyFile, append(testKeyMaterial, '\n'), 0600))
+
+	contents, err := Encrypt([]byte(testTokenFile), testKeyMaterial, testServiceKey)
+	require.NoError(t, err)
+	tokenFile := filepath.Join(dir, "secrets-token.json.enc")
+	require.NoError(t, ioutil.WriteFile(tokenFile, contents, 0600))
+
+	token, err := ReadToken(context.Background(), tokenFile, NewFileKeySource(keyFile), testServiceKey)
+	require.NoError(t, err)
+	assert.Equal(t, "service-token", token)
+
+	_, err = ReadToken(context.Background(), tokenFile, NewFileKeySource(filepath.Join(dir, "missing")), testServiceKey)
+	require.Error(t, err)
+	_, err = ReadToken(context.Background(), filepath.Join(dir, "missing"), NewFileKeySource(keyFile), testServiceKey)
+	require.Error(t, err)
+}
+
+func TestRegisterKeySource(t *testing.T) {
+	source := KeySourceFunc(func(_ context.Context) ([]byte, error) {
+		return append([]byte(nil), testKeyMaterial...), nil
+	})
+
+	RegisterKeySource("test-tpm", source)
+	found, ok := LookupKeySource("test-tpm")
+	require.True(t, ok)
+	keyMaterial, err := found.Key(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testKeyMaterial, keyMaterial)
+
+	_, ok = LookupKeySource("unknown")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { RegisterKeySource("test-tpm", source) })
+	assert.Panics(t, func() { RegisterKeySource("test-nil", nil) })
+}
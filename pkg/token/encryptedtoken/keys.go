@@ -0,0 +1,90 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package encryptedtoken
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/secure"
+)
+
+// KeySource provides the key material token files are encrypted with
+type KeySource interface {
+	// Key returns the key material, a copy which the caller wipes after use
+	Key(ctx context.Context) ([]byte, error)
+}
+
+// KeySourceFunc adapts a function to a KeySource
+type KeySourceFunc func(ctx context.Context) ([]byte, error)
+
+// Key calls f
+func (f KeySourceFunc) Key(ctx context.Context) ([]byte, error) {
+	return f(ctx)
+}
+
+var (
+	sourcesMutex sync.RWMutex
+	sources      = make(map[string]KeySource)
+)
+
+// RegisterKeySource makes source available under name, which the KeySource of the token file encryption settings
+// selects. It is typically called at startup with a KeySource unsealing the key material from the TPM or reading it
+// from the kernel keyring. RegisterKeySource panics if source is nil or a source is already registered under name.
+func RegisterKeySource(name string, source KeySource) {
+	sourcesMutex.Lock()
+	defer sourcesMutex.Unlock()
+
+	if source == nil {
+		panic(fmt.Sprintf("encryptedtoken: RegisterKeySource source is nil for '%s'", name))
+	}
+	if _, exists := sources[name]; exists {
+		panic(fmt.Sprintf("encryptedtoken: RegisterKeySource called twice for '%s'", name))
+	}
+
+	sources[name] = source
+}
+
+// LookupKeySource returns the source registered under name
+func LookupKeySource(name string) (KeySource, bool) {
+	sourcesMutex.RLock()
+	defer sourcesMutex.RUnlock()
+
+	source, ok := sources[name]
+	return source, ok
+}
+
+// NewFileKeySource returns a KeySource reading the key material from the file at path, i.e. a machine secret such
+// as /etc/machine-id or a key the TPM was unsealed into on a tmpfs. Surrounding whitespace is not part of the key
+// material. The file is read for each key, so it is never kept in memory.
+func NewFileKeySource(path string) KeySource {
+	return KeySourceFunc(func(_ context.Context) ([]byte, error) {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		keyMaterial := append([]byte(nil), bytes.TrimSpace(contents)...)
+		secure.Wipe(contents)
+		if len(keyMaterial) == 0 {
+			return nil, errors.New("token file key material is empty")
+		}
+		return keyMaterial, nil
+	})
+}
@@ -0,0 +1,225 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package renewer keeps a secret store token alive by renewing it in the background before its TTL expires.
+package renewer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	defaultRenewFraction = 2.0 / 3.0
+	defaultJitter        = 0.1
+	defaultMaxRetries    = 3
+	defaultRetryInterval = time.Second
+)
+
+var (
+	// ErrMaxTTLReached is reported when a renewal no longer extends the token's lifetime, as its max TTL was reached
+	ErrMaxTTLReached = errors.New("token has reached its max TTL and can no longer be renewed")
+	// ErrAlreadyRunning is returned by Start when the TokenRenewer is already renewing a token
+	ErrAlreadyRunning = errors.New("token renewer is already running")
+)
+
+// Client renews a token, returning its new TTL. The secrets.SecretStoreClient satisfies this interface.
+type Client interface {
+	RenewToken(ctx context.Context, token string) (time.Duration, error)
+}
+
+// Options configures when a TokenRenewer renews and how it retries. Zero values select the defaults.
+type Options struct {
+	// RenewFraction is the fraction of the TTL which elapses before the token is renewed, defaults to 2/3
+	RenewFraction float64
+	// Jitter randomly shortens each renewal interval by up to this fraction of it, so many services started together
+	// don't renew at the same time. Defaults to 0.1, a negative value disables jitter.
+	Jitter float64
+	// MaxRetries is the number of times a failed renewal is retried before the renewal fails, defaults to 3.
+	// A negative value disables retries.
+	MaxRetries int
+	// RetryInterval is the wait before the first retry, which doubles for every following retry. Defaults to 1s.
+	RetryInterval time.Duration
+	// OnFailure, when set, is called with the token and the error when the renewal fails and the renewer stops
+	OnFailure func(token string, err error)
+}
+
+// TokenRenewer renews a token in a background goroutine until it is stopped or the renewal fails.
+// Failures are reported on the Failures channel and to the OnFailure callback.
+type TokenRenewer struct {
+	client   Client
+	lc       logger.LoggingClient
+	options  Options
+	failures chan error
+	// now abstracts the clock used to track the token expiry, which is most useful for testing
+	now func() time.Time
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTokenRenewer creates a TokenRenewer which renews tokens with the client
+func NewTokenRenewer(client Client, lc logger.LoggingClient, options Options) *TokenRenewer {
+	if options.RenewFraction <= 0 || options.RenewFraction >= 1 {
+		options.RenewFraction = defaultRenewFraction
+	}
+	if options.Jitter == 0 {
+		options.Jitter = defaultJitter
+	} else if options.Jitter < 0 {
+		options.Jitter = 0
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = defaultMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = defaultRetryInterval
+	}
+
+	return &TokenRenewer{
+		client:   client,
+		lc:       lc,
+		options:  options,
+		failures: make(chan error, 1),
+		now:      time.Now,
+	}
+}
+
+// Failures returns the channel on which the error is sent when the renewal fails. The send doesn't block, so a
+// failure is dropped when the previous one has not been received.
+func (r *TokenRenewer) Failures() <-chan error {
+	return r.failures
+}
+
+// Start renews token, which has ttl remaining, until ctx is cancelled, Stop is called or the renewal fails
+func (r *TokenRenewer) Start(ctx context.Context, token string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("token TTL must be positive to renew the token, got %v", ttl)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.done != nil {
+		select {
+		case <-r.done:
+		default:
+			return ErrAlreadyRunning
+		}
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	go r.run(ctx, r.done, token, ttl)
+
+	return nil
+}
+
+// Stop stops the renewal and waits for the background goroutine to exit
+func (r *TokenRenewer) Stop() {
+	r.mutex.Lock()
+	cancel, done := r.cancel, r.done
+	r.mutex.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (r *TokenRenewer) run(ctx context.Context, done chan struct{}, token string, ttl time.Duration) {
+	defer close(done)
+
+	expiry := r.now().Add(ttl)
+	for {
+		timer := time.NewTimer(r.renewInterval(ttl))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		newTTL, err := r.renew(ctx, token)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			newExpiry := r.now().Add(newTTL)
+			if newTTL <= 0 || !newExpiry.After(expiry) {
+				err = ErrMaxTTLReached
+			}
+			expiry = newExpiry
+		}
+		if err != nil {
+			r.fail(token, err)
+			return
+		}
+
+		r.lc.Debugf("token renewed, new TTL: %v", newTTL)
+		ttl = newTTL
+	}
+}
+
+// renewInterval returns the wait before renewing a token with ttl remaining, shortened by a random jitter
+func (r *TokenRenewer) renewInterval(ttl time.Duration) time.Duration {
+	interval := time.Duration(float64(ttl) * r.options.RenewFraction)
+	if jitter := int64(float64(interval) * r.options.Jitter); jitter > 0 {
+		interval -= time.Duration(rand.Int63n(jitter))
+	}
+	return interval
+}
+
+// renew renews the token, retrying with an exponential backoff
+func (r *TokenRenewer) renew(ctx context.Context, token string) (time.Duration, error) {
+	wait := r.options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		ttl, err := r.client.RenewToken(ctx, token)
+		if err == nil || attempt >= r.options.MaxRetries {
+			return ttl, err
+		}
+
+		r.lc.Warnf("unable to renew token, retrying in %v: %v", wait, err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+func (r *TokenRenewer) fail(token string, err error) {
+	r.lc.Errorf("token renewal stopped: %v", err)
+
+	select {
+	case r.failures <- err:
+	default:
+	}
+
+	if r.options.OnFailure != nil {
+		r.options.OnFailure(token, err)
+	}
+}
@@ -0,0 +1,146 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package renewer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// fakeClient returns the configured TTLs in order, repeating the last one, or always fails when err is set
+type fakeClient struct {
+	mutex sync.Mutex
+	calls int
+	ttls  []time.Duration
+	err   error
+}
+
+func (f *fakeClient) RenewToken(_ context.Context, token string) (time.Duration, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.calls++
+	if f.err != nil {
+		return 0, f.err
+	}
+	if token != "test-token" {
+		return 0, errors.New("unexpected token")
+	}
+	index := f.calls - 1
+	if index >= len(f.ttls) {
+		index = len(f.ttls) - 1
+	}
+	return f.ttls[index], nil
+}
+
+func (f *fakeClient) callCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.calls
+}
+
+func TestRenewsBeforeExpiry(t *testing.T) {
+	client := &fakeClient{ttls: []time.Duration{30 * time.Millisecond}}
+	renewer := NewTokenRenewer(client, logger.NewMockClient(), Options{})
+
+	require.NoError(t, renewer.Start(context.Background(), "test-token", 30*time.Millisecond))
+	assert.Equal(t, ErrAlreadyRunning, renewer.Start(context.Background(), "test-token", 30*time.Millisecond))
+
+	assert.Eventually(t, func() bool { return client.callCount() >= 3 }, time.Second, 5*time.Millisecond)
+	renewer.Stop()
+
+	calls := client.callCount()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, calls, client.callCount(), "no renewals are expected after Stop")
+	assert.Empty(t, renewer.Failures())
+
+	// a stopped renewer can be started again
+	require.NoError(t, renewer.Start(context.Background(), "test-token", 30*time.Millisecond))
+	renewer.Stop()
+}
+
+func TestRenewalFailure(t *testing.T) {
+	renewErr := errors.New("permission denied")
+
+	tests := []struct {
+		name     string
+		client   *fakeClient
+		expected error
+		calls    int
+	}{
+		{"Retries exhausted", &fakeClient{err: renewErr}, renewErr, 3},
+		{"Max TTL reached", &fakeClient{ttls: []time.Duration{30 * time.Millisecond, 5 * time.Millisecond}}, ErrMaxTTLReached, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var failedToken string
+			var failedErr error
+			var mutex sync.Mutex
+			renewer := NewTokenRenewer(test.client, logger.NewMockClient(), Options{
+				Jitter:        -1,
+				MaxRetries:    2,
+				RetryInterval: time.Millisecond,
+				OnFailure: func(token string, err error) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					failedToken, failedErr = token, err
+				},
+			})
+
+			require.NoError(t, renewer.Start(context.Background(), "test-token", 30*time.Millisecond))
+
+			select {
+			case err := <-renewer.Failures():
+				assert.Equal(t, test.expected, err)
+			case <-time.After(time.Second):
+				require.Fail(t, "renewal failure was not reported")
+			}
+			renewer.Stop()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			assert.Equal(t, "test-token", failedToken)
+			assert.Equal(t, test.expected, failedErr)
+			assert.Equal(t, test.calls, test.client.callCount())
+		})
+	}
+}
+
+func TestRenewInterval(t *testing.T) {
+	renewer := NewTokenRenewer(&fakeClient{}, logger.NewMockClient(), Options{RenewFraction: 0.5, Jitter: 0.2})
+
+	for i := 0; i < 100; i++ {
+		interval := renewer.renewInterval(time.Hour)
+		assert.True(t, interval <= 30*time.Minute && interval > 24*time.Minute, "unexpected interval %v", interval)
+	}
+
+	noJitter := NewTokenRenewer(&fakeClient{}, logger.NewMockClient(), Options{Jitter: -1})
+	assert.Equal(t, 40*time.Minute, noJitter.renewInterval(time.Hour))
+}
+
+func TestStartInvalidTTL(t *testing.T) {
+	renewer := NewTokenRenewer(&fakeClient{}, logger.NewMockClient(), Options{})
+	require.Error(t, renewer.Start(context.Background(), "test-token", 0))
+	renewer.Stop()
+}
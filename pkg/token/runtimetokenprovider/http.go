@@ -0,0 +1,112 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package runtimetokenprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// TokenAPI is the path of the HTTP/JSON endpoint of the runtime token provider
+const TokenAPI = "/api/v2/gettoken"
+
+// tokenResponse is the token creation response returned by the HTTP/JSON endpoint
+type tokenResponse struct {
+	Auth struct {
+		ClientToken   types.SecretString `json:"client_token"`
+		Accessor      string             `json:"accessor"`
+		LeaseDuration int                `json:"lease_duration"`
+		Renewable     bool               `json:"renewable"`
+	} `json:"auth"`
+}
+
+// errorResponse is the body of a failed request, either with the Vault errors or an EdgeX message
+type errorResponse struct {
+	Errors  []string `json:"errors"`
+	Message string   `json:"message"`
+}
+
+type httpTokenService struct {
+	baseURL string
+	caller  pkg.Caller
+}
+
+// NewHTTPTokenService creates a TokenService requesting the tokens from the HTTP/JSON endpoint of the runtime token
+// provider at baseURL, i.e. "https://edgex-security-spiffe-token-provider:59841", with caller. The transport errors
+// and the responses with one of the types.DefaultRetryableStatusCodes are returned as an ErrUnavailable.
+func NewHTTPTokenService(baseURL string, caller pkg.Caller) TokenService {
+	return &httpTokenService{baseURL: strings.TrimRight(baseURL, "/"), caller: caller}
+}
+
+func (s *httpTokenService) GetToken(ctx context.Context, serviceKey string) (Token, error) {
+	tokenURL := s.baseURL + TokenAPI + "?" + url.Values{"service_key": {serviceKey}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.caller.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return Token{}, err
+		}
+		return Token{}, NewErrUnavailable(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Token{}, NewErrUnavailable(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var failure errorResponse
+		_ = json.Unmarshal(body, &failure)
+		if failure.Message != "" {
+			failure.Errors = append(failure.Errors, failure.Message)
+		}
+		description := fmt.Sprintf("runtime token provider responded %d for '%s'", resp.StatusCode, serviceKey)
+		if len(failure.Errors) > 0 {
+			description += ": " + strings.Join(failure.Errors, ", ")
+		}
+		err := pkg.NewErrSecretStoreFromResponse(description, resp.StatusCode, failure.Errors)
+		if (types.RetryPolicy{}).Retryable(resp.StatusCode) {
+			return Token{}, NewErrUnavailable(err)
+		}
+		return Token{}, err
+	}
+
+	var response tokenResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Token{}, fmt.Errorf("unable to parse the runtime token provider response: %w", err)
+	}
+
+	return Token{
+		ClientToken: response.Auth.ClientToken,
+		Accessor:    response.Auth.Accessor,
+		TTL:         time.Duration(response.Auth.LeaseDuration) * time.Second,
+		Renewable:   response.Auth.Renewable,
+	}, nil
+}
@@ -0,0 +1,78 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package runtimetokenprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+func TestHTTPTokenService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != TokenAPI || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Query().Get("service_key") {
+		case "core-data":
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"token","accessor":"accessor","lease_duration":3600,"renewable":true}}`))
+		case "starting":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "unknown":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"apiVersion":"v2","message":"service is not allowed a token","statusCode":403}`))
+		default:
+			_, _ = w.Write([]byte(`not json`))
+		}
+	}))
+	defer server.Close()
+
+	service := NewHTTPTokenService(server.URL+"/", http.DefaultClient)
+
+	token, err := service.GetToken(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.Equal(t, Token{ClientToken: "token", Accessor: "accessor", TTL: time.Hour, Renewable: true}, token)
+
+	_, err = service.GetToken(context.Background(), "starting")
+	require.Error(t, err)
+	assert.IsType(t, ErrUnavailable{}, err)
+
+	_, err = service.GetToken(context.Background(), "unknown")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pkg.ErrPermissionDenied{}))
+	assert.Contains(t, err.Error(), "service is not allowed a token")
+
+	_, err = service.GetToken(context.Background(), "invalid")
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &ErrUnavailable{}))
+}
+
+func TestHTTPTokenServiceUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	_, err := NewHTTPTokenService(server.URL, http.DefaultClient).GetToken(context.Background(), "core-data")
+	require.Error(t, err)
+	assert.IsType(t, ErrUnavailable{}, err)
+}
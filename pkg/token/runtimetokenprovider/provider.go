@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package runtimetokenprovider obtains the secret store token of a service on demand from a runtime token provider
+// service, which issues per-service tokens when they are requested rather than the security bootstrapper writing a
+// token file for each service up front.
+//
+// The token provider service is defined over gRPC, which is not a dependency of this module. NewHTTPTokenService
+// reaches the HTTP/JSON endpoint served next to it, while a generated gRPC client is adapted to a TokenService with
+// TokenServiceFunc, mapping the Unavailable, DeadlineExceeded and ResourceExhausted codes to NewErrUnavailable so
+// they are retried.
+package runtimetokenprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	// DefaultTimeout is the deadline of each token request when Options.Timeout is not set
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxAttempts is the number of token requests made when Options.Retry.MaxAttempts is not set
+	DefaultMaxAttempts = 5
+
+	defaultInitialInterval = time.Second
+	defaultMaxInterval     = 30 * time.Second
+)
+
+// Token is a secret store token issued for a service
+type Token struct {
+	ClientToken types.SecretString
+	Accessor    string
+	// TTL is how long the token is valid for, 0 for a token which does not expire
+	TTL       time.Duration
+	Renewable bool
+}
+
+// TokenService issues the tokens of the services
+type TokenService interface {
+	// GetToken issues a token for the service identified by serviceKey
+	GetToken(ctx context.Context, serviceKey string) (Token, error)
+}
+
+// TokenServiceFunc is an adapter to use a function as a TokenService
+type TokenServiceFunc func(ctx context.Context, serviceKey string) (Token, error)
+
+// GetToken calls f(ctx, serviceKey)
+func (f TokenServiceFunc) GetToken(ctx context.Context, serviceKey string) (Token, error) {
+	return f(ctx, serviceKey)
+}
+
+var (
+	servicesMutex sync.RWMutex
+	services      = make(map[string]TokenService)
+)
+
+// RegisterTokenService makes service available under name, which the Service of the runtime token provider
+// settings selects, i.e. to use a gRPC client. RegisterTokenService panics if service is nil or a service is already
+// registered under name.
+func RegisterTokenService(name string, service TokenService) {
+	servicesMutex.Lock()
+	defer servicesMutex.Unlock()
+
+	if service == nil {
+		panic(fmt.Sprintf("runtimetokenprovider: RegisterTokenService service is nil for '%s'", name))
+	}
+	if _, exists := services[name]; exists {
+		panic(fmt.Sprintf("runtimetokenprovider: RegisterTokenService called twice for '%s'", name))
+	}
+
+	services[name] = service
+}
+
+// LookupTokenService returns the service registered under name
+func LookupTokenService(name string) (TokenService, bool) {
+	servicesMutex.RLock()
+	defer servicesMutex.RUnlock()
+
+	service, ok := services[name]
+	return service, ok
+}
+
+// ErrUnavailable error when the token provider failed transiently, i.e. it could not be reached, which is retried
+type ErrUnavailable struct {
+	cause error
+}
+
+func (e ErrUnavailable) Error() string {
+	return fmt.Sprintf("runtime token provider is unavailable: %s", e.cause.Error())
+}
+
+// Unwrap returns the error causing the failure
+func (e ErrUnavailable) Unwrap() error {
+	return e.cause
+}
+
+// NewErrUnavailable creates an ErrUnavailable error caused by err
+func NewErrUnavailable(err error) ErrUnavailable {
+	return ErrUnavailable{cause: err}
+}
+
+// Options configures a Provider. The zero value of each option selects its default.
+type Options struct {
+	// Timeout is the deadline of each token request, defaults to DefaultTimeout
+	Timeout time.Duration
+	// Retry is how the token requests failing with an ErrUnavailable, or exceeding their deadline, are retried.
+	// Its MaxAttempts defaults to DefaultMaxAttempts, so that a token provider starting along with the service is
+	// waited for, and an InitialInterval of 1s doubles up to a MaxInterval of 30s. MaxAttempts of 1 disables retries.
+	Retry types.RetryPolicy
+}
+
+// Provider requests the token of one service from a TokenService, retrying the requests which fail transiently
+type Provider struct {
+	service    TokenService
+	serviceKey string
+	lc         logger.LoggingClient
+	options    Options
+}
+
+// NewProvider creates a Provider requesting the token of the service identified by serviceKey from service
+func NewProvider(service TokenService, serviceKey string, lc logger.LoggingClient, options Options) *Provider {
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+	if options.Retry.MaxAttempts == 0 {
+		options.Retry.MaxAttempts = DefaultMaxAttempts
+	}
+	if options.Retry.InitialInterval <= 0 {
+		options.Retry.InitialInterval = defaultInitialInterval
+	}
+	if options.Retry.MaxInterval <= 0 {
+		options.Retry.MaxInterval = defaultMaxInterval
+	}
+
+	return &Provider{service: service, serviceKey: serviceKey, lc: lc, options: options}
+}
+
+// GetToken requests a token for the service. Each request has the Timeout as deadline, and the requests which fail
+// transiently are retried until the Retry policy gives up or ctx is done.
+func (p *Provider) GetToken(ctx context.Context) (Token, error) {
+	if p.serviceKey == "" {
+		return Token{}, errors.New("a service key is required to request a token from the runtime token provider")
+	}
+
+	wait := p.options.Retry.InitialInterval
+	for attempt := 1; ; attempt++ {
+		token, err := p.getToken(ctx)
+		if err == nil {
+			if token.ClientToken == "" {
+				return Token{}, fmt.Errorf("runtime token provider issued no token for '%s'", p.serviceKey)
+			}
+			return token, nil
+		}
+
+		if !p.transient(ctx, err) || attempt >= p.options.Retry.MaxAttempts {
+			return Token{}, err
+		}
+
+		delay := jitter(wait, p.options.Retry.Jitter)
+		p.lc.Warnf("unable to get the token of '%s' from the runtime token provider, retrying in %v (attempt %d of %d): %s",
+			p.serviceKey, delay, attempt+1, p.options.Retry.MaxAttempts, err.Error())
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Token{}, ctx.Err()
+		case <-timer.C:
+		}
+
+		wait *= 2
+		if wait > p.options.Retry.MaxInterval {
+			wait = p.options.Retry.MaxInterval
+		}
+	}
+}
+
+// getToken makes one token request with the request deadline
+func (p *Provider) getToken(ctx context.Context) (Token, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, p.options.Timeout)
+	defer cancel()
+
+	token, err := p.service.GetToken(requestCtx, p.serviceKey)
+	if err != nil && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		// only the deadline of the request was exceeded, not that of the caller
+		err = NewErrUnavailable(fmt.Errorf("request exceeded its %v deadline", p.options.Timeout))
+	}
+	return token, err
+}
+
+// transient reports whether the failed request is retried, which it is not once ctx is done
+func (p *Provider) transient(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var unavailable ErrUnavailable
+	return errors.As(err, &unavailable)
+}
+
+// jitter randomizes wait by up to fraction of it
+func jitter(wait time.Duration, fraction float64) time.Duration {
+	if fraction <= 0 {
+		return wait
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	return time.Duration(float64(wait) * (1 - fraction + 2*fraction*rand.Float64()))
+}
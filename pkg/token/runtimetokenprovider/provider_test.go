@@ -0,0 +1,154 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package runtimetokenprovider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+var fastRetry = types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+// stubService fails with the errors in turn before issuing the token
+type stubService struct {
+	mutex       sync.Mutex
+	errors      []error
+	serviceKeys []string
+}
+
+func (s *stubService) GetToken(ctx context.Context, serviceKey string) (Token, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.serviceKeys = append(s.serviceKeys, serviceKey)
+	if len(s.errors) > 0 {
+		err := s.errors[0]
+		s.errors = s.errors[1:]
+		return Token{}, err
+	}
+	return Token{ClientToken: "token", Accessor: "accessor", TTL: time.Hour, Renewable: true}, nil
+}
+
+func TestGetToken(t *testing.T) {
+	unavailable := NewErrUnavailable(errors.New("connection refused"))
+	denied := errors.New("permission denied")
+
+	tests := []struct {
+		name             string
+		errors           []error
+		expectError      error
+		expectedRequests int
+	}{
+		{"Issued", nil, nil, 1},
+		{"Retried until issued", []error{unavailable, unavailable}, nil, 3},
+		{"Retries exhausted", []error{unavailable, unavailable, unavailable}, unavailable, 3},
+		{"Permanent failure not retried", []error{denied}, denied, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &stubService{errors: test.errors}
+			provider := NewProvider(service, "core-data", logger.NewMockClient(), Options{Retry: fastRetry})
+
+			token, err := provider.GetToken(context.Background())
+			assert.Len(t, service.serviceKeys, test.expectedRequests)
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, test.expectError))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, types.SecretString("token"), token.ClientToken)
+			assert.Equal(t, time.Hour, token.TTL)
+			assert.Equal(t, []string{"core-data"}, service.serviceKeys[:1])
+		})
+	}
+}
+
+func TestGetTokenDeadline(t *testing.T) {
+	var attempts int
+	var mutex sync.Mutex
+	service := TokenServiceFunc(func(ctx context.Context, _ string) (Token, error) {
+		mutex.Lock()
+		attempts++
+		attempt := attempts
+		mutex.Unlock()
+
+		if attempt == 1 {
+			// the first request hangs until its deadline
+			<-ctx.Done()
+			return Token{}, ctx.Err()
+		}
+		return Token{ClientToken: "token"}, nil
+	})
+
+	provider := NewProvider(service, "core-data", logger.NewMockClient(), Options{Timeout: 10 * time.Millisecond, Retry: fastRetry})
+	token, err := provider.GetToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, types.SecretString("token"), token.ClientToken)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestGetTokenCanceled(t *testing.T) {
+	service := &stubService{errors: []error{NewErrUnavailable(errors.New("connection refused"))}}
+	provider := NewProvider(service, "core-data", logger.NewMockClient(),
+		Options{Retry: types.RetryPolicy{MaxAttempts: 3, InitialInterval: time.Hour}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := provider.GetToken(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Len(t, service.serviceKeys, 1)
+}
+
+func TestGetTokenInvalid(t *testing.T) {
+	_, err := NewProvider(&stubService{}, "", logger.NewMockClient(), Options{}).GetToken(context.Background())
+	require.Error(t, err, "no service key")
+
+	empty := TokenServiceFunc(func(_ context.Context, _ string) (Token, error) { return Token{}, nil })
+	_, err = NewProvider(empty, "core-data", logger.NewMockClient(), Options{}).GetToken(context.Background())
+	require.Error(t, err, "no token issued")
+}
+
+func TestRegisterTokenService(t *testing.T) {
+	service := &stubService{}
+	RegisterTokenService("test-register", service)
+
+	actual, ok := LookupTokenService("test-register")
+	require.True(t, ok)
+	assert.Equal(t, service, actual)
+
+	_, ok = LookupTokenService("unknown")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { RegisterTokenService("test-register", service) })
+	assert.Panics(t, func() { RegisterTokenService("test-nil", nil) })
+}
@@ -0,0 +1,153 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+const (
+	defaultRequestTimeout      = 30 * time.Second
+	defaultDialTimeout         = 30 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 10
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// NewHTTPClient creates the HTTP client used to reach a secret store with the timeouts, connection pooling and
+// proxy of info, applying the defaults to unset values. tlsConfig is optional. In FIPS mode a copy of tlsConfig is
+// restricted to the FIPS approved TLS settings, returning an error when it is configured otherwise.
+func NewHTTPClient(info types.TransportInfo, tlsConfig *tls.Config) (*http.Client, error) {
+	proxy, err := newProxy(info)
+	if err != nil {
+		return nil, err
+	}
+
+	if fips.Enabled() {
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		} else {
+			tlsConfig = tlsConfig.Clone()
+		}
+		if err := fips.ConfigureTLS(tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return newHTTPClient(info, tlsConfig, proxy, ""), nil
+}
+
+// NewUnixSocketHTTPClient creates the HTTP client used to reach a secret store listening on the Unix domain socket
+// at socketPath, i.e. a local Vault Agent, with the timeouts and connection pooling of info. Requests to the socket
+// are never proxied.
+func NewUnixSocketHTTPClient(socketPath string, info types.TransportInfo) (*http.Client, error) {
+	if socketPath == "" {
+		return nil, NewErrSecretStore("socket path is required for the unix protocol")
+	}
+
+	return newHTTPClient(info, nil, nil, socketPath), nil
+}
+
+// newHTTPClient creates the client, which connects to socketPath instead of the host of the request when it is set
+func newHTTPClient(info types.TransportInfo, tlsConfig *tls.Config, proxy func(*http.Request) (*url.URL, error),
+	socketPath string) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   durationOrDefault(info.DialTimeout, defaultDialTimeout),
+		KeepAlive: defaultKeepAlive,
+	}
+	if info.KeepAlive != 0 {
+		// unlike the timeouts, net.Dialer disables keep-alive probes with a negative interval
+		dialer.KeepAlive = info.KeepAlive
+	}
+
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   durationOrDefault(info.TLSHandshakeTimeout, defaultTLSHandshakeTimeout),
+		MaxIdleConns:          intOrDefault(info.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   intOrDefault(info.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		IdleConnTimeout:       durationOrDefault(info.IdleConnTimeout, defaultIdleConnTimeout),
+		ExpectContinueTimeout: time.Second,
+	}
+
+	if socketPath != "" {
+		transport.DialContext = func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+	}
+
+	return &http.Client{
+		Timeout:   durationOrDefault(info.RequestTimeout, defaultRequestTimeout),
+		Transport: transport,
+	}
+}
+
+// newProxy returns the proxy selection of the transport, which is nil to connect directly
+func newProxy(info types.TransportInfo) (func(*http.Request) (*url.URL, error), error) {
+	switch {
+	case info.DisableProxy:
+		return nil, nil
+	case info.ProxyURL == "":
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(info.ProxyURL)
+	if err != nil {
+		return nil, NewErrSecretStore(fmt.Sprintf("invalid ProxyURL in config: %s", err.Error()))
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, NewErrSecretStore(fmt.Sprintf("unsupported ProxyURL scheme '%s' in config, expected http, https or socks5",
+			proxyURL.Scheme))
+	}
+	if proxyURL.Host == "" {
+		return nil, NewErrSecretStore("ProxyURL in config has no host")
+	}
+
+	return http.ProxyURL(proxyURL), nil
+}
+
+// durationOrDefault returns fallback for a zero duration, and zero, which disables the timeout, for a negative one
+func durationOrDefault(duration time.Duration, fallback time.Duration) time.Duration {
+	switch {
+	case duration == 0:
+		return fallback
+	case duration < 0:
+		return 0
+	default:
+		return duration
+	}
+}
+
+func intOrDefault(value int, fallback int) int {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
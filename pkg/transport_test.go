@@ -0,0 +1,141 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package pkg
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	client, err := NewHTTPClient(types.TransportInfo{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultRequestTimeout, client.Timeout)
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, defaultTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	assert.Equal(t, defaultMaxIdleConns, transport.MaxIdleConns)
+	assert.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, defaultIdleConnTimeout, transport.IdleConnTimeout)
+	if fips.Enabled() {
+		// FIPS mode always restricts TLS, see TestNewHTTPClientFIPS
+		require.NotNil(t, transport.TLSClientConfig)
+	} else {
+		assert.Nil(t, transport.TLSClientConfig)
+	}
+
+	tlsConfig := &tls.Config{ServerName: "edgex-vault"}
+	client, err = NewHTTPClient(types.TransportInfo{
+		RequestTimeout:      -1,
+		TLSHandshakeTimeout: 5 * time.Second,
+		MaxIdleConns:        20,
+		MaxIdleConnsPerHost: 20,
+		IdleConnTimeout:     time.Minute,
+	}, tlsConfig)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), client.Timeout, "negative timeout disables it")
+	transport = client.Transport.(*http.Transport)
+	assert.Equal(t, 5*time.Second, transport.TLSHandshakeTimeout)
+	assert.Equal(t, 20, transport.MaxIdleConns)
+	assert.Equal(t, 20, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+	if fips.Enabled() {
+		// the restricted config is a clone of the config of the caller
+		assert.Equal(t, tlsConfig.ServerName, transport.TLSClientConfig.ServerName)
+	} else {
+		assert.Equal(t, tlsConfig, transport.TLSClientConfig)
+	}
+}
+
+func TestNewHTTPClientFIPS(t *testing.T) {
+	fips.SetEnabled(true)
+	defer fips.SetEnabled(false)
+
+	client, err := NewHTTPClient(types.TransportInfo{}, nil)
+	require.NoError(t, err)
+	tlsConfig := client.Transport.(*http.Transport).TLSClientConfig
+	require.NotNil(t, tlsConfig)
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	assert.Equal(t, uint16(0), tlsConfig.MaxVersion, "TLS 1.3 is not downgraded")
+	assert.Equal(t, fips.CipherSuites, tlsConfig.CipherSuites)
+
+	configured := &tls.Config{ServerName: "edgex-vault"}
+	client, err = NewHTTPClient(types.TransportInfo{}, configured)
+	require.NoError(t, err)
+	tlsConfig = client.Transport.(*http.Transport).TLSClientConfig
+	assert.Equal(t, "edgex-vault", tlsConfig.ServerName)
+	assert.Equal(t, fips.CurvePreferences, tlsConfig.CurvePreferences)
+	assert.Nil(t, configured.CipherSuites, "the config of the caller is not modified")
+
+	client, err = NewHTTPClient(types.TransportInfo{}, &tls.Config{MinVersion: tls.VersionTLS13})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), client.Transport.(*http.Transport).TLSClientConfig.MinVersion)
+
+	_, err = NewHTTPClient(types.TransportInfo{}, &tls.Config{MaxVersion: tls.VersionTLS11})
+	require.Error(t, err)
+	assert.IsType(t, fips.ErrNotApproved{}, err)
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client, err := NewHTTPClient(types.TransportInfo{ProxyURL: proxy.URL}, nil)
+	require.NoError(t, err)
+	resp, err := client.Get("http://vault.edgex.invalid:8200/v1/sys/health")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, "http://vault.edgex.invalid:8200/v1/sys/health", proxied)
+
+	client, err = NewHTTPClient(types.TransportInfo{ProxyURL: proxy.URL, DisableProxy: true}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, client.Transport.(*http.Transport).Proxy)
+
+	client, err = NewHTTPClient(types.TransportInfo{ProxyURL: "socks5://proxy.edgex:1080"}, nil)
+	require.NoError(t, err)
+	proxyURL, err := client.Transport.(*http.Transport).Proxy(httptest.NewRequest(http.MethodGet, "https://vault:8200", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "socks5://proxy.edgex:1080", proxyURL.String())
+
+	for _, invalid := range []string{"ftp://proxy.edgex", "http://", "://proxy"} {
+		_, err = NewHTTPClient(types.TransportInfo{ProxyURL: invalid}, nil)
+		require.Error(t, err, invalid)
+		assert.IsType(t, ErrSecretStore{}, err)
+	}
+}
+
+func TestNewUnixSocketHTTPClient(t *testing.T) {
+	client, err := NewUnixSocketHTTPClient("/run/vault-agent/agent.sock", types.TransportInfo{ProxyURL: "http://proxy:3128"})
+	require.NoError(t, err)
+	transport := client.Transport.(*http.Transport)
+	assert.Nil(t, transport.Proxy, "socket is never proxied")
+	assert.Nil(t, transport.TLSClientConfig)
+
+	_, err = NewUnixSocketHTTPClient("", types.TransportInfo{})
+	require.Error(t, err)
+}
@@ -17,22 +17,62 @@ package types
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
+// UnixProtocol is the Protocol of a secret store listening on a Unix domain socket, such as a local Vault Agent
+// listener. The Host is then the path of the socket and the Port is not used.
+const UnixProtocol = "unix"
+
 // SecretConfig contains configuration settings used to communicate with an HTTP based secret provider
 type SecretConfig struct {
 	Type string
 	Host string
 	Port int
 	// Path is the base path to the secret's location in the secret store
-	Path           string
-	Protocol       string
-	Namespace      string
+	Path string
+	// KVMount is the mount point of the KV secrets engine holding Path, which is used to build the KV v2 data and
+	// metadata paths. Defaults to the first segment of Path after /v1/, i.e. "secret" for /v1/secret/edgex/.
+	KVMount string
+	// KVVersion is the version of the KV secrets engine holding Path: "1" (the default), "2", or "auto" to detect
+	// it from the mount. GetSecrets, StoreSecrets and ListSecretPaths use the KV v2 data and metadata paths for "2".
+	KVVersion string
+	// Protocol is "http", "https" or UnixProtocol to reach a Vault Agent listening on the socket at Host
+	Protocol string
+	// Namespace is the Vault Enterprise namespace of every request, which pkg.WithNamespace overrides per call
+	Namespace string
+	// RootCaCertPath is the CA certificate bundle trusted for the secret store, which is reloaded when it changes
 	RootCaCertPath string
-	ServerName     string
+	// ServerName overrides the host name the certificate of the secret store is verified against, also sent as SNI
+	ServerName string
+	// TLS contains the further TLS settings used to reach the secret store
+	TLS TLSInfo
+	// Compatibility selects the flavor of the Vault API server: "vault" (the default), "openbao", or "auto"
+	// to detect the flavor from the version reported by the server's health endpoint
+	Compatibility  string
 	Authentication AuthenticationInfo
+	// Retry is the retry policy of the requests to a Vault secret store, which is overridden per call with
+	// pkg.WithRetryPolicy. Requests are not retried by default.
+	Retry RetryPolicy
+	// Transport contains the timeouts and connection pooling of the HTTP client used to reach the secret store
+	Transport TransportInfo
+	// AWS contains the settings used when Type is "aws"
+	AWS AWSInfo
+	// Azure contains the settings used when Type is "azure"
+	Azure AzureInfo
+	// Kubernetes contains the settings used when Type is "kubernetes"
+	Kubernetes KubernetesInfo
+	// File contains the settings used when Type is "file"
+	File FileInfo
+	// GCP contains the settings used when Type is "gcp"
+	GCP GCPInfo
+	// Conjur contains the settings used when Type is "conjur"
+	Conjur ConjurInfo
+	// OnePassword contains the settings used when Type is "onepassword"
+	OnePassword OnePasswordInfo
 }
 
 // BuildURL constructs a URL which can be used to identify a HTTP based secret provider
@@ -50,6 +90,11 @@ func (c SecretConfig) BuildURL(path string) (string, error) {
 		return "", fmt.Errorf("unable to build URL: Host not set. Please check configuration settings")
 	}
 
+	if c.Protocol == UnixProtocol {
+		// the requests are HTTP over the socket, so the host of the URL only ends up in the Host header
+		return "http://localhost" + path, nil
+	}
+
 	if c.Port == 0 {
 		return "", fmt.Errorf("unable to build URL: Port not set. Please check configuration settings")
 	}
@@ -72,8 +117,369 @@ func (c SecretConfig) BuildSecretsPathURL(subPath string) (string, error) {
 	return c.BuildURL(c.Path + subPath)
 }
 
+// TLSInfo contains the TLS settings used to reach the secret store beyond the RootCaCertPath and ServerName
+type TLSInfo struct {
+	// RootCaCertPEM is a PEM encoded CA certificate bundle trusted for the secret store, used when RootCaCertPath is
+	// not set, i.e. when the CA is injected through an environment variable
+	RootCaCertPEM string
+	// ClientCertPath and ClientKeyPath are the PEM encoded client certificate and private key presented for mutual
+	// TLS. The files are reloaded when they change. The cert auth method presents its own certificate instead.
+	ClientCertPath string
+	ClientKeyPath  string
+	// MinVersion is the minimum TLS version negotiated: "1.2" (the default) or "1.3". Both are allowed in FIPS mode,
+	// which restricts the cipher suites, see the fips package
+	MinVersion string
+}
+
+// TransportInfo contains the settings of the HTTP client used to reach an HTTP based secret store.
+// The zero value of each setting selects its default, while a negative duration disables the timeout.
+type TransportInfo struct {
+	// RequestTimeout bounds each request, including reading the response body, defaults to 30s
+	RequestTimeout time.Duration
+	// DialTimeout bounds establishing a TCP connection, defaults to 30s
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout bounds the TLS handshake of a new connection, defaults to 10s
+	TLSHandshakeTimeout time.Duration
+	// KeepAlive is the interval of the TCP keep-alive probes of idle connections, defaults to 30s
+	KeepAlive time.Duration
+	// MaxIdleConns limits the idle connections kept open for reuse, defaults to 100
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the idle connections kept open to the secret store, defaults to 10
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept open, defaults to 90s
+	IdleConnTimeout time.Duration
+	// ProxyURL is the HTTP, HTTPS or SOCKS5 proxy the secret store is reached through, i.e. "socks5://proxy:1080".
+	// Without it the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored.
+	ProxyURL string
+	// DisableProxy connects to the secret store directly, ignoring both ProxyURL and the environment variables
+	DisableProxy bool
+}
+
 // AuthenticationInfo contains authentication information to be used when communicating with an HTTP based provider
 type AuthenticationInfo struct {
 	AuthType  string
 	AuthToken string
+	// Method selects how the token is obtained: "token" (the default) uses AuthToken as is, "agent" leaves it to a
+	// co-located Vault Agent, using AuthToken only when no agent is detected, while an auth method such as
+	// "approle" logs in to the secret store to obtain the token. Methods registered with pkg.RegisterAuthMethod,
+	// including the built-in "file" and "env", can be selected as well.
+	Method string
+	// AppRole contains the settings used when Method is "approle"
+	AppRole AppRoleInfo
+	// Kubernetes contains the settings used when Method is "kubernetes"
+	Kubernetes KubernetesAuthInfo
+	// Cert contains the settings used when Method is "cert"
+	Cert CertAuthInfo
+	// JWT contains the settings used when Method is "jwt"
+	JWT JWTAuthInfo
+	// AWS contains the settings used when Method is "aws"
+	AWS AWSAuthInfo
+	// Userpass contains the settings used when Method is "userpass"
+	Userpass UserpassInfo
+	// SPIFFE contains the settings used when Method is "spiffe"
+	SPIFFE SPIFFEAuthInfo
+	// RuntimeTokenProvider contains the settings used when Method is "runtime"
+	RuntimeTokenProvider RuntimeTokenProviderInfo
+	// RevokeTokenOnShutdown revokes the token when the client is shut down, so no live token is left behind by a
+	// terminated service. It leaves the service to obtain a new token through its auth method when restarted.
+	RevokeTokenOnShutdown bool
+	// ExchangeChildToken exchanges the token for the child token described by ChildToken whenever a token is
+	// obtained, so the service only holds a short-lived token narrowed to its own policies
+	ExchangeChildToken bool
+	ChildToken         TokenExchangeRequest
+	// TokenFile is the JSON token file read when Method is "file"
+	TokenFile string
+	// TokenFileEncryption contains the settings used to decrypt the TokenFile when it is encrypted for the service
+	TokenFileEncryption TokenFileEncryptionInfo
+	// TokenFileWatchInterval is how often the TokenFile is checked for a re-issued token, which replaces the token
+	// in use when the file changes. Defaults to 5s, a negative interval disables the watch.
+	TokenFileWatchInterval time.Duration
+	// TokenEnvVar is the environment variable read when Method is "env", defaults to "VAULT_TOKEN"
+	TokenEnvVar string
+	// DevMode enables the auth methods which are only suitable for local development, such as "userpass".
+	// It must never be set in production deployments.
+	DevMode bool
+}
+
+// TokenFileEncryptionInfo contains the settings of a token file encrypted for the service with the encryptedtoken
+// package. The token file is encrypted when KeySource or KeyFile is set.
+type TokenFileEncryptionInfo struct {
+	// ServiceKey is the key of the service the token file was encrypted for
+	ServiceKey string
+	// KeySource is the name of the encryptedtoken.KeySource providing the key material, i.e. a TPM or keyring
+	// source registered with encryptedtoken.RegisterKeySource
+	KeySource string
+	// KeyFile is read for the key material when KeySource is not set, i.e. a machine secret such as /etc/machine-id
+	KeyFile string
+}
+
+// Enabled reports whether the token file is encrypted
+func (info TokenFileEncryptionInfo) Enabled() bool {
+	return info.KeySource != "" || info.KeyFile != ""
+}
+
+// AppRoleInfo contains the credentials used to log in with the AppRole auth method
+type AppRoleInfo struct {
+	// MountPath is where the AppRole auth method is enabled, defaults to "approle"
+	MountPath string
+	// RoleId is the role id, RoleIdFile is read for the role id when RoleId is not set
+	RoleId     string
+	RoleIdFile string
+	// SecretId is the secret id, SecretIdFile is read for the secret id when SecretId is not set
+	SecretId     string
+	SecretIdFile string
+	// SecretIdWrapped indicates the secret id is a response wrapping token which is unwrapped to obtain the secret id
+	SecretIdWrapped bool
+}
+
+// KubernetesAuthInfo contains the settings used to log in with the Kubernetes auth method
+type KubernetesAuthInfo struct {
+	// MountPath is where the Kubernetes auth method is enabled, defaults to "kubernetes"
+	MountPath string
+	// Role is the Vault role bound to the pod's service account
+	Role string
+	// TokenFile is the service account JWT, which is read on every login as projected tokens are rotated.
+	// Defaults to the in-cluster service account token.
+	TokenFile string
+}
+
+// CertAuthInfo contains the client certificate used to log in with the TLS certificate auth method.
+// The RootCaCertPath or TLS.RootCaCertPEM must also be set, pinning the CA of the Vault server the certificate is
+// presented to.
+type CertAuthInfo struct {
+	// MountPath is where the cert auth method is enabled, defaults to "cert"
+	MountPath string
+	// Name of the certificate role to authenticate against, otherwise Vault tries every role matching the certificate
+	Name string
+	// CertPath and KeyPath are the PEM encoded client certificate and private key. The files are reloaded when
+	// they change, so rotated certificates are used for subsequent logins.
+	CertPath string
+	KeyPath  string
+}
+
+// JWTAuthInfo contains the settings used to log in with the JWT/OIDC auth method, exchanging a workload identity
+// JWT such as a SPIFFE JWT-SVID or a cloud provider identity token for a Vault token
+type JWTAuthInfo struct {
+	// MountPath is where the JWT auth method is enabled, defaults to "jwt"
+	MountPath string
+	// Role is the Vault role to log in with, otherwise the default role of the auth method is used
+	Role string
+	// Token is the JWT, TokenFile is read for the JWT on every login when Token is not set
+	Token     string
+	TokenFile string
+	// Audience, when set, must be one of the JWT's audiences. It is checked before the JWT is sent to Vault.
+	Audience string
+}
+
+// SPIFFEAuthInfo contains the settings used to log in with the SPIFFE identity of the workload, either presenting
+// its X.509-SVID to the cert auth method or exchanging its JWT-SVID through the JWT auth method. The SVIDs are
+// fetched again for every login and TLS handshake, so those rotated by SPIRE are used without restarting.
+type SPIFFEAuthInfo struct {
+	// SVIDType is the SVID logged in with: "jwt" (the default) or "x509". The RootCaCertPath or TLS.RootCaCertPEM
+	// must also be set for "x509", pinning the CA of the Vault server the X.509-SVID is presented to.
+	SVIDType string
+	// MountPath is where the auth method is enabled, defaults to "jwt" or "cert" for the SVIDType
+	MountPath string
+	// Role is the JWT role or the name of the certificate role to log in with
+	Role string
+	// Audience is the audience of the JWT-SVID, defaults to "vault"
+	Audience string
+	// TrustDomain, when set, must be the trust domain of the SVID. It is checked before the SVID is presented.
+	TrustDomain string
+	// Source is the name of the spiffe.Source registered to fetch the SVIDs, i.e. from the SPIRE Workload API.
+	// When it is not set the SVIDs are read from the files written by spiffe-helper.
+	Source string
+	// JWTSVIDFile is the JWT-SVID file written by spiffe-helper
+	JWTSVIDFile string
+	// CertFile and KeyFile are the X.509-SVID certificate chain and private key files written by spiffe-helper
+	CertFile string
+	KeyFile  string
+}
+
+// RuntimeTokenProviderInfo contains the settings used to request the token from a runtime token provider service,
+// which issues the token of the service on demand instead of a token file being created for it up front
+type RuntimeTokenProviderInfo struct {
+	// Protocol, Host and Port locate the HTTP/JSON endpoint of the token provider. Protocol defaults to "https".
+	Protocol string
+	Host     string
+	Port     int
+	// Service is the name of the runtimetokenprovider.TokenService registered to request the tokens, i.e. with a
+	// gRPC client, which is used instead of the HTTP/JSON endpoint
+	Service string
+	// ServiceKey identifies the service the token is issued for
+	ServiceKey string
+	// RootCaCertPath is the CA certificate bundle trusted for the token provider instead of the system roots
+	RootCaCertPath string
+	// Timeout bounds each token request, defaults to 10s
+	Timeout time.Duration
+	// Retry is how the token requests failing transiently are retried. Its MaxAttempts defaults to 5, so that a
+	// token provider starting along with the service is waited for.
+	Retry RetryPolicy
+}
+
+// UserpassInfo contains the username and password used to log in with the userpass auth method, which is only
+// available in DevMode so developers can use a dev Vault without creating token files
+type UserpassInfo struct {
+	// MountPath is where the userpass auth method is enabled, defaults to "userpass"
+	MountPath string
+	Username  string
+	// Password is the user's password, PasswordFile is read for the password when Password is not set
+	Password     string
+	PasswordFile string
+}
+
+// AWSAuthInfo contains the settings used to log in with the AWS auth method's iam login type, which proves the
+// identity of the AWS credentials with a signed sts:GetCallerIdentity request that Vault forwards to AWS
+type AWSAuthInfo struct {
+	// MountPath is where the AWS auth method is enabled, defaults to "aws"
+	MountPath string
+	// Role is the Vault role bound to the IAM principal, otherwise Vault uses the role named after the principal
+	Role string
+	// Region selects the regional STS endpoint the request is signed for. The global STS endpoint is used when not set,
+	// in which case the Vault auth method must also be configured for the global endpoint.
+	Region string
+	// ServerIdHeader is the X-Vault-AWS-IAM-Server-ID value required by the auth method, if configured
+	ServerIdHeader string
+	// AccessKeyId, SecretAccessKey and SessionToken are the credentials used to sign the request. When not set
+	// they are read from the standard AWS environment variables, then the container credentials endpoint
+	// (EKS Pod Identity and ECS task roles) and lastly the EC2 instance metadata service.
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	// MetadataEndpoint overrides the EC2 instance metadata service endpoint
+	MetadataEndpoint string
+}
+
+// AWSInfo contains the settings used to communicate with AWS Secrets Manager
+type AWSInfo struct {
+	// Region is the AWS region hosting the secrets, i.e. us-east-1. Defaults to the AWS_REGION environment variable.
+	Region string
+	// AccessKeyId, SecretAccessKey and SessionToken are the credentials used to sign requests. They default to the
+	// standard AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	// SecretIds maps a secret sub-path to the name or ARN of the AWS secret holding it.
+	// Sub-paths which are not mapped use the base Path joined with the sub-path as the secret name.
+	SecretIds map[string]string
+}
+
+// AzureInfo contains the settings used to communicate with Azure Key Vault
+type AzureInfo struct {
+	// VaultName is the name of the Key Vault, which is used to build https://<VaultName>.vault.azure.net
+	// when Host is not set
+	VaultName string
+	// TenantId, ClientId and ClientSecret identify the service principal used to authenticate.
+	// When no ClientSecret is set the managed identity of the host is used instead, optionally selecting
+	// the user-assigned identity with ClientId.
+	TenantId     string
+	ClientId     string
+	ClientSecret string
+	// SecretNamePrefix is prepended to every Key Vault secret name built from the secret path
+	SecretNamePrefix string
+	// AuthorityHost overrides the Azure AD endpoint used for service principal authentication
+	AuthorityHost string
+	// IdentityEndpoint overrides the instance metadata endpoint used for managed identity authentication
+	IdentityEndpoint string
+}
+
+// KubernetesInfo contains the settings used to access native Kubernetes Secrets
+type KubernetesInfo struct {
+	// Namespace holding the secrets. Defaults to the namespace of the pod's service account.
+	Namespace string
+	// TokenFile is the service account token used to call the Kubernetes API.
+	// Defaults to the in-cluster service account token.
+	TokenFile string
+	// MountPath, when set, reads the secrets from volumes mounted as <MountPath>/<secret name>/<key>
+	// instead of calling the Kubernetes API. Storing secrets is not possible in this mode.
+	MountPath string
+}
+
+// FileInfo contains the settings used to persist secrets to a local encrypted file
+type FileInfo struct {
+	// FilePath is the location of the encrypted secrets file, which is created on the first store
+	FilePath string
+	// Passphrase is used to derive the encryption key with PBKDF2. Ignored when KeyFile is set.
+	Passphrase string
+	// KeyFile is the location of a raw 32 byte AES-256 key, such as a key unsealed from the TPM at boot
+	// into a tmpfs location by tpm2_unseal
+	KeyFile string
+}
+
+// GCPInfo contains the settings used to communicate with Google Cloud Secret Manager
+type GCPInfo struct {
+	// ProjectId is the project holding the secrets. Defaults to the project_id of the service account key.
+	ProjectId string
+	// CredentialsFile is a service account JSON key used to authenticate. Defaults to the
+	// GOOGLE_APPLICATION_CREDENTIALS environment variable. When neither is set the workload identity of
+	// the host is obtained from the metadata server.
+	CredentialsFile string
+	// SecretVersion is the version read for every secret, either "latest" (the default) or an explicit version number
+	SecretVersion string
+	// SecretVersions pins the version read for individual secret sub-paths, overriding SecretVersion
+	SecretVersions map[string]string
+	// MetadataEndpoint overrides the metadata server endpoint used for workload identity authentication
+	MetadataEndpoint string
+}
+
+// ConjurInfo contains the settings used to communicate with CyberArk Conjur
+type ConjurInfo struct {
+	// Account is the Conjur organization account
+	Account string
+	// Login is the host identity used to authenticate, i.e. host/edgex/core-data
+	Login string
+	// APIKey is the API key of the host identity. APIKeyFile is used instead when APIKey is not set.
+	APIKey     string
+	APIKeyFile string
+	// VariablePrefix is prepended to every Conjur variable id built from the secret path
+	VariablePrefix string
+}
+
+// OnePasswordInfo contains the settings used to communicate with a 1Password Connect server
+type OnePasswordInfo struct {
+	// Vault is the name or id of the 1Password vault holding the secret items
+	Vault string
+	// Token is the Connect server access token. Defaults to the contents of TokenFile or the
+	// OP_CONNECT_TOKEN environment variable.
+	Token     string
+	TokenFile string
+	// Category is used when creating new items, defaults to API_CREDENTIAL
+	Category string
+}
+
+// RetryPolicy is how requests which fail transiently are retried, that is requests which fail to reach the secret
+// store or are answered with one of the RetryableStatusCodes. The wait before the first retry is InitialInterval,
+// doubling for every following retry up to MaxInterval. MaxAttempts of 0 or 1 disables retries.
+type RetryPolicy struct {
+	MaxAttempts     int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// Jitter randomizes each wait by up to this fraction of it, i.e. 0.2 waits between 80% and 120% of the
+	// interval, so that clients failing together do not retry together
+	Jitter float64
+	// RetryableStatusCodes defaults to 429 Too Many Requests, 500 Internal Server Error, 502 Bad Gateway and
+	// 503 Service Unavailable, the latter also being the status of a sealed Vault
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryableStatusCodes are the status codes retried when a RetryPolicy has no RetryableStatusCodes
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+}
+
+// Retryable returns whether a response with statusCode is retried
+func (p RetryPolicy) Retryable(statusCode int) bool {
+	codes := p.RetryableStatusCodes
+	if codes == nil {
+		codes = DefaultRetryableStatusCodes
+	}
+	for _, code := range codes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
 }
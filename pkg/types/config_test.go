@@ -31,6 +31,8 @@ func TestBuildUrl(t *testing.T) {
 	cfgWithInvalidPort := SecretConfig{Host: "", Port: 9999, Protocol: "http", Path: "/api/v1/ping/"}
 	cfgWithNoProtocol := SecretConfig{Host: "localhost", Port: 8080, Protocol: "", Path: "/api/v1/ping/"}
 	cfgWithInvalidProtocol := SecretConfig{Host: "localhost", Port: 8080, Protocol: "234", Path: ""}
+	cfgUnixSocket := SecretConfig{Host: "/run/vault-agent/agent.sock", Protocol: UnixProtocol, Path: "/v1/secret/edgex/"}
+	cfgUnixNoSocket := SecretConfig{Protocol: UnixProtocol, Path: "/v1/secret/edgex/"}
 
 	tests := []struct {
 		name        string
@@ -47,6 +49,8 @@ func TestBuildUrl(t *testing.T) {
 		{"Invalid - Invalid Port", cfgWithInvalidPort, "", true},
 		{"Invalid - No protocol", cfgWithNoProtocol, "", true},
 		{"Invalid - Invalid protocol", cfgWithInvalidProtocol, "", true},
+		{"Valid - Unix socket", cfgUnixSocket, "http://localhost/v1/secret/edgex", false},
+		{"Invalid - Unix socket not set", cfgUnixNoSocket, "", true},
 	}
 
 	for _, test := range tests {
@@ -64,3 +68,15 @@ func TestBuildUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryableStatusCodes(t *testing.T) {
+	defaultPolicy := RetryPolicy{}
+	require.True(t, defaultPolicy.Retryable(429))
+	require.True(t, defaultPolicy.Retryable(503))
+	require.False(t, defaultPolicy.Retryable(400))
+	require.False(t, defaultPolicy.Retryable(200))
+
+	custom := RetryPolicy{RetryableStatusCodes: []int{504}}
+	require.True(t, custom.Retryable(504))
+	require.False(t, custom.Retryable(503))
+}
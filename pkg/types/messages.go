@@ -17,13 +17,122 @@
 
 package types
 
+import "time"
+
 // InitResponse contains a Secret Store init response
 type InitResponse struct {
-	Keys          []string `json:"keys,omitempty"`
-	KeysBase64    []string `json:"keys_base64,omitempty"`
-	EncryptedKeys []string `json:"encrypted_keys,omitempty"`
-	Nonces        []string `json:"nonces,omitempty"`
-	RootToken     string   `json:"root_token,omitempty"`
+	Keys          []SecretString `json:"keys,omitempty"`
+	KeysBase64    []SecretString `json:"keys_base64,omitempty"`
+	EncryptedKeys []string       `json:"encrypted_keys,omitempty"`
+	Nonces        []string       `json:"nonces,omitempty"`
+	RootToken     SecretString   `json:"root_token,omitempty"`
+}
+
+// SealStatus is the seal state of a Secret Store. T is the number of key shares required to unseal out of the N
+// key shares, Progress is the number of key shares submitted so far.
+type SealStatus struct {
+	Type        string `json:"type"`
+	Initialized bool   `json:"initialized"`
+	Sealed      bool   `json:"sealed"`
+	T           int    `json:"t"`
+	N           int    `json:"n"`
+	Progress    int    `json:"progress"`
+	Nonce       string `json:"nonce"`
+	Version     string `json:"version"`
+	ClusterName string `json:"cluster_name"`
+	ClusterId   string `json:"cluster_id"`
+}
+
+// GenerateRootStatus is the progress of a root token generation. Required is the number of unseal key shares to
+// submit, Progress is the number submitted so far. EncodedToken holds the root token, XOR-ed with the OTP, once the
+// generation is Complete.
+type GenerateRootStatus struct {
+	Nonce        string `json:"nonce"`
+	Started      bool   `json:"started"`
+	Progress     int    `json:"progress"`
+	Required     int    `json:"required"`
+	Complete     bool   `json:"complete"`
+	EncodedToken string `json:"encoded_token"`
+	OTP          string `json:"otp"`
+	OTPLength    int    `json:"otp_length"`
+}
+
+// KeyStatus is the state of the encryption key protecting the Secret Store's storage. Term is incremented by each
+// key rotation, InstallTime is when the current key was installed.
+type KeyStatus struct {
+	Term        int       `json:"term"`
+	InstallTime time.Time `json:"install_time"`
+	// Encryptions is the number of encryptions made with the key, which is only reported by Vault 1.11 and later
+	Encryptions int64 `json:"encryptions,omitempty"`
+}
+
+// RaftPeer is a node of a Secret Store cluster using integrated (raft) storage
+type RaftPeer struct {
+	NodeId          string `json:"node_id"`
+	Address         string `json:"address"`
+	Leader          bool   `json:"leader"`
+	Voter           bool   `json:"voter"`
+	ProtocolVersion string `json:"protocol_version"`
+}
+
+// LeaderStatus is the view of the HA leadership from the node which is queried. IsSelf is true on the active node.
+type LeaderStatus struct {
+	HAEnabled            bool      `json:"ha_enabled"`
+	IsSelf               bool      `json:"is_self"`
+	ActiveTime           time.Time `json:"active_time"`
+	LeaderAddress        string    `json:"leader_address"`
+	LeaderClusterAddress string    `json:"leader_cluster_address"`
+	PerformanceStandby   bool      `json:"performance_standby"`
+}
+
+// HANode is a node of a Secret Store HA cluster, ActiveNode being true for the node serving requests
+type HANode struct {
+	Hostname       string    `json:"hostname"`
+	APIAddress     string    `json:"api_address"`
+	ClusterAddress string    `json:"cluster_address"`
+	ActiveNode     bool      `json:"active_node"`
+	LastEcho       time.Time `json:"last_echo"`
+	Version        string    `json:"version"`
+}
+
+// RekeyRequest starts a rekey, which replaces the unseal key shares with SecretShares new key shares of which
+// SecretThreshold are required to unseal. When RequireVerification is set, the new key shares only take effect
+// once a threshold of them have been verified.
+type RekeyRequest struct {
+	SecretShares        int
+	SecretThreshold     int
+	PGPKeys             []string
+	Backup              bool
+	RequireVerification bool
+}
+
+// RekeyStatus is the progress of a rekey. Required is the number of current key shares to submit, Progress is the
+// number submitted so far. Keys and KeysBase64 hold the new key shares once the rekey is Complete.
+type RekeyStatus struct {
+	Nonce                string         `json:"nonce"`
+	Started              bool           `json:"started"`
+	T                    int            `json:"t"`
+	N                    int            `json:"n"`
+	Progress             int            `json:"progress"`
+	Required             int            `json:"required"`
+	Complete             bool           `json:"complete"`
+	Keys                 []SecretString `json:"keys,omitempty"`
+	KeysBase64           []SecretString `json:"keys_base64,omitempty"`
+	PGPFingerprints      []string       `json:"pgp_fingerprints,omitempty"`
+	Backup               bool           `json:"backup"`
+	VerificationRequired bool           `json:"verification_required"`
+	VerificationNonce    string         `json:"verification_nonce,omitempty"`
+}
+
+// RekeyVerificationStatus is the progress of verifying the new key shares, Progress being the number of new key
+// shares verified so far
+type RekeyVerificationStatus struct {
+	Nonce    string `json:"nonce"`
+	Started  bool   `json:"started"`
+	T        int    `json:"t"`
+	N        int    `json:"n"`
+	Progress int    `json:"progress"`
+	Complete bool   `json:"complete"`
 }
 
 // TokenMetadata has introspection data about a token and is the "data" sub-structure for token lookup,
@@ -36,4 +145,563 @@ type TokenMetadata struct {
 	Period     int      `json:"period"` // in seconds
 	Renewable  bool     `json:"renewable"`
 	Ttl        int      `json:"ttl"` // in seconds
+	// the remaining fields identify who a token was issued to and how, which is used to audit issued tokens
+	DisplayName    string            `json:"display_name"`
+	EntityId       string            `json:"entity_id"`
+	Meta           map[string]string `json:"meta"`
+	IssueTime      string            `json:"issue_time"`
+	CreationTtl    int               `json:"creation_ttl"`     // in seconds
+	ExplicitMaxTtl int               `json:"explicit_max_ttl"` // in seconds
+	NumUses        int               `json:"num_uses"`
+	Orphan         bool              `json:"orphan"`
+	Type           string            `json:"type"`
+}
+
+// TokenInfo describes the properties of a token as reported by the secret store, with its durations and times
+// parsed, so they can be acted upon rather than assumed from the configuration
+type TokenInfo struct {
+	Accessor string
+	Policies []string
+	// TTL is the remaining lifetime of the token until it is renewed, 0 for tokens which never expire
+	TTL       time.Duration
+	Renewable bool
+	// Period is the TTL each renewal of a periodic token resets to, 0 for tokens which aren't periodic
+	Period   time.Duration
+	EntityId string
+	// ExpireTime is when the token expires unless renewed, the zero time for tokens which never expire
+	ExpireTime time.Time
+	IssueTime  time.Time
+	// ExplicitMaxTTL is the lifetime the token can't be renewed beyond, 0 when it has none
+	ExplicitMaxTTL time.Duration
+	// NumUses is the number of requests the token can still make, 0 is unlimited
+	NumUses     int
+	Orphan      bool
+	Type        string
+	DisplayName string
+	Meta        map[string]string
+}
+
+// WrapInfo describes a response wrapping token, i.e. the "wrap_info" sub-structure of a wrapped response
+type WrapInfo struct {
+	Token        SecretString `json:"token"`
+	Accessor     string       `json:"accessor"`
+	TTL          int          `json:"ttl"` // in seconds
+	CreationTime string       `json:"creation_time"`
+	CreationPath string       `json:"creation_path"`
+}
+
+// UnwrapOptions are the checks made on a wrapping token before it is unwrapped, guarding against a token
+// which was created by someone else or intercepted and re-wrapped
+type UnwrapOptions struct {
+	// CreationPath, when set, must match the path the wrapped response was created at,
+	// i.e. "auth/approle/role/core-data/secret-id". A trailing "*" matches any path starting with the prefix.
+	CreationPath string
+	// MaxTTL, when set, is the longest wrapping TTL accepted
+	MaxTTL time.Duration
+}
+
+// TokenExchangeRequest describes the child token a parent token, i.e. the bootstrap token of a service, is
+// exchanged for
+type TokenExchangeRequest struct {
+	// Policies narrow the child token to these policies of the parent token, defaults to all the parent's policies
+	Policies []string
+	// TTL is the lifetime of the child token until it is renewed, defaults to an hour
+	TTL time.Duration
+	// ExplicitMaxTTL, when set, limits how long the child token can be renewed for
+	ExplicitMaxTTL time.Duration
+	DisplayName    string
+	// RevokeParent revokes the parent token once the child token is created. The parent is revoked with the
+	// revoke-orphan API, so the child token remains valid, which requires sudo on auth/token/revoke-orphan.
+	RevokeParent bool
+}
+
+// TokenCreateRequest contains the settings of a token to create. Zero values are omitted so the secret store's
+// defaults, or those of the token role, apply.
+type TokenCreateRequest struct {
+	// RoleName, when set, creates the token against the token role of that name
+	RoleName        string
+	Policies        []string
+	NoDefaultPolicy bool
+	Meta            map[string]string
+	DisplayName     string
+	// Orphan creates a token without a parent, so it isn't revoked along with the token creating it
+	Orphan bool
+	// Type is "service" (the default) or "batch". Batch tokens are lightweight, but can't be renewed,
+	// be periodic or have a number of uses.
+	Type string
+	// Renewable, when set, overrides whether the token can be renewed
+	Renewable      *bool
+	TTL            time.Duration
+	ExplicitMaxTTL time.Duration
+	Period         time.Duration
+	// NumUses limits the number of requests the token can make, 0 is unlimited
+	NumUses int
+	// EntityAlias associates the token with an identity entity alias, which requires RoleName
+	EntityAlias string
+}
+
+// TokenCreateResponse describes a created token, and is the "auth" sub-structure of the token create response
+type TokenCreateResponse struct {
+	ClientToken   SecretString      `json:"client_token"`
+	Accessor      string            `json:"accessor"`
+	Policies      []string          `json:"policies"`
+	TokenPolicies []string          `json:"token_policies"`
+	Metadata      map[string]string `json:"metadata"`
+	LeaseDuration int               `json:"lease_duration"` // in seconds
+	Renewable     bool              `json:"renewable"`
+	EntityId      string            `json:"entity_id"`
+	TokenType     string            `json:"token_type"`
+	Orphan        bool              `json:"orphan"`
+	NumUses       int               `json:"num_uses"`
+}
+
+// TokenRole defines the tokens created against a token role, which lets a token create tokens with policies it
+// doesn't hold itself. Durations are in seconds and, as every field is written, Renewable must be set explicitly.
+type TokenRole struct {
+	Name                 string   `json:"name,omitempty"`
+	AllowedPolicies      []string `json:"allowed_policies"`
+	DisallowedPolicies   []string `json:"disallowed_policies"`
+	AllowedEntityAliases []string `json:"allowed_entity_aliases"`
+	Orphan               bool     `json:"orphan"`
+	Renewable            bool     `json:"renewable"`
+	PathSuffix           string   `json:"path_suffix"`
+	TokenPeriod          int      `json:"token_period"`
+	TokenExplicitMaxTtl  int      `json:"token_explicit_max_ttl"`
+	TokenNoDefaultPolicy bool     `json:"token_no_default_policy"`
+	// TokenType is "service", "batch" or one of the "default-service" and "default-batch" defaults
+	TokenType string `json:"token_type,omitempty"`
+}
+
+// SecretVersionMetadata describes a version of a KV v2 secret. DeletionTime is empty unless the version was deleted.
+type SecretVersionMetadata struct {
+	Version      int    `json:"version"`
+	CreatedTime  string `json:"created_time"`
+	DeletionTime string `json:"deletion_time"`
+	Destroyed    bool   `json:"destroyed"`
+}
+
+// SecretVersion is a version of a KV v2 secret. Data is nil when the version was deleted or destroyed.
+type SecretVersion struct {
+	Data     map[string]string
+	Metadata SecretVersionMetadata
+}
+
+// SecretMetadata contains the metadata of a KV v2 secret and of all its versions
+type SecretMetadata struct {
+	CurrentVersion int    `json:"current_version"`
+	OldestVersion  int    `json:"oldest_version"`
+	CreatedTime    string `json:"created_time"`
+	UpdatedTime    string `json:"updated_time"`
+	MaxVersions    int    `json:"max_versions"`
+	CasRequired    bool   `json:"cas_required"`
+	// DeleteVersionAfter is the duration after which versions are deleted, i.e. "768h0m0s", or "0s" for never
+	DeleteVersionAfter string `json:"delete_version_after"`
+	// CustomMetadata holds user supplied key/values, i.e. the owner or rotation schedule of the secret
+	CustomMetadata map[string]string             `json:"custom_metadata"`
+	Versions       map[int]SecretVersionMetadata `json:"versions"`
+}
+
+// SecretMetadataUpdate contains the changes made to the metadata of a KV v2 secret, nil fields are left unchanged
+type SecretMetadataUpdate struct {
+	// MaxVersions is the number of versions kept, 0 uses the engine's setting
+	MaxVersions *int
+	CasRequired *bool
+	// DeleteVersionAfter deletes versions after the duration, 0 disables deletion
+	DeleteVersionAfter *time.Duration
+	// CustomMetadata replaces all of the secret's custom metadata
+	CustomMetadata map[string]string
+}
+
+// Lease identifies the lease of a dynamic secret, which is revoked by the secret store once it expires
+type Lease struct {
+	LeaseId       string `json:"lease_id"`
+	LeaseDuration int    `json:"lease_duration"` // in seconds
+	Renewable     bool   `json:"renewable"`
+}
+
+// DatabaseCredentials are the dynamic credentials created for a database secrets engine role
+type DatabaseCredentials struct {
+	Username string
+	Password SecretString
+	Lease    Lease
+}
+
+// DatabaseConnection configures the connection of the database secrets engine to a database, i.e. the
+// "redis-database-plugin" or "postgresql-database-plugin" PluginName and its ConnectionURL template
+type DatabaseConnection struct {
+	PluginName    string   `json:"plugin_name"`
+	ConnectionURL string   `json:"connection_url,omitempty"`
+	Username      string   `json:"username,omitempty"`
+	Password      string   `json:"password,omitempty"`
+	AllowedRoles  []string `json:"allowed_roles"`
+	// VerifyConnection defaults to verifying the connection when the configuration is written
+	VerifyConnection *bool  `json:"verify_connection,omitempty"`
+	PasswordPolicy   string `json:"password_policy,omitempty"`
+	// PluginSettings holds the plugin specific settings, i.e. "host", "port" and "tls" of the Redis plugin
+	PluginSettings map[string]interface{} `json:"-"`
+}
+
+// DatabaseRole defines the credentials created for a role of the database secrets engine. Durations are in seconds.
+type DatabaseRole struct {
+	DBName               string   `json:"db_name"`
+	CreationStatements   []string `json:"creation_statements"`
+	RevocationStatements []string `json:"revocation_statements,omitempty"`
+	RollbackStatements   []string `json:"rollback_statements,omitempty"`
+	RenewStatements      []string `json:"renew_statements,omitempty"`
+	DefaultTTL           int      `json:"default_ttl"`
+	MaxTTL               int      `json:"max_ttl"`
+}
+
+// LeaseInfo contains the details of a lease returned by a lease lookup
+type LeaseInfo struct {
+	Id          string `json:"id"`
+	IssueTime   string `json:"issue_time"`
+	ExpireTime  string `json:"expire_time"`
+	LastRenewal string `json:"last_renewal"`
+	Renewable   bool   `json:"renewable"`
+	TTL         int    `json:"ttl"` // in seconds
+}
+
+// TransitKey configures a named encryption key of the transit secrets engine
+type TransitKey struct {
+	// Type is the key type, i.e. "aes256-gcm96" (the default), "chacha20-poly1305", "ed25519" or "rsa-2048"
+	Type       string `json:"type,omitempty"`
+	Exportable bool   `json:"exportable,omitempty"`
+	// AllowPlaintextBackup allows backing up the key, which can then be restored in plaintext
+	AllowPlaintextBackup bool `json:"allow_plaintext_backup,omitempty"`
+	// AutoRotatePeriod is the period, in seconds, after which the key is rotated. Zero disables automatic rotation.
+	AutoRotatePeriod int `json:"auto_rotate_period,omitempty"`
+}
+
+// TransitSignOptions selects how a transit key signs or HMACs its input. Zero values select the secret store's
+// defaults, only HashAlgorithm and KeyVersion apply to HMACs.
+type TransitSignOptions struct {
+	// HashAlgorithm is the hash of the input, i.e. "sha2-256" (the default), "sha2-384" or "sha2-512"
+	HashAlgorithm string
+	// Prehashed indicates the input already is the hash to sign, which must match HashAlgorithm
+	Prehashed bool
+	// SignatureAlgorithm is "pss" (the default) or "pkcs1v15" for RSA keys
+	SignatureAlgorithm string
+	// MarshalingAlgorithm is "asn1" (the default) or "jws" for ECDSA keys, which JWT signatures require
+	MarshalingAlgorithm string
+	// KeyVersion is the key version to sign with, zero selects the latest version
+	KeyVersion int
+}
+
+// TransitKeyInfo describes a transit key and its versions
+type TransitKeyInfo struct {
+	Name          string
+	Type          string
+	LatestVersion int
+	// MinDecryptionVersion is the oldest version which can decrypt and MinEncryptionVersion the version which
+	// encrypts, zero selecting the latest version
+	MinDecryptionVersion int
+	MinEncryptionVersion int
+	// MinAvailableVersion is the oldest version which hasn't been trimmed
+	MinAvailableVersion  int
+	DeletionAllowed      bool
+	Exportable           bool
+	AllowPlaintextBackup bool
+	// AutoRotatePeriod is in seconds, zero when automatic rotation is disabled
+	AutoRotatePeriod int
+	// Versions are the available key versions in ascending order
+	Versions []int
+}
+
+// TransitKeyConfig updates the configuration of a transit key, nil and zero values leave the setting unchanged
+type TransitKeyConfig struct {
+	MinDecryptionVersion int   `json:"min_decryption_version,omitempty"`
+	MinEncryptionVersion int   `json:"min_encryption_version,omitempty"`
+	DeletionAllowed      *bool `json:"deletion_allowed,omitempty"`
+	Exportable           *bool `json:"exportable,omitempty"`
+	AllowPlaintextBackup *bool `json:"allow_plaintext_backup,omitempty"`
+	// AutoRotatePeriod is in seconds, zero disables automatic rotation
+	AutoRotatePeriod *int `json:"auto_rotate_period,omitempty"`
+}
+
+// PKICAConfig configures the CA of a PKI secrets engine. The CA is a self-signed root CA unless IssuerMount is set,
+// in which case an intermediate CA signed by the PKI secrets engine at IssuerMount is created.
+type PKICAConfig struct {
+	CommonName string
+	// TTL is the validity of the CA certificate in seconds, which cannot exceed the max lease TTL of the mount
+	TTL int
+	// KeyType is "rsa" (the default), "ec" or "ed25519" and KeyBits its size, i.e. 2048 or 256
+	KeyType     string
+	KeyBits     int
+	IssuerMount string
+}
+
+// PKIRole restricts the certificates issued for a role of a PKI secrets engine. Durations are in seconds and, unlike
+// Vault's defaults, the boolean settings are false unless set.
+type PKIRole struct {
+	AllowedDomains   []string `json:"allowed_domains"`
+	AllowSubdomains  bool     `json:"allow_subdomains"`
+	AllowBareDomains bool     `json:"allow_bare_domains"`
+	AllowLocalhost   bool     `json:"allow_localhost"`
+	AllowIPSANs      bool     `json:"allow_ip_sans"`
+	ServerFlag       bool     `json:"server_flag"`
+	ClientFlag       bool     `json:"client_flag"`
+	KeyType          string   `json:"key_type,omitempty"`
+	KeyBits          int      `json:"key_bits,omitempty"`
+	TTL              int      `json:"ttl,omitempty"`
+	MaxTTL           int      `json:"max_ttl,omitempty"`
+}
+
+// CertificateRequest requests a certificate for CommonName, i.e. "edgex-core-data", valid for TTL seconds
+type CertificateRequest struct {
+	CommonName string
+	AltNames   []string
+	IPSANs     []string
+	TTL        int
+}
+
+// Certificate is a PEM encoded certificate with its private key and CA chain, the private key is only returned
+// when the certificate is issued
+type Certificate struct {
+	Certificate    string
+	IssuingCA      string
+	CAChain        []string
+	PrivateKey     SecretString
+	PrivateKeyType string
+	SerialNumber   string
+	Expiration     time.Time
+}
+
+// ConsulAccess configures how the Consul secrets engine connects to Consul. Token is a Consul ACL token allowed to
+// manage ACL tokens, i.e. the bootstrap token.
+type ConsulAccess struct {
+	Address string `json:"address"`
+	// Scheme is "http" (the default) or "https"
+	Scheme     string `json:"scheme,omitempty"`
+	Token      string `json:"token"`
+	CACert     string `json:"ca_cert,omitempty"`
+	ClientCert string `json:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty"`
+}
+
+// ConsulRole defines the Consul ACL tokens created for a role of the Consul secrets engine. Durations are in seconds.
+type ConsulRole struct {
+	ConsulPolicies []string `json:"consul_policies,omitempty"`
+	ConsulRoles    []string `json:"consul_roles,omitempty"`
+	// ServiceIdentities are Consul service identities, i.e. "core-data:dc1"
+	ServiceIdentities []string `json:"service_identities,omitempty"`
+	Local             bool     `json:"local,omitempty"`
+	TTL               int      `json:"ttl,omitempty"`
+	MaxTTL            int      `json:"max_ttl,omitempty"`
+}
+
+// ConsulCredentials is a Consul ACL token created for a role of the Consul secrets engine
+type ConsulCredentials struct {
+	Token    SecretString
+	Accessor string
+	Lease    Lease
+}
+
+// SSHRole restricts the SSH certificates signed for a role of the SSH secrets engine. Durations are in seconds.
+type SSHRole struct {
+	AllowUserCertificates bool `json:"allow_user_certificates"`
+	AllowHostCertificates bool `json:"allow_host_certificates"`
+	// AllowedUsers is a comma separated list of the principals of user certificates, "*" allows any
+	AllowedUsers string `json:"allowed_users,omitempty"`
+	DefaultUser  string `json:"default_user,omitempty"`
+	// AllowedDomains is a comma separated list of the principals of host certificates
+	AllowedDomains    string            `json:"allowed_domains,omitempty"`
+	AllowSubdomains   bool              `json:"allow_subdomains,omitempty"`
+	AllowedExtensions string            `json:"allowed_extensions,omitempty"`
+	DefaultExtensions map[string]string `json:"default_extensions,omitempty"`
+	TTL               int               `json:"ttl,omitempty"`
+	MaxTTL            int               `json:"max_ttl,omitempty"`
+}
+
+// SSHSignRequest requests an SSH certificate for PublicKey, in authorized_keys format. CertType is "user", the
+// default, or "host".
+type SSHSignRequest struct {
+	PublicKey       string
+	ValidPrincipals []string
+	CertType        string
+	// KeyId is recorded in the certificate and the SSH server's logs, i.e. the maintainer's name
+	KeyId string
+	TTL   int
+}
+
+// SSHCertificate is an SSH certificate, in authorized_keys format, signed by the SSH secrets engine
+type SSHCertificate struct {
+	SerialNumber string
+	SignedKey    string
+}
+
+// RabbitMQConnection configures the connection of the RabbitMQ secrets engine to the RabbitMQ management API, i.e.
+// "http://edgex-rabbitmq:15672". The user must be allowed to manage users.
+type RabbitMQConnection struct {
+	ConnectionURI string `json:"connection_uri"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	// VerifyConnection defaults to verifying the connection when the configuration is written
+	VerifyConnection *bool `json:"verify_connection,omitempty"`
+}
+
+// RabbitMQPermissions are the configure, write and read permission regular expressions of a RabbitMQ user. For
+// topic permissions, Configure is ignored.
+type RabbitMQPermissions struct {
+	Configure string `json:"configure,omitempty"`
+	Write     string `json:"write"`
+	Read      string `json:"read"`
+}
+
+// RabbitMQRole defines the users created for a role of the RabbitMQ secrets engine
+type RabbitMQRole struct {
+	// Tags are the RabbitMQ user tags, i.e. "management"
+	Tags []string
+	// VHosts maps each virtual host to the permissions of the user
+	VHosts map[string]RabbitMQPermissions
+	// VHostTopics maps each virtual host to the topic exchanges and the user's topic permissions on them
+	VHostTopics map[string]map[string]RabbitMQPermissions
+}
+
+// RabbitMQCredentials are the dynamic credentials of a RabbitMQ user created for a role, which is deleted from
+// RabbitMQ when the lease is revoked
+type RabbitMQCredentials struct {
+	Username string
+	Password SecretString
+	Lease    Lease
+}
+
+// Entity is an identity of the secret store, i.e. an EdgeX service, which the aliases of its auth methods map to
+type Entity struct {
+	Id       string            `json:"id"`
+	Name     string            `json:"name"`
+	Policies []string          `json:"policies"`
+	Metadata map[string]string `json:"metadata"`
+	Disabled bool              `json:"disabled"`
+	Aliases  []EntityAlias     `json:"aliases"`
+	// GroupIds are the ids of all the groups the entity is a member of, directly or through nested groups
+	GroupIds []string `json:"group_ids"`
+}
+
+// EntityAlias maps the identity of an auth method, i.e. the role name of an AppRole login, to an Entity.
+// MountAccessor identifies the auth method and CanonicalId the entity.
+type EntityAlias struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	CanonicalId   string `json:"canonical_id"`
+	MountAccessor string `json:"mount_accessor"`
+	MountPath     string `json:"mount_path"`
+	MountType     string `json:"mount_type"`
+}
+
+// Group is an internal identity group, whose policies apply to all of its member entities and groups
+type Group struct {
+	Id              string            `json:"id"`
+	Name            string            `json:"name"`
+	Policies        []string          `json:"policies"`
+	Metadata        map[string]string `json:"metadata"`
+	MemberEntityIds []string          `json:"member_entity_ids"`
+	MemberGroupIds  []string          `json:"member_group_ids"`
+}
+
+// IdentityTokenKey configures a named key signing identity tokens. Durations are in seconds.
+type IdentityTokenKey struct {
+	// Algorithm is i.e. "RS256" (the default) or "ES256"
+	Algorithm      string `json:"algorithm,omitempty"`
+	RotationPeriod int    `json:"rotation_period,omitempty"`
+	// VerificationTTL is how long the public key of a rotated key is kept for verifying tokens
+	VerificationTTL int `json:"verification_ttl,omitempty"`
+	// AllowedClientIds are the client ids of the roles allowed to use the key, "*" allows all
+	AllowedClientIds []string `json:"allowed_client_ids"`
+}
+
+// IdentityTokenRole defines the identity tokens generated for a role, signed with the named Key. Template is a JSON
+// template of additional claims, i.e. `{"service": {{identity.entity.name}}}`.
+type IdentityTokenRole struct {
+	Key      string `json:"key"`
+	Template string `json:"template,omitempty"`
+	ClientId string `json:"client_id,omitempty"`
+	TTL      int    `json:"ttl,omitempty"`
+}
+
+// IdentityToken is a signed OIDC identity token of the client's entity. ClientId is the audience of the token.
+type IdentityToken struct {
+	Token    string
+	ClientId string
+	TTL      time.Duration
+}
+
+// AuthMethod is an auth method to enable, i.e. of Type "approle", "kubernetes" or "cert"
+type AuthMethod struct {
+	Type        string           `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Config      AuthMethodConfig `json:"config"`
+	// Local auth methods are not replicated to performance secondaries
+	Local bool `json:"local,omitempty"`
+}
+
+// AuthMethodConfig tunes an auth method. Durations are in seconds, zero values leave the setting unchanged.
+type AuthMethodConfig struct {
+	DefaultLeaseTTL int `json:"default_lease_ttl,omitempty"`
+	MaxLeaseTTL     int `json:"max_lease_ttl,omitempty"`
+	// TokenType is the type of the tokens issued on login, i.e. "default-service" or "batch"
+	TokenType string `json:"token_type,omitempty"`
+	// ListingVisibility is "unauth" to list the auth method in the login UI, or "hidden"
+	ListingVisibility string `json:"listing_visibility,omitempty"`
+}
+
+// AuthMount is an enabled auth method. The Accessor identifies it in entity aliases.
+type AuthMount struct {
+	Type        string           `json:"type"`
+	Accessor    string           `json:"accessor"`
+	Description string           `json:"description"`
+	Local       bool             `json:"local"`
+	Config      AuthMethodConfig `json:"config"`
+}
+
+// SecretEngineMount is an enabled secrets engine. Options are specific to the Type, i.e. the "version" of a KV
+// secrets engine, and the Accessor identifies the mount in audit logs.
+type SecretEngineMount struct {
+	Path        string            `json:"-"`
+	Type        string            `json:"type"`
+	Description string            `json:"description"`
+	Accessor    string            `json:"accessor"`
+	Local       bool              `json:"local"`
+	SealWrap    bool              `json:"seal_wrap"`
+	Options     map[string]string `json:"options"`
+	Config      MountConfig       `json:"config"`
+}
+
+// MountInfo describes the secrets engine mount holding a path. KVVersion is 1 or 2 for a KV secrets engine and 0
+// for other engines. Zero TTLs mean the mount uses the system defaults.
+type MountInfo struct {
+	Path            string
+	Type            string
+	KVVersion       int
+	DefaultLeaseTTL time.Duration
+	MaxLeaseTTL     time.Duration
+	Options         map[string]string
+}
+
+// MountConfig tunes a secrets engine mount. Durations are in seconds, zero values leave the setting unchanged.
+type MountConfig struct {
+	DefaultLeaseTTL int `json:"default_lease_ttl,omitempty"`
+	MaxLeaseTTL     int `json:"max_lease_ttl,omitempty"`
+	// AuditNonHMACRequestKeys and AuditNonHMACResponseKeys are the keys the audit devices log in plain text
+	AuditNonHMACRequestKeys  []string `json:"audit_non_hmac_request_keys,omitempty"`
+	AuditNonHMACResponseKeys []string `json:"audit_non_hmac_response_keys,omitempty"`
+}
+
+// Audit device types supported by Vault
+const (
+	AuditDeviceFile   = "file"
+	AuditDeviceSyslog = "syslog"
+	AuditDeviceSocket = "socket"
+)
+
+// AuditDevice is an audit device to enable or an enabled one. Options are specific to the Type, i.e. "file_path"
+// for file devices, "facility" and "tag" for syslog devices, "address" and "socket_type" for socket devices.
+type AuditDevice struct {
+	Type        string            `json:"type"`
+	Description string            `json:"description,omitempty"`
+	Options     map[string]string `json:"options,omitempty"`
+	// Local audit devices are not replicated to performance secondaries
+	Local bool `json:"local,omitempty"`
+	// Path is only set for enabled audit devices
+	Path string `json:"path,omitempty"`
 }
@@ -0,0 +1,92 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package types
+
+import (
+	"fmt"
+	"io"
+)
+
+// Redacted is what the SecretString and SecretBytes values are formatted as
+const Redacted = "*****"
+
+// SecretString is a secret value, i.e. a token or password, which cannot leak through formatting: String, GoString
+// and every fmt verb output Redacted, also when it is a field of a formatted struct. Reveal returns the value. It
+// is encoded to and decoded from JSON as a plain string.
+type SecretString string
+
+// Reveal returns the secret value
+func (s SecretString) Reveal() string {
+	return string(s)
+}
+
+// String returns Redacted
+func (s SecretString) String() string {
+	return Redacted
+}
+
+// GoString returns Redacted, for the %#v verb
+func (s SecretString) GoString() string {
+	return Redacted
+}
+
+// Format writes Redacted whatever the verb
+func (s SecretString) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, Redacted)
+}
+
+// RevealAll returns the values of secrets
+func RevealAll(secrets []SecretString) []string {
+	if secrets == nil {
+		return nil
+	}
+
+	values := make([]string, len(secrets))
+	for index, secret := range secrets {
+		values[index] = secret.Reveal()
+	}
+	return values
+}
+
+// SecretBytes is a binary secret value, i.e. a private key, which is formatted as Redacted like a SecretString.
+// Reveal returns the value. It is encoded to and decoded from JSON as base64 like a []byte.
+type SecretBytes []byte
+
+// Reveal returns the secret value
+func (s SecretBytes) Reveal() []byte {
+	return s
+}
+
+// Wipe overwrites the secret value with zeros
+func (s SecretBytes) Wipe() {
+	for index := range s {
+		s[index] = 0
+	}
+}
+
+// String returns Redacted
+func (s SecretBytes) String() string {
+	return Redacted
+}
+
+// GoString returns Redacted, for the %#v verb
+func (s SecretBytes) GoString() string {
+	return Redacted
+}
+
+// Format writes Redacted whatever the verb
+func (s SecretBytes) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, Redacted)
+}
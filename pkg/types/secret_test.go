@@ -0,0 +1,80 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretString(t *testing.T) {
+	secret := SecretString("hvs.password")
+	credentials := struct {
+		Username string
+		Password SecretString
+		Key      SecretBytes
+	}{"redis5", secret, SecretBytes("private")}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		output := fmt.Sprintf(format, credentials)
+		assert.NotContains(t, output, "password", format)
+		assert.NotContains(t, output, "private", format)
+		assert.NotContains(t, output, fmt.Sprintf("%x", "password"), format)
+		assert.Contains(t, output, Redacted, format)
+	}
+	assert.Equal(t, Redacted, secret.String())
+	assert.Equal(t, "hvs.password", secret.Reveal())
+	assert.Equal(t, []byte("private"), credentials.Key.Reveal())
+
+	// encoded as the plain values, so the structs still make valid requests
+	encoded, err := json.Marshal(credentials)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Username": "redis5", "Password": "hvs.password", "Key": "cHJpdmF0ZQ=="}`, string(encoded))
+
+	credentials.Key.Wipe()
+	assert.Equal(t, SecretBytes{0, 0, 0, 0, 0, 0, 0}, credentials.Key)
+
+	var decoded struct{ Password SecretString }
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, secret, decoded.Password)
+}
+
+func TestResponseSecretsRedacted(t *testing.T) {
+	secret := SecretString("hvs.leaked")
+	responses := []interface{}{
+		InitResponse{Keys: []SecretString{secret}, KeysBase64: []SecretString{secret}, RootToken: secret},
+		RekeyStatus{Keys: []SecretString{secret}, KeysBase64: []SecretString{secret}},
+		WrapInfo{Token: secret},
+		TokenCreateResponse{ClientToken: secret},
+		DatabaseCredentials{Username: "postgres", Password: secret},
+		Certificate{PrivateKey: secret},
+		ConsulCredentials{Token: secret},
+		RabbitMQCredentials{Username: "edgex", Password: secret},
+	}
+
+	for _, response := range responses {
+		for _, format := range []string{"%v", "%+v", "%#v"} {
+			output := fmt.Sprintf(format, response)
+			assert.NotContains(t, output, "leaked", "%T formatted with %s", response, format)
+		}
+	}
+
+	assert.Equal(t, []string{"hvs.leaked", "other"}, RevealAll([]SecretString{secret, "other"}))
+	assert.Nil(t, RevealAll(nil))
+}
@@ -0,0 +1,425 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+)
+
+// The key shares are encrypted in the age v1 file format (https://age-encryption.org/v1) with X25519 recipients,
+// so that operators are able to decrypt them with the age tools as well. Neither X25519 nor ChaCha20-Poly1305 is FIPS
+// approved, so encrypting and decrypting key shares returns a fips.ErrNotApproved in FIPS mode.
+const (
+	ageAlgorithm       = "age encryption with X25519 and ChaCha20-Poly1305"
+	ageVersionLine     = "age-encryption.org/v1"
+	ageX25519Label     = "age-encryption.org/v1/X25519"
+	ageRecipientHRP    = "age"
+	ageIdentityHRP     = "AGE-SECRET-KEY-"
+	ageFileKeySize     = 16
+	ageStreamNonceSize = 16
+	ageChunkSize       = 64 * 1024
+	ageColumnsPerLine  = 64
+)
+
+var (
+	// ErrNoMatchingIdentity is returned when the data was not encrypted to the recipient of the identity
+	ErrNoMatchingIdentity = errors.New("no identity matches the recipients of the encrypted data")
+
+	ageBase64 = base64.RawStdEncoding
+)
+
+// GenerateX25519Identity generates an X25519 identity, i.e. "AGE-SECRET-KEY-1...", and its recipient, i.e.
+// "age1...". Data is encrypted to the recipient, the identity must be kept secret to decrypt it.
+func GenerateX25519Identity() (identity string, recipient string, err error) {
+	if err := fips.Reject(ageAlgorithm); err != nil {
+		return "", "", err
+	}
+
+	secret := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+
+	public, err := curve25519.X25519(secret, curve25519.Basepoint)
+	if err != nil {
+		return "", "", err
+	}
+
+	if identity, err = bech32Encode(ageIdentityHRP, secret); err != nil {
+		return "", "", err
+	}
+	if recipient, err = bech32Encode(ageRecipientHRP, public); err != nil {
+		return "", "", err
+	}
+
+	return strings.ToUpper(identity), recipient, nil
+}
+
+// EncryptX25519 encrypts plaintext to each of the recipients, any of whose identities is able to decrypt it
+func EncryptX25519(plaintext []byte, recipients ...string) ([]byte, error) {
+	if err := fips.Reject(ageAlgorithm); err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("at least one recipient is required to encrypt")
+	}
+
+	fileKey := make([]byte, ageFileKeySize)
+	if _, err := rand.Read(fileKey); err != nil {
+		return nil, err
+	}
+	defer zero(fileKey)
+
+	header := &bytes.Buffer{}
+	header.WriteString(ageVersionLine + "\n")
+	for _, recipient := range recipients {
+		stanza, err := wrapFileKey(fileKey, recipient)
+		if err != nil {
+			return nil, err
+		}
+		header.WriteString(stanza)
+	}
+	header.WriteString("---")
+
+	mac, err := headerMAC(fileKey, header.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	header.WriteString(" " + ageBase64.EncodeToString(mac) + "\n")
+
+	nonce := make([]byte, ageStreamNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	payload, err := streamSeal(fileKey, nonce, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Write(nonce)
+	header.Write(payload)
+	return header.Bytes(), nil
+}
+
+// DecryptX25519 decrypts data encrypted to the recipient of identity
+func DecryptX25519(ciphertext []byte, identity string) ([]byte, error) {
+	if err := fips.Reject(ageAlgorithm); err != nil {
+		return nil, err
+	}
+	secret, public, err := parseIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(secret)
+
+	reader := bufio.NewReader(bytes.NewReader(ciphertext))
+	line, err := readLine(reader)
+	if err != nil || line != ageVersionLine {
+		return nil, errors.New("encrypted data is not in the age v1 format")
+	}
+
+	var fileKey []byte
+	defer func() { zero(fileKey) }()
+	for {
+		line, err := readLine(reader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid age header: %w", err)
+		}
+		if strings.HasPrefix(line, "---") {
+			if fileKey == nil {
+				return nil, ErrNoMatchingIdentity
+			}
+			if err := verifyHeaderMAC(fileKey, ciphertext, line); err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		args, body, err := readStanza(reader, line)
+		if err != nil {
+			return nil, err
+		}
+		if fileKey == nil && len(args) == 2 && args[0] == "X25519" {
+			fileKey = unwrapFileKey(secret, public, args[1], body)
+		}
+	}
+
+	nonce := make([]byte, ageStreamNonceSize)
+	if _, err := io.ReadFull(reader, nonce); err != nil {
+		return nil, errors.New("encrypted data is truncated")
+	}
+	payload, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return streamOpen(fileKey, nonce, payload)
+}
+
+// wrapFileKey returns the X25519 recipient stanza wrapping fileKey for recipient
+func wrapFileKey(fileKey []byte, recipient string) (string, error) {
+	hrp, public, err := bech32Decode(recipient)
+	if err != nil || hrp != ageRecipientHRP || len(public) != curve25519.PointSize {
+		return "", fmt.Errorf("invalid X25519 recipient '%s'", recipient)
+	}
+
+	ephemeral := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(ephemeral); err != nil {
+		return "", err
+	}
+	defer zero(ephemeral)
+
+	share, err := curve25519.X25519(ephemeral, curve25519.Basepoint)
+	if err != nil {
+		return "", err
+	}
+	shared, err := curve25519.X25519(ephemeral, public)
+	if err != nil {
+		return "", fmt.Errorf("invalid X25519 recipient '%s': %w", recipient, err)
+	}
+
+	wrapKey, err := deriveKey(shared, append(append([]byte{}, share...), public...), ageX25519Label)
+	if err != nil {
+		return "", err
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return "", err
+	}
+	body := aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), fileKey, nil)
+
+	return "-> X25519 " + ageBase64.EncodeToString(share) + "\n" + wrapLines(ageBase64.EncodeToString(body)), nil
+}
+
+// unwrapFileKey returns the file key wrapped in the X25519 stanza, or nil when it is wrapped for another recipient
+func unwrapFileKey(secret []byte, public []byte, encodedShare string, body []byte) []byte {
+	share, err := ageBase64.DecodeString(encodedShare)
+	if err != nil || len(share) != curve25519.PointSize {
+		return nil
+	}
+	shared, err := curve25519.X25519(secret, share)
+	if err != nil {
+		return nil
+	}
+
+	wrapKey, err := deriveKey(shared, append(append([]byte{}, share...), public...), ageX25519Label)
+	if err != nil {
+		return nil
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return nil
+	}
+	fileKey, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), body, nil)
+	if err != nil || len(fileKey) != ageFileKeySize {
+		return nil
+	}
+
+	return fileKey
+}
+
+// readStanza reads the body lines of the stanza whose first line is line, the body ending with a short line
+func readStanza(reader *bufio.Reader, line string) ([]string, []byte, error) {
+	if !strings.HasPrefix(line, "-> ") {
+		return nil, nil, fmt.Errorf("invalid age stanza line '%s'", line)
+	}
+	args := strings.Split(strings.TrimPrefix(line, "-> "), " ")
+
+	var encoded strings.Builder
+	for {
+		bodyLine, err := readLine(reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid age stanza: %w", err)
+		}
+		encoded.WriteString(bodyLine)
+		if len(bodyLine) < ageColumnsPerLine {
+			break
+		}
+	}
+
+	body, err := ageBase64.DecodeString(encoded.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid age stanza body: %w", err)
+	}
+
+	return args, body, nil
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+// wrapLines splits the encoded stanza body in lines of 64 columns, the last line being shorter, possibly empty
+func wrapLines(encoded string) string {
+	var lines strings.Builder
+	for len(encoded) >= ageColumnsPerLine {
+		lines.WriteString(encoded[:ageColumnsPerLine] + "\n")
+		encoded = encoded[ageColumnsPerLine:]
+	}
+	lines.WriteString(encoded + "\n")
+	return lines.String()
+}
+
+func headerMAC(fileKey []byte, header []byte) ([]byte, error) {
+	macKey, err := deriveKey(fileKey, nil, "header")
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, macKey)
+	_, _ = mac.Write(header)
+	return mac.Sum(nil), nil
+}
+
+// verifyHeaderMAC verifies the MAC at the end of the header, which covers the header up to and including "---"
+func verifyHeaderMAC(fileKey []byte, ciphertext []byte, macLine string) error {
+	end := bytes.Index(ciphertext, []byte("\n"+macLine+"\n"))
+	if end < 0 || !strings.HasPrefix(macLine, "--- ") {
+		return errors.New("invalid age header MAC line")
+	}
+
+	expected, err := ageBase64.DecodeString(strings.TrimPrefix(macLine, "--- "))
+	if err != nil {
+		return fmt.Errorf("invalid age header MAC: %w", err)
+	}
+	mac, err := headerMAC(fileKey, ciphertext[:end+len("\n---")])
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(mac, expected) {
+		return errors.New("age header MAC mismatch, the encrypted data was altered")
+	}
+
+	return nil
+}
+
+// streamSeal encrypts plaintext in chunks of 64KiB with the STREAM construction of age
+func streamSeal(fileKey []byte, nonce []byte, plaintext []byte) ([]byte, error) {
+	aead, err := payloadAEAD(fileKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var sealed []byte
+	for counter := uint64(0); ; counter++ {
+		chunk := plaintext
+		if len(chunk) > ageChunkSize {
+			chunk = chunk[:ageChunkSize]
+		}
+		plaintext = plaintext[len(chunk):]
+		last := len(plaintext) == 0
+
+		sealed = aead.Seal(sealed, chunkNonce(counter, last), chunk, nil)
+		if last {
+			return sealed, nil
+		}
+	}
+}
+
+// streamOpen decrypts and authenticates the chunks sealed by streamSeal
+func streamOpen(fileKey []byte, nonce []byte, payload []byte) ([]byte, error) {
+	aead, err := payloadAEAD(fileKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	sealedChunkSize := ageChunkSize + aead.Overhead()
+	var plaintext []byte
+	for counter := uint64(0); ; counter++ {
+		chunk := payload
+		if len(chunk) > sealedChunkSize {
+			chunk = chunk[:sealedChunkSize]
+		}
+		payload = payload[len(chunk):]
+		last := len(payload) == 0
+
+		if plaintext, err = aead.Open(plaintext, chunkNonce(counter, last), chunk, nil); err != nil {
+			return nil, errors.New("unable to decrypt age payload, the encrypted data was altered or truncated")
+		}
+		if last {
+			if counter > 0 && len(chunk) == aead.Overhead() {
+				return nil, errors.New("invalid empty final age payload chunk")
+			}
+			return plaintext, nil
+		}
+	}
+}
+
+func payloadAEAD(fileKey []byte, nonce []byte) (cipher.AEAD, error) {
+	payloadKey, err := deriveKey(fileKey, nonce, "payload")
+	if err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(payloadKey)
+}
+
+// chunkNonce is the 11 byte big endian chunk counter followed by the last chunk flag
+func chunkNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+func deriveKey(secret []byte, salt []byte, info string) ([]byte, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(info)), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func parseIdentity(identity string) ([]byte, []byte, error) {
+	hrp, secret, err := bech32Decode(strings.TrimSpace(identity))
+	if err != nil || hrp != strings.ToLower(ageIdentityHRP) || len(secret) != curve25519.ScalarSize {
+		return nil, nil, errors.New("invalid X25519 identity")
+	}
+
+	public, err := curve25519.X25519(secret, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secret, public, nil
+}
+
+func zero(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
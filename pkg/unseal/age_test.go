@@ -0,0 +1,177 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+)
+
+func TestBech32(t *testing.T) {
+	// valid strings from BIP 173
+	for _, valid := range []string{"A12UEL5L", "a12uel5l", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw"} {
+		_, _, err := bech32Decode(valid)
+		assert.NoError(t, err, valid)
+	}
+	for _, invalid := range []string{"A12uEL5L", "a12uel5m", "pzry9x0s0muk", "1pzry9x0s0muk", "abc1rzg"} {
+		_, _, err := bech32Decode(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	data := []byte{0x00, 0x14, 0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3}
+	encoded, err := bech32Encode("test", data)
+	require.NoError(t, err)
+	hrp, decoded, err := bech32Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, "test", hrp)
+	assert.Equal(t, data, decoded)
+}
+
+// skipInFIPSMode skips the tests of the X25519 age encryption, which FIPS mode refuses as TestX25519FIPSMode checks
+func skipInFIPSMode(t *testing.T) {
+	if fips.Enabled() {
+		t.Skip("X25519 age encryption is refused in FIPS mode, which the fips build tag keeps on")
+	}
+}
+
+func TestGenerateX25519Identity(t *testing.T) {
+	skipInFIPSMode(t)
+
+	identity, recipient, err := GenerateX25519Identity()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(identity, "AGE-SECRET-KEY-1"))
+	assert.True(t, strings.HasPrefix(recipient, "age1"))
+	assert.Len(t, recipient, 62)
+
+	other, _, err := GenerateX25519Identity()
+	require.NoError(t, err)
+	assert.NotEqual(t, identity, other)
+}
+
+func TestX25519FIPSMode(t *testing.T) {
+	// the algorithm is refused before the arguments are parsed, so placeholders do with the fips build tag, under
+	// which no identity can be generated
+	identity, recipient, ciphertext := "AGE-SECRET-KEY-1", "age1", []byte("age-encryption.org/v1\n")
+	if !fips.Enabled() {
+		var err error
+		identity, recipient, err = GenerateX25519Identity()
+		require.NoError(t, err)
+		ciphertext, err = EncryptX25519([]byte("key share"), recipient)
+		require.NoError(t, err)
+	}
+
+	fips.SetEnabled(true)
+	defer fips.SetEnabled(false)
+
+	_, _, err := GenerateX25519Identity()
+	assert.IsType(t, fips.ErrNotApproved{}, err)
+	_, err = EncryptX25519([]byte("key share"), recipient)
+	assert.IsType(t, fips.ErrNotApproved{}, err)
+	_, err = DecryptX25519(ciphertext, identity)
+	assert.IsType(t, fips.ErrNotApproved{}, err)
+	_, err = NewX25519Decrypter(identity)
+	assert.IsType(t, fips.ErrNotApproved{}, err)
+}
+
+func TestEncryptX25519(t *testing.T) {
+	skipInFIPSMode(t)
+
+	identity1, recipient1, err := GenerateX25519Identity()
+	require.NoError(t, err)
+	identity2, recipient2, err := GenerateX25519Identity()
+	require.NoError(t, err)
+	identity3, _, err := GenerateX25519Identity()
+	require.NoError(t, err)
+
+	random := func(size int) []byte {
+		data := make([]byte, size)
+		_, _ = rand.Read(data)
+		return data
+	}
+
+	tests := []struct {
+		name      string
+		plaintext []byte
+	}{
+		{"Empty", []byte{}},
+		{"Key share", []byte("dGVzdC1rZXktMQ==")},
+		{"Exactly one chunk", random(ageChunkSize)},
+		{"Several chunks", random(2*ageChunkSize + 100)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ciphertext, err := EncryptX25519(test.plaintext, recipient1, recipient2)
+			require.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(ciphertext, []byte("age-encryption.org/v1\n-> X25519 ")))
+
+			for _, identity := range []string{identity1, identity2} {
+				plaintext, err := DecryptX25519(ciphertext, identity)
+				require.NoError(t, err)
+				assert.Equal(t, len(test.plaintext), len(plaintext))
+				assert.True(t, bytes.Equal(test.plaintext, plaintext))
+			}
+
+			_, err = DecryptX25519(ciphertext, identity3)
+			assert.True(t, errors.Is(err, ErrNoMatchingIdentity))
+		})
+	}
+}
+
+func TestDecryptX25519Invalid(t *testing.T) {
+	skipInFIPSMode(t)
+
+	identity, recipient, err := GenerateX25519Identity()
+	require.NoError(t, err)
+	ciphertext, err := EncryptX25519([]byte("dGVzdC1rZXktMQ=="), recipient)
+	require.NoError(t, err)
+
+	tamper := func(index int) []byte {
+		altered := append([]byte{}, ciphertext...)
+		altered[index] ^= 0x01
+		return altered
+	}
+	headerEnd := bytes.Index(ciphertext, []byte("\n--- ")) + 5
+
+	_, err = DecryptX25519(tamper(len(ciphertext)-1), identity)
+	assert.Error(t, err, "altered payload")
+	_, err = DecryptX25519(tamper(headerEnd), identity)
+	assert.Error(t, err, "altered header MAC")
+	_, err = DecryptX25519(ciphertext[:len(ciphertext)-20], identity)
+	assert.Error(t, err, "truncated payload")
+	_, err = DecryptX25519([]byte("not encrypted"), identity)
+	assert.Error(t, err)
+	_, err = DecryptX25519(ciphertext, "AGE-SECRET-KEY-1INVALID")
+	assert.Error(t, err)
+
+	_, err = EncryptX25519([]byte("data"), "age1invalid")
+	assert.Error(t, err)
+	_, err = EncryptX25519([]byte("data"))
+	assert.Error(t, err)
+}
+
+func TestWrapLines(t *testing.T) {
+	assert.Equal(t, "abc\n", wrapLines("abc"))
+	assert.Equal(t, strings.Repeat("a", 64)+"\n\n", wrapLines(strings.Repeat("a", 64)))
+	assert.Equal(t, strings.Repeat("a", 64)+"\nbb\n", wrapLines(strings.Repeat("a", 64)+"bb"))
+}
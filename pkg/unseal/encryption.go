@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/fips"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// EncryptInitResponse encrypts the whole init response, including the root token, to the recipients. The result is
+// saved in place of the plain init response and read back by a file source with NewX25519Decrypter.
+func EncryptInitResponse(response types.InitResponse, recipients ...string) ([]byte, error) {
+	plaintext, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(plaintext)
+
+	return EncryptX25519(plaintext, recipients...)
+}
+
+// EncryptKeyShares encrypts each of the keys_base64 key shares of the init response to the recipient at the same
+// index, so that each custodian is only able to decrypt their own key share
+func EncryptKeyShares(response types.InitResponse, recipients []string) ([][]byte, error) {
+	if len(recipients) != len(response.KeysBase64) {
+		return nil, fmt.Errorf("%d recipients are required to encrypt %d key shares, got %d",
+			len(response.KeysBase64), len(response.KeysBase64), len(recipients))
+	}
+
+	encrypted := make([][]byte, len(recipients))
+	for i, recipient := range recipients {
+		share, err := EncryptX25519([]byte(response.KeysBase64[i]), recipient)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt key share %d: %w", i, err)
+		}
+		encrypted[i] = share
+	}
+
+	return encrypted, nil
+}
+
+// NewX25519Decrypter returns a Decrypter decrypting the data encrypted to the recipient of identity
+func NewX25519Decrypter(identity string) (Decrypter, error) {
+	if err := fips.Reject(ageAlgorithm); err != nil {
+		return nil, err
+	}
+	if _, _, err := parseIdentity(identity); err != nil {
+		return nil, err
+	}
+
+	return DecrypterFunc(func(_ context.Context, ciphertext []byte) ([]byte, error) {
+		return DecryptX25519(ciphertext, identity)
+	}), nil
+}
+
+type encryptedKeySharesSource struct {
+	shares    [][]byte
+	decrypter Decrypter
+}
+
+// NewEncryptedKeySharesSource returns a KeyShareSource providing the key shares encrypted by EncryptKeyShares which
+// decrypter is able to decrypt. The key shares encrypted to other recipients are skipped.
+func NewEncryptedKeySharesSource(shares [][]byte, decrypter Decrypter) KeyShareSource {
+	return &encryptedKeySharesSource{shares: shares, decrypter: decrypter}
+}
+
+func (s *encryptedKeySharesSource) KeyShares(ctx context.Context, required int) ([]string, error) {
+	var shares []string
+	for i, encrypted := range s.shares {
+		if len(shares) >= required {
+			break
+		}
+
+		share, err := s.decrypter.Decrypt(ctx, encrypted)
+		if errors.Is(err, ErrNoMatchingIdentity) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt key share %d: %w", i, err)
+		}
+		shares = append(shares, string(share))
+		zero(share)
+	}
+
+	return shares, nil
+}
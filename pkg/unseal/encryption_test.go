@@ -0,0 +1,93 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestEncryptInitResponse(t *testing.T) {
+	skipInFIPSMode(t)
+
+	identity, recipient, err := GenerateX25519Identity()
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "unseal")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	response := types.InitResponse{KeysBase64: []types.SecretString{"a", "b", "c"}, RootToken: "s.root"}
+	encrypted, err := EncryptInitResponse(response, recipient)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "s.root")
+	file := filepath.Join(dir, "resp-init.json.age")
+	require.NoError(t, ioutil.WriteFile(file, encrypted, 0600))
+
+	decrypter, err := NewX25519Decrypter(identity)
+	require.NoError(t, err)
+	client := newFakeClient(2, "a", "b", "c")
+	unsealer := NewUnsealer(client, []KeyShareSource{NewFileSource(file, decrypter)}, logger.NewMockClient(), Options{})
+	require.NoError(t, unsealer.Unseal(context.Background()))
+	assert.False(t, client.isSealed())
+
+	_, err = NewX25519Decrypter("invalid")
+	require.Error(t, err)
+}
+
+func TestEncryptKeyShares(t *testing.T) {
+	skipInFIPSMode(t)
+
+	identities := make([]string, 3)
+	recipients := make([]string, 3)
+	for i := range identities {
+		var err error
+		identities[i], recipients[i], err = GenerateX25519Identity()
+		require.NoError(t, err)
+	}
+
+	response := types.InitResponse{KeysBase64: []types.SecretString{"a", "b", "c"}}
+	encrypted, err := EncryptKeyShares(response, recipients)
+	require.NoError(t, err)
+	require.Len(t, encrypted, 3)
+	_, err = EncryptKeyShares(response, recipients[:2])
+	require.Error(t, err)
+
+	// each custodian only decrypts their own key share
+	for i, identity := range identities {
+		decrypter, err := NewX25519Decrypter(identity)
+		require.NoError(t, err)
+		shares, err := NewEncryptedKeySharesSource(encrypted, decrypter).KeyShares(context.Background(), 3)
+		require.NoError(t, err)
+		assert.Equal(t, []string{response.KeysBase64[i].Reveal()}, shares)
+	}
+
+	decrypter, err := NewX25519Decrypter(identities[0])
+	require.NoError(t, err)
+	altered := append([]byte{}, encrypted[0]...)
+	altered[len(altered)-1] ^= 0x01
+	_, err = NewEncryptedKeySharesSource([][]byte{altered}, decrypter).KeyShares(context.Background(), 1)
+	require.Error(t, err)
+}
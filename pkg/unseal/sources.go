@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+)
+
+// KeyShareSource provides base64 encoded unseal key shares. required is the number of key shares still needed to
+// unseal, a source may return fewer or more of them.
+type KeyShareSource interface {
+	KeyShares(ctx context.Context, required int) ([]string, error)
+}
+
+// KeyShareSourceFunc adapts a function to a KeyShareSource
+type KeyShareSourceFunc func(ctx context.Context, required int) ([]string, error)
+
+// KeyShares calls f
+func (f KeyShareSourceFunc) KeyShares(ctx context.Context, required int) ([]string, error) {
+	return f(ctx, required)
+}
+
+// Decrypter decrypts the key shares protected at rest, i.e. with a key sealed by a TPM or held by a cloud KMS
+type Decrypter interface {
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// DecrypterFunc adapts a function to a Decrypter
+type DecrypterFunc func(ctx context.Context, ciphertext []byte) ([]byte, error)
+
+// Decrypt calls f
+func (f DecrypterFunc) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	return f(ctx, ciphertext)
+}
+
+type fileSource struct {
+	path      string
+	decrypter Decrypter
+}
+
+// NewFileSource returns a KeyShareSource reading the keys_base64 of the init response saved at path, or its hex
+// encoded keys when there are none. When decrypter is not nil the file is decrypted with it before being parsed.
+func NewFileSource(path string, decrypter Decrypter) KeyShareSource {
+	return &fileSource{path: path, decrypter: decrypter}
+}
+
+func (s *fileSource) KeyShares(ctx context.Context, _ int) ([]string, error) {
+	contents, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.decrypter != nil {
+		if contents, err = s.decrypter.Decrypt(ctx, contents); err != nil {
+			return nil, fmt.Errorf("unable to decrypt key shares file '%s': %w", s.path, err)
+		}
+	}
+
+	var response types.InitResponse
+	if err := json.Unmarshal(contents, &response); err != nil {
+		return nil, fmt.Errorf("unable to parse key shares file '%s': %w", s.path, err)
+	}
+	if len(response.KeysBase64) > 0 {
+		return types.RevealAll(response.KeysBase64), nil
+	}
+	// the hex encoded keys are accepted by Unseal too
+	if len(response.Keys) > 0 {
+		return types.RevealAll(response.Keys), nil
+	}
+
+	return nil, fmt.Errorf("key shares file '%s' has neither keys_base64 nor keys", s.path)
+}
+
+// NewPromptSource returns a KeyShareSource asking prompt for the key shares, i.e. from operators on a console
+func NewPromptSource(prompt func(ctx context.Context, required int) ([]string, error)) KeyShareSource {
+	return KeyShareSourceFunc(func(ctx context.Context, required int) ([]string, error) {
+		if prompt == nil {
+			return nil, errors.New("no prompt to ask for the key shares")
+		}
+		return prompt(ctx, required)
+	})
+}
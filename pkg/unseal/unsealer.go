@@ -0,0 +1,264 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package unseal unseals the secret store with key shares from pluggable sources, on startup and whenever the
+// secret store is found sealed again, i.e. after a restart.
+package unseal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	defaultPollInterval  = 30 * time.Second
+	defaultMaxRetries    = 3
+	defaultRetryInterval = time.Second
+)
+
+var (
+	// ErrNotInitialized is returned when the secret store has not been initialized, so it has no key shares yet
+	ErrNotInitialized = errors.New("secret store is not initialized")
+	// ErrInsufficientKeyShares is returned when the sources do not provide enough key shares to unseal
+	ErrInsufficientKeyShares = errors.New("insufficient key shares to unseal")
+	// ErrAlreadyStarted is returned by Start when the Unsealer is already monitoring the seal status
+	ErrAlreadyStarted = errors.New("unsealer is already started")
+)
+
+// Client reports the seal status and applies key shares. The secrets.SecretStoreClient satisfies this interface.
+type Client interface {
+	SealStatus(ctx context.Context) (types.SealStatus, error)
+	Unseal(ctx context.Context, keysBase64 []string) error
+}
+
+// Options configures how often an Unsealer checks the seal status and how it retries. Zero values select the defaults.
+type Options struct {
+	// PollInterval is the interval between seal status checks, defaults to 30s
+	PollInterval time.Duration
+	// MaxRetries is the number of times a failed unseal is retried before it fails, defaults to 3. A negative
+	// value disables retries.
+	MaxRetries int
+	// RetryInterval is the wait before the first retry, which doubles for every following retry. Defaults to 1s.
+	RetryInterval time.Duration
+	// OnFailure, when set, is called with the error when the secret store was found sealed and unsealing it failed.
+	// The Unsealer tries again at the next seal status check.
+	OnFailure func(err error)
+}
+
+// Unsealer collects key shares from its sources, in order, until it has enough to unseal the secret store
+type Unsealer struct {
+	client   Client
+	sources  []KeyShareSource
+	lc       logger.LoggingClient
+	options  Options
+	failures chan error
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewUnsealer creates an Unsealer which unseals the secret store through client with key shares from sources
+func NewUnsealer(client Client, sources []KeyShareSource, lc logger.LoggingClient, options Options) *Unsealer {
+	if options.PollInterval <= 0 {
+		options.PollInterval = defaultPollInterval
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = defaultMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = defaultRetryInterval
+	}
+
+	return &Unsealer{
+		client:   client,
+		sources:  sources,
+		lc:       lc,
+		options:  options,
+		failures: make(chan error, 1),
+	}
+}
+
+// Failures returns the channel on which the error is sent when unsealing fails in the background. The send doesn't
+// block, so a failure is dropped when the previous one has not been received.
+func (u *Unsealer) Failures() <-chan error {
+	return u.failures
+}
+
+// Unseal unseals the secret store when it is sealed. The key shares are only collected from the sources when needed.
+func (u *Unsealer) Unseal(ctx context.Context) error {
+	status, err := u.client.SealStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get seal status: %w", err)
+	}
+	if !status.Initialized {
+		return ErrNotInitialized
+	}
+	if !status.Sealed {
+		return nil
+	}
+
+	required := status.T - status.Progress
+	u.lc.Infof("secret store is sealed, collecting %d key shares", required)
+	shares, err := u.collect(ctx, required)
+	if err != nil {
+		return err
+	}
+
+	return u.client.Unseal(ctx, shares)
+}
+
+// Start unseals the secret store, retrying as configured, then checks the seal status every PollInterval in the
+// background, unsealing the secret store when it is found sealed again. The error of the initial unseal is
+// returned, the monitoring is started regardless so that a later check can unseal the secret store.
+func (u *Unsealer) Start(ctx context.Context) error {
+	u.mutex.Lock()
+	if u.cancel != nil {
+		u.mutex.Unlock()
+		return ErrAlreadyStarted
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	u.cancel = cancel
+	u.done = make(chan struct{})
+	u.mutex.Unlock()
+
+	err := u.unsealWithRetries(ctx)
+	go u.run(runCtx, u.done)
+
+	return err
+}
+
+// Stop stops checking the seal status
+func (u *Unsealer) Stop() {
+	u.mutex.Lock()
+	cancel, done := u.cancel, u.done
+	u.cancel = nil
+	u.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (u *Unsealer) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(u.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := u.client.SealStatus(ctx)
+		if err != nil {
+			// the secret store is unreachable, i.e. restarting, check again at the next interval
+			u.lc.Debugf("unable to get seal status: %v", err)
+			continue
+		}
+		if !status.Initialized || !status.Sealed {
+			continue
+		}
+
+		u.lc.Warn("secret store was found sealed, unsealing it")
+		if err := u.unsealWithRetries(ctx); err != nil && ctx.Err() == nil {
+			u.fail(err)
+		}
+	}
+}
+
+// unsealWithRetries unseals the secret store, retrying with an exponential backoff
+func (u *Unsealer) unsealWithRetries(ctx context.Context) error {
+	wait := u.options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		err := u.Unseal(ctx)
+		if err == nil || errors.Is(err, ErrNotInitialized) || attempt >= u.options.MaxRetries {
+			return err
+		}
+
+		u.lc.Warnf("unable to unseal secret store, retrying in %v: %v", wait, err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// collect asks the sources in order for key shares until required distinct key shares are collected
+func (u *Unsealer) collect(ctx context.Context, required int) ([]string, error) {
+	var shares []string
+	seen := make(map[string]bool)
+	var failures []string
+
+	for i, source := range u.sources {
+		if len(shares) >= required {
+			break
+		}
+
+		provided, err := source.KeyShares(ctx, required-len(shares))
+		if err != nil {
+			u.lc.Warnf("unable to get key shares from source %d: %v", i, err)
+			failures = append(failures, fmt.Sprintf("source %d: %v", i, err))
+			continue
+		}
+
+		for _, share := range provided {
+			if share != "" && !seen[share] {
+				seen[share] = true
+				shares = append(shares, share)
+			}
+		}
+	}
+
+	if len(shares) < required {
+		if len(failures) > 0 {
+			return nil, fmt.Errorf("%w: %d of %d collected, %s", ErrInsufficientKeyShares, len(shares), required,
+				strings.Join(failures, "; "))
+		}
+		return nil, fmt.Errorf("%w: %d of %d collected", ErrInsufficientKeyShares, len(shares), required)
+	}
+
+	return shares, nil
+}
+
+func (u *Unsealer) fail(err error) {
+	err = fmt.Errorf("unable to unseal secret store: %w", err)
+	u.lc.Error(err.Error())
+
+	select {
+	case u.failures <- err:
+	default:
+	}
+
+	if u.options.OnFailure != nil {
+		u.options.OnFailure(err)
+	}
+}
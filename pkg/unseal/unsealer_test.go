@@ -0,0 +1,255 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package unseal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// fakeClient is a secret store requiring threshold of the valid key shares, applied in any order, to unseal
+type fakeClient struct {
+	mutex     sync.Mutex
+	sealed    bool
+	threshold int
+	valid     map[string]bool
+	applied   map[string]bool
+	unseals   int
+}
+
+func newFakeClient(threshold int, valid ...string) *fakeClient {
+	client := &fakeClient{sealed: true, threshold: threshold, valid: make(map[string]bool), applied: make(map[string]bool)}
+	for _, key := range valid {
+		client.valid[key] = true
+	}
+	return client
+}
+
+func (f *fakeClient) SealStatus(_ context.Context) (types.SealStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return types.SealStatus{Initialized: true, Sealed: f.sealed, T: f.threshold, N: len(f.valid), Progress: len(f.applied)}, nil
+}
+
+func (f *fakeClient) Unseal(_ context.Context, keysBase64 []string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.unseals++
+	for _, key := range keysBase64 {
+		if !f.valid[key] {
+			f.applied = make(map[string]bool)
+			return errors.New("invalid key share")
+		}
+		f.applied[key] = true
+		if len(f.applied) >= f.threshold {
+			f.sealed = false
+			f.applied = make(map[string]bool)
+			return nil
+		}
+	}
+	return errors.New("still sealed")
+}
+
+func (f *fakeClient) seal() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.sealed = true
+}
+
+func (f *fakeClient) isSealed() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.sealed
+}
+
+func staticSource(shares ...string) KeyShareSource {
+	return KeyShareSourceFunc(func(context.Context, int) ([]string, error) {
+		return shares, nil
+	})
+}
+
+func failingSource() KeyShareSource {
+	return KeyShareSourceFunc(func(context.Context, int) ([]string, error) {
+		return nil, errors.New("source unavailable")
+	})
+}
+
+func TestUnseal(t *testing.T) {
+	tests := []struct {
+		name        string
+		sources     []KeyShareSource
+		expectError error
+	}{
+		{"One source", []KeyShareSource{staticSource("a", "b", "c")}, nil},
+		{"Shares across sources", []KeyShareSource{failingSource(), staticSource("a", "a"), staticSource("b", "")}, nil},
+		{"Insufficient shares", []KeyShareSource{failingSource(), staticSource("a")}, ErrInsufficientKeyShares},
+		{"No sources", nil, ErrInsufficientKeyShares},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newFakeClient(2, "a", "b", "c")
+			unsealer := NewUnsealer(client, test.sources, logger.NewMockClient(), Options{})
+
+			err := unsealer.Unseal(context.Background())
+			if test.expectError != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, test.expectError))
+				assert.True(t, client.isSealed())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.False(t, client.isSealed())
+		})
+	}
+}
+
+func TestUnsealSkipsSourcesWhenUnsealed(t *testing.T) {
+	client := newFakeClient(1, "a")
+	client.sealed = false
+	asked := false
+	source := KeyShareSourceFunc(func(context.Context, int) ([]string, error) {
+		asked = true
+		return nil, nil
+	})
+
+	require.NoError(t, NewUnsealer(client, []KeyShareSource{source}, logger.NewMockClient(), Options{}).Unseal(context.Background()))
+	assert.False(t, asked)
+	assert.Equal(t, 0, client.unseals)
+}
+
+func TestFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "unseal")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	contents, err := json.Marshal(types.InitResponse{KeysBase64: []types.SecretString{"a", "b"}, RootToken: "s.root"})
+	require.NoError(t, err)
+	plainFile := filepath.Join(dir, "resp-init.json")
+	require.NoError(t, ioutil.WriteFile(plainFile, contents, 0600))
+
+	// the "encryption" reverses the bytes
+	reverse := func(data []byte) []byte {
+		reversed := make([]byte, len(data))
+		for i, b := range data {
+			reversed[len(data)-1-i] = b
+		}
+		return reversed
+	}
+	encryptedFile := filepath.Join(dir, "resp-init.enc")
+	require.NoError(t, ioutil.WriteFile(encryptedFile, reverse(contents), 0600))
+	decrypter := DecrypterFunc(func(_ context.Context, ciphertext []byte) ([]byte, error) {
+		return reverse(ciphertext), nil
+	})
+
+	shares, err := NewFileSource(plainFile, nil).KeyShares(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, shares)
+
+	shares, err = NewFileSource(encryptedFile, decrypter).KeyShares(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, shares)
+
+	contents, err = json.Marshal(types.InitResponse{Keys: []types.SecretString{"0a", "0b"}})
+	require.NoError(t, err)
+	hexFile := filepath.Join(dir, "resp-init-hex.json")
+	require.NoError(t, ioutil.WriteFile(hexFile, contents, 0600))
+	shares, err = NewFileSource(hexFile, nil).KeyShares(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0a", "0b"}, shares)
+
+	_, err = NewFileSource(encryptedFile, nil).KeyShares(context.Background(), 2)
+	require.Error(t, err, "not decrypted")
+	_, err = NewFileSource(filepath.Join(dir, "missing.json"), nil).KeyShares(context.Background(), 2)
+	require.Error(t, err)
+}
+
+func TestPromptSource(t *testing.T) {
+	source := NewPromptSource(func(_ context.Context, required int) ([]string, error) {
+		assert.Equal(t, 2, required)
+		return []string{"a", "b"}, nil
+	})
+	client := newFakeClient(2, "a", "b")
+
+	require.NoError(t, NewUnsealer(client, []KeyShareSource{source}, logger.NewMockClient(), Options{}).Unseal(context.Background()))
+	assert.False(t, client.isSealed())
+
+	_, err := NewPromptSource(nil).KeyShares(context.Background(), 1)
+	require.Error(t, err)
+}
+
+func TestStartUnsealsAfterReseal(t *testing.T) {
+	client := newFakeClient(1, "a")
+	unsealer := NewUnsealer(client, []KeyShareSource{staticSource("a")}, logger.NewMockClient(), Options{
+		PollInterval: 10 * time.Millisecond,
+	})
+
+	require.NoError(t, unsealer.Start(context.Background()))
+	defer unsealer.Stop()
+	assert.False(t, client.isSealed())
+	assert.Equal(t, ErrAlreadyStarted, unsealer.Start(context.Background()))
+
+	client.seal()
+	assert.Eventually(t, func() bool { return !client.isSealed() }, time.Second, 10*time.Millisecond)
+}
+
+func TestStartReportsFailures(t *testing.T) {
+	client := newFakeClient(1, "a")
+	client.sealed = false
+	var mutex sync.Mutex
+	var reported error
+	unsealer := NewUnsealer(client, []KeyShareSource{failingSource()}, logger.NewMockClient(), Options{
+		PollInterval:  10 * time.Millisecond,
+		MaxRetries:    1,
+		RetryInterval: time.Millisecond,
+		OnFailure: func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			reported = err
+		},
+	})
+
+	require.NoError(t, unsealer.Start(context.Background()))
+	defer unsealer.Stop()
+
+	client.seal()
+	select {
+	case err := <-unsealer.Failures():
+		assert.True(t, errors.Is(err, ErrInsufficientKeyShares))
+	case <-time.After(time.Second):
+		require.Fail(t, "unseal failure not reported")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.True(t, errors.Is(reported, ErrInsufficientKeyShares))
+}
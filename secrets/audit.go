@@ -0,0 +1,215 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const (
+	// AuditOperationGet is the operation of the AuditEvents recording a GetSecrets
+	AuditOperationGet = "GetSecrets"
+	// AuditOperationStore is the operation of the AuditEvents recording a StoreSecrets
+	AuditOperationStore = "StoreSecrets"
+
+	// AuditSuccess is the outcome of the AuditEvents of the calls which succeeded
+	AuditSuccess = "success"
+	// AuditFailure is the outcome of the AuditEvents of the calls which failed
+	AuditFailure = "failure"
+)
+
+// AuditEvent records an access to the secrets of a sub-path. The values of the secrets are never recorded.
+type AuditEvent struct {
+	Time      time.Time `json:"time"`
+	Identity  string    `json:"identity,omitempty"`
+	Operation string    `json:"operation"`
+	Path      string    `json:"path"`
+	// Keys are the keys requested by a GetSecrets, none when all were requested, or the keys stored by a
+	// StoreSecrets
+	Keys    []string `json:"keys,omitempty"`
+	Outcome string   `json:"outcome"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// AuditSink records the AuditEvents, i.e. to a file or syslog
+type AuditSink interface {
+	Record(event AuditEvent) error
+}
+
+// AuditSinkFunc adapts a function to an AuditSink
+type AuditSinkFunc func(event AuditEvent) error
+
+// Record calls f(event)
+func (f AuditSinkFunc) Record(event AuditEvent) error {
+	return f(event)
+}
+
+// WriterAuditSink writes the AuditEvents to a writer as JSON, one per line, i.e. to a *syslog.Writer
+type WriterAuditSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterAuditSink creates a WriterAuditSink writing to writer
+func NewWriterAuditSink(writer io.Writer) *WriterAuditSink {
+	return &WriterAuditSink{writer: writer}
+}
+
+// Record writes the event as a line of JSON
+func (s *WriterAuditSink) Record(event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, err = s.writer.Write(append(line, '\n'))
+	return err
+}
+
+// FileAuditSink appends the AuditEvents to a file as JSON, one per line
+type FileAuditSink struct {
+	*WriterAuditSink
+	file *os.File
+}
+
+// NewFileAuditSink creates a FileAuditSink appending to the file at path, which is created readable by its owner
+// only when it doesn't exist
+func NewFileAuditSink(path string) (*FileAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditSink{WriterAuditSink: NewWriterAuditSink(file), file: file}, nil
+}
+
+// Close closes the file
+func (s *FileAuditSink) Close() error {
+	return s.file.Close()
+}
+
+// ErrAuditChannelFull is returned by a channel AuditSink when an event is dropped as the channel is full
+var ErrAuditChannelFull = errors.New("audit channel is full, the event is dropped")
+
+// NewChannelAuditSink creates an AuditSink sending the AuditEvents on the channel. The send doesn't block, so the
+// secrets are not held up by a slow receiver, and the event is dropped with ErrAuditChannelFull when the channel
+// is full.
+func NewChannelAuditSink(events chan<- AuditEvent) AuditSink {
+	return AuditSinkFunc(func(event AuditEvent) error {
+		select {
+		case events <- event:
+			return nil
+		default:
+			return ErrAuditChannelFull
+		}
+	})
+}
+
+// AuditOptions configures an AuditingClient
+type AuditOptions struct {
+	// Identity is recorded for the calls whose context has no pkg.WithCallerIdentity, i.e. the service key
+	Identity string
+}
+
+// AuditingClient decorates a SecretClient, recording every GetSecrets and StoreSecrets to an AuditSink, for the
+// devices where the secret store doesn't audit the accesses itself. The events which fail to be recorded are
+// logged, the calls themselves are not failed.
+type AuditingClient struct {
+	client  SecretClient
+	sink    AuditSink
+	lc      logger.LoggingClient
+	options AuditOptions
+	// now abstracts the clock of the event times, which is most useful for testing
+	now func() time.Time
+}
+
+// NewAuditingClient constructs an AuditingClient decorating client which records to sink
+func NewAuditingClient(client SecretClient, sink AuditSink, lc logger.LoggingClient, options AuditOptions) *AuditingClient {
+	return &AuditingClient{client: client, sink: sink, lc: lc, options: options, now: time.Now}
+}
+
+// GetSecrets retrieves the secrets with the decorated client and records the access
+func (c *AuditingClient) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	secrets, err := c.client.GetSecrets(ctx, subPath, keys...)
+	c.record(ctx, AuditOperationGet, subPath, append([]string(nil), keys...), err)
+	return secrets, err
+}
+
+// StoreSecrets stores the secrets with the decorated client and records the keys stored
+func (c *AuditingClient) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	err := c.client.StoreSecrets(ctx, subPath, secrets)
+
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	c.record(ctx, AuditOperationStore, subPath, keys, err)
+	return err
+}
+
+// RegisterSecretUpdatedCallback registers the callback with the decorated client
+func (c *AuditingClient) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.client.RegisterSecretUpdatedCallback(subPath, callback)
+}
+
+// ListSecretPaths lists the keys with the decorated client
+func (c *AuditingClient) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	return c.client.ListSecretPaths(ctx, subPath)
+}
+
+// GenerateConsulToken generates the token with the decorated client
+func (c *AuditingClient) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	return c.client.GenerateConsulToken(ctx, serviceKey)
+}
+
+func (c *AuditingClient) record(ctx context.Context, operation string, subPath string, keys []string, err error) {
+	identity, ok := pkg.CallerIdentityFromContext(ctx)
+	if !ok {
+		identity = c.options.Identity
+	}
+
+	event := AuditEvent{
+		Time:      c.now().UTC(),
+		Identity:  identity,
+		Operation: operation,
+		Path:      subPath,
+		Keys:      keys,
+		Outcome:   AuditSuccess,
+	}
+	if len(event.Keys) == 0 {
+		event.Keys = nil
+	}
+	if err != nil {
+		event.Outcome = AuditFailure
+		event.Error = err.Error()
+	}
+
+	if err := c.sink.Record(event); err != nil {
+		c.lc.Errorf("unable to record the audit event of %s of '%s': %s", operation, subPath, err.Error())
+	}
+}
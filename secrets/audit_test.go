@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/inmemory"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestAuditingClient(t *testing.T) {
+	events := make(chan AuditEvent, 10)
+	client := NewAuditingClient(inmemory.NewSecretsClient(types.SecretConfig{Path: "edgex"}, nil),
+		NewChannelAuditSink(events), logger.NewMockClient(), AuditOptions{Identity: "core-data"})
+	now := time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC)
+	client.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	require.NoError(t, client.StoreSecrets(ctx, "redisdb", map[string]string{"username": "redis5", "password": "secret"}))
+	_, err := client.GetSecrets(pkg.WithCallerIdentity(ctx, "admin"), "redisdb", "password")
+	require.NoError(t, err)
+	_, err = client.GetSecrets(ctx, "unknown")
+	require.Error(t, err)
+
+	require.Len(t, events, 3)
+	assert.Equal(t, AuditEvent{Time: now, Identity: "core-data", Operation: AuditOperationStore, Path: "redisdb",
+		Keys: []string{"password", "username"}, Outcome: AuditSuccess}, <-events)
+	assert.Equal(t, AuditEvent{Time: now, Identity: "admin", Operation: AuditOperationGet, Path: "redisdb",
+		Keys: []string{"password"}, Outcome: AuditSuccess}, <-events)
+	failed := <-events
+	assert.Equal(t, AuditFailure, failed.Outcome)
+	assert.Equal(t, err.Error(), failed.Error)
+	assert.Nil(t, failed.Keys)
+}
+
+func TestAuditSinkFailure(t *testing.T) {
+	sink := AuditSinkFunc(func(AuditEvent) error { return errors.New("disk full") })
+	client := NewAuditingClient(inmemory.NewSecretsClient(types.SecretConfig{}, nil), sink, logger.NewMockClient(),
+		AuditOptions{})
+
+	// the secrets are still accessed when the event can't be recorded
+	require.NoError(t, client.StoreSecrets(context.Background(), "redisdb", map[string]string{"password": "secret"}))
+	secrets, err := client.GetSecrets(context.Background(), "redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "secret"}, secrets)
+
+	full := NewChannelAuditSink(make(chan AuditEvent))
+	assert.Equal(t, ErrAuditChannelFull, full.Record(AuditEvent{}))
+}
+
+func TestFileAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "audit.log")
+	sink, err := NewFileAuditSink(path)
+	require.NoError(t, err)
+
+	client := NewAuditingClient(inmemory.NewSecretsClient(types.SecretConfig{}, nil), sink, logger.NewMockClient(),
+		AuditOptions{Identity: "core-data"})
+	require.NoError(t, client.StoreSecrets(context.Background(), "redisdb", map[string]string{"password": "secret"}))
+	_, err = client.GetSecrets(context.Background(), "redisdb")
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+
+	// appended to, not truncated, when opened again
+	sink, err = NewFileAuditSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Record(AuditEvent{Operation: AuditOperationGet, Path: "mqtt", Outcome: AuditSuccess}))
+	require.NoError(t, sink.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	var paths []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		assert.NotContains(t, scanner.Text(), "secret\"", "secret value recorded")
+		var event AuditEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		paths = append(paths, event.Operation+" "+event.Path)
+	}
+	assert.Equal(t, []string{"StoreSecrets redisdb", "GetSecrets redisdb", "GetSecrets mqtt"}, paths)
+}
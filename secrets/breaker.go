@@ -0,0 +1,278 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+	defaultHalfOpenProbes   = 1
+)
+
+// BreakerState is the state of a CircuitBreakerClient
+type BreakerState int
+
+const (
+	// BreakerClosed passes every call through to the secret store
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects every call with pkg.ErrCircuitOpen until the open duration has passed
+	BreakerOpen
+	// BreakerHalfOpen lets a limited number of probe calls through, closing on the first success
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerOptions configures a CircuitBreakerClient. The zero value of each option selects its default.
+type BreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit, defaults to 5
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before probing the secret store, defaults to 30s
+	OpenDuration time.Duration
+	// HalfOpenProbes is the number of calls let through concurrently while half-open, defaults to 1
+	HalfOpenProbes int
+	// IsFailure decides which errors count towards opening the circuit, defaults to IsStoreFailure
+	IsFailure func(err error) bool
+	// OnStateChange is called, with the lock held, whenever the circuit changes state
+	OnStateChange func(from BreakerState, to BreakerState)
+}
+
+// IsStoreFailure reports whether err means the secret store is failing rather than the request being refused,
+// so missing secrets, version conflicts, denied permissions and calls cancelled by the caller are not failures.
+func IsStoreFailure(err error) bool {
+	switch err.(type) {
+	case nil, pkg.ErrSecretsNotFound, pkg.ErrSecretVersionConflict, pkg.ErrMissingCapabilities:
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, pkg.ErrSecretNotFound{}) &&
+		!errors.Is(err, pkg.ErrPermissionDenied{}) && !errors.Is(err, pkg.ErrAlreadyExists{})
+}
+
+// CircuitBreakerClient decorates a SecretClient with a circuit breaker, so while the secret store is down calls
+// fail fast with pkg.ErrCircuitOpen instead of each one waiting out the request timeout.
+type CircuitBreakerClient struct {
+	client   SecretClient
+	options  BreakerOptions
+	mutex    sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probes   int
+	// halfOpens counts the half-open periods, telling the probes of the current one from those of earlier ones
+	halfOpens uint64
+	// now abstracts the clock used for the open duration, which is most useful for testing
+	now func() time.Time
+}
+
+// NewCircuitBreakerClient constructs a closed CircuitBreakerClient decorating client
+func NewCircuitBreakerClient(client SecretClient, options BreakerOptions) *CircuitBreakerClient {
+	if options.FailureThreshold <= 0 {
+		options.FailureThreshold = defaultFailureThreshold
+	}
+	if options.OpenDuration <= 0 {
+		options.OpenDuration = defaultOpenDuration
+	}
+	if options.HalfOpenProbes <= 0 {
+		options.HalfOpenProbes = defaultHalfOpenProbes
+	}
+	if options.IsFailure == nil {
+		options.IsFailure = IsStoreFailure
+	}
+
+	return &CircuitBreakerClient{
+		client:  client,
+		options: options,
+		now:     time.Now,
+	}
+}
+
+// GetSecrets retrieves the secrets with the decorated client unless the circuit is open
+func (c *CircuitBreakerClient) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	probe, err := c.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := c.client.GetSecrets(ctx, subPath, keys...)
+	c.record(probe, err)
+	return secrets, err
+}
+
+// StoreSecrets stores the secrets with the decorated client unless the circuit is open
+func (c *CircuitBreakerClient) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	probe, err := c.allow()
+	if err != nil {
+		return err
+	}
+
+	err = c.client.StoreSecrets(ctx, subPath, secrets)
+	c.record(probe, err)
+	return err
+}
+
+// RegisterSecretUpdatedCallback registers callback with the decorated client
+func (c *CircuitBreakerClient) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.client.RegisterSecretUpdatedCallback(subPath, callback)
+}
+
+// ListSecretPaths lists the keys under the sub-path with the decorated client unless the circuit is open
+func (c *CircuitBreakerClient) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	probe, err := c.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.client.ListSecretPaths(ctx, subPath)
+	c.record(probe, err)
+	return keys, err
+}
+
+// GenerateConsulToken generates a Consul token with the decorated client unless the circuit is open
+func (c *CircuitBreakerClient) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	probe, err := c.allow()
+	if err != nil {
+		return "", err
+	}
+
+	token, err := c.client.GenerateConsulToken(ctx, serviceKey)
+	c.record(probe, err)
+	return token, err
+}
+
+// State returns the current state of the circuit, which becomes half-open once the open duration has passed
+func (c *CircuitBreakerClient) State() BreakerState {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.state == BreakerOpen && !c.now().Before(c.openedAt.Add(c.options.OpenDuration)) {
+		return BreakerHalfOpen
+	}
+	return c.state
+}
+
+// Reset closes the circuit, i.e. after the secret store is known to have recovered
+func (c *CircuitBreakerClient) Reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.failures = 0
+	c.setState(BreakerClosed)
+}
+
+// breakerProbe marks a call admitted as a probe of the half-open period it was admitted in
+type breakerProbe struct {
+	probe    bool
+	halfOpen uint64
+}
+
+// allow returns pkg.ErrCircuitOpen when the call must not reach the secret store, otherwise counting a probe
+// when half-open
+func (c *CircuitBreakerClient) allow() (breakerProbe, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	retryAt := c.openedAt.Add(c.options.OpenDuration)
+	if c.state == BreakerOpen {
+		if c.now().Before(retryAt) {
+			return breakerProbe{}, pkg.NewErrCircuitOpen(retryAt)
+		}
+		c.setState(BreakerHalfOpen)
+	}
+
+	if c.state == BreakerHalfOpen {
+		if c.probes >= c.options.HalfOpenProbes {
+			return breakerProbe{}, pkg.NewErrCircuitOpen(retryAt)
+		}
+		c.probes++
+		return breakerProbe{probe: true, halfOpen: c.halfOpens}, nil
+	}
+
+	return breakerProbe{}, nil
+}
+
+// record updates the circuit with the outcome of a call allowed through. While half-open only the outcome of the
+// probes of the current period counts, a call admitted while closed or in an earlier period is ignored.
+func (c *CircuitBreakerClient) record(probe breakerProbe, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	failed := err != nil && c.options.IsFailure(err)
+	switch c.state {
+	case BreakerHalfOpen:
+		if !probe.probe || probe.halfOpen != c.halfOpens {
+			return
+		}
+		c.probes--
+		if failed {
+			c.open()
+		} else if !errors.Is(err, context.Canceled) {
+			c.failures = 0
+			c.setState(BreakerClosed)
+		}
+	case BreakerClosed:
+		if !failed {
+			c.failures = 0
+			return
+		}
+		c.failures++
+		if c.failures >= c.options.FailureThreshold {
+			c.open()
+		}
+	}
+}
+
+// open must be called with the mutex held
+func (c *CircuitBreakerClient) open() {
+	c.openedAt = c.now()
+	c.setState(BreakerOpen)
+}
+
+// setState must be called with the mutex held
+func (c *CircuitBreakerClient) setState(state BreakerState) {
+	if state != BreakerHalfOpen {
+		c.probes = 0
+	}
+	if c.state == state {
+		return
+	}
+
+	if state == BreakerHalfOpen {
+		c.halfOpens++
+	}
+	from := c.state
+	c.state = state
+	if c.options.OnStateChange != nil {
+		c.options.OnStateChange(from, state)
+	}
+}
@@ -0,0 +1,177 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	unreachable := errors.New("connect: connection refused")
+
+	client := &mocks.SecretClient{}
+	client.On("GetSecrets", mock.Anything, "/down").Return(nil, unreachable)
+	client.On("GetSecrets", mock.Anything, "/redisdb").Return(map[string]string{"password": "password"}, nil)
+	client.On("GetSecrets", mock.Anything, "/missing").Return(nil, pkg.NewErrSecretsNotFound([]string{"password"}))
+
+	var transitions []BreakerState
+	breaker := NewCircuitBreakerClient(client, BreakerOptions{
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+		OnStateChange:    func(_ BreakerState, to BreakerState) { transitions = append(transitions, to) },
+	})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	// refused requests are not failures of the secret store, and a success resets the count
+	_, err := breaker.GetSecrets(ctx, "/down")
+	require.Error(t, err)
+	_, err = breaker.GetSecrets(ctx, "/missing")
+	require.Error(t, err)
+	_, err = breaker.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	_, err = breaker.GetSecrets(ctx, "/down")
+	require.Error(t, err)
+	assert.Equal(t, BreakerClosed, breaker.State())
+
+	// opened by consecutive failures, failing fast without calling the secret store
+	_, err = breaker.GetSecrets(ctx, "/down")
+	require.Equal(t, unreachable, err)
+	assert.Equal(t, BreakerOpen, breaker.State())
+	_, err = breaker.GetSecrets(ctx, "/redisdb")
+	require.Error(t, err)
+	require.IsType(t, pkg.ErrCircuitOpen{}, err)
+	assert.Equal(t, now.Add(time.Minute), err.(pkg.ErrCircuitOpen).RetryAt())
+	client.AssertNumberOfCalls(t, "GetSecrets", 5)
+
+	// a failed probe opens the circuit again
+	now = now.Add(time.Minute)
+	assert.Equal(t, BreakerHalfOpen, breaker.State())
+	_, err = breaker.GetSecrets(ctx, "/down")
+	require.Equal(t, unreachable, err)
+	assert.Equal(t, BreakerOpen, breaker.State())
+
+	// a successful probe closes it
+	now = now.Add(time.Minute)
+	_, err = breaker.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, BreakerClosed, breaker.State())
+	client.AssertNumberOfCalls(t, "GetSecrets", 7)
+
+	assert.Equal(t, []BreakerState{BreakerOpen, BreakerHalfOpen, BreakerOpen, BreakerHalfOpen, BreakerClosed}, transitions)
+}
+
+func TestCircuitBreakerHalfOpenProbes(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan time.Time)
+
+	client := &mocks.SecretClient{}
+	client.On("StoreSecrets", mock.Anything, "/slow", mock.Anything).Return(nil).WaitUntil(release)
+	client.On("StoreSecrets", mock.Anything, "/down", mock.Anything).Return(errors.New("connect: connection refused"))
+
+	breaker := NewCircuitBreakerClient(client, BreakerOptions{FailureThreshold: 1, OpenDuration: time.Minute})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	require.Error(t, breaker.StoreSecrets(ctx, "/down", nil))
+	now = now.Add(time.Minute)
+
+	// only one probe is let through while half-open
+	done := make(chan error)
+	go func() { done <- breaker.StoreSecrets(ctx, "/slow", nil) }()
+	require.Eventually(t, func() bool {
+		breaker.mutex.Lock()
+		defer breaker.mutex.Unlock()
+		return breaker.probes == 1
+	}, time.Second, time.Millisecond)
+
+	err := breaker.StoreSecrets(ctx, "/slow", nil)
+	require.IsType(t, pkg.ErrCircuitOpen{}, err)
+
+	close(release)
+	require.NoError(t, <-done)
+	assert.Equal(t, BreakerClosed, breaker.State())
+
+	breaker.Reset()
+	assert.Equal(t, BreakerClosed, breaker.State())
+}
+
+func TestCircuitBreakerIgnoresCallsAdmittedClosed(t *testing.T) {
+	ctx := context.Background()
+	started := make(chan struct{})
+	releaseClosed := make(chan struct{})
+	releaseProbe := make(chan time.Time)
+
+	client := &mocks.SecretClient{}
+	client.On("StoreSecrets", mock.Anything, "/admitted-closed", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		close(started)
+		<-releaseClosed
+	})
+	client.On("StoreSecrets", mock.Anything, "/probe", mock.Anything).Return(nil).WaitUntil(releaseProbe)
+	client.On("StoreSecrets", mock.Anything, "/redisdb", mock.Anything).Return(nil)
+	client.On("StoreSecrets", mock.Anything, "/down", mock.Anything).Return(errors.New("connect: connection refused"))
+
+	breaker := NewCircuitBreakerClient(client, BreakerOptions{FailureThreshold: 1, OpenDuration: time.Minute})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	probes := func() int {
+		breaker.mutex.Lock()
+		defer breaker.mutex.Unlock()
+		return breaker.probes
+	}
+
+	// a call admitted while closed is still running when the circuit opens and becomes half-open
+	closedDone := make(chan error)
+	go func() { closedDone <- breaker.StoreSecrets(ctx, "/admitted-closed", nil) }()
+	<-started
+	require.Error(t, breaker.StoreSecrets(ctx, "/down", nil))
+	now = now.Add(time.Minute)
+
+	probeDone := make(chan error)
+	go func() { probeDone <- breaker.StoreSecrets(ctx, "/probe", nil) }()
+	require.Eventually(t, func() bool { return probes() == 1 }, time.Second, time.Millisecond)
+
+	// its outcome neither frees the probe nor closes the circuit
+	close(releaseClosed)
+	require.NoError(t, <-closedDone)
+	assert.Equal(t, 1, probes())
+	assert.Equal(t, BreakerHalfOpen, breaker.State())
+	require.IsType(t, pkg.ErrCircuitOpen{}, breaker.StoreSecrets(ctx, "/redisdb", nil))
+
+	close(releaseProbe)
+	require.NoError(t, <-probeDone)
+	assert.Equal(t, 0, probes())
+	assert.Equal(t, BreakerClosed, breaker.State())
+}
+
+func TestIsStoreFailure(t *testing.T) {
+	assert.False(t, IsStoreFailure(nil))
+	assert.False(t, IsStoreFailure(pkg.NewErrSecretsNotFound([]string{"password"})))
+	assert.False(t, IsStoreFailure(pkg.NewErrSecretVersionConflict("/redisdb", 1)))
+	assert.False(t, IsStoreFailure(context.Canceled))
+	assert.True(t, IsStoreFailure(context.DeadlineExceeded))
+	assert.True(t, IsStoreFailure(pkg.NewErrSecretStore("Received a '500' response")))
+}
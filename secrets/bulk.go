@@ -0,0 +1,86 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// DefaultBulkParallelism is the number of sub-paths GetMultipleSecrets reads at once when no parallelism is given
+const DefaultBulkParallelism = 4
+
+// GetMultipleSecrets retrieves all the secrets of each sub-path with client, reading up to parallelism sub-paths
+// concurrently, so a service reading several credentials at startup waits for the slowest read rather than their
+// sum. The secrets are returned keyed by sub-path. When some of the sub-paths fail the secrets of the others are
+// still returned, along with a pkg.ErrMultipleSecrets holding the error of each failed sub-path.
+func GetMultipleSecrets(ctx context.Context, client SecretClient, subPaths []string, parallelism int) (map[string]map[string]string, error) {
+	if parallelism <= 0 {
+		parallelism = DefaultBulkParallelism
+	}
+
+	var mutex sync.Mutex
+	secrets := make(map[string]map[string]string, len(subPaths))
+	failures := make(map[string]error)
+	record := func(subPath string, data map[string]string, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			failures[subPath] = err
+			return
+		}
+		secrets[subPath] = data
+	}
+
+	limit := make(chan struct{}, parallelism)
+	var wait sync.WaitGroup
+	started := make(map[string]bool, len(subPaths))
+	for _, subPath := range subPaths {
+		if started[subPath] {
+			continue
+		}
+		started[subPath] = true
+
+		// the sub-paths not read yet fail with the context's error once it is done
+		if ctx.Err() != nil {
+			record(subPath, nil, ctx.Err())
+			continue
+		}
+		select {
+		case limit <- struct{}{}:
+		case <-ctx.Done():
+			record(subPath, nil, ctx.Err())
+			continue
+		}
+
+		wait.Add(1)
+		go func(subPath string) {
+			defer func() {
+				<-limit
+				wait.Done()
+			}()
+			data, err := client.GetSecrets(ctx, subPath)
+			record(subPath, data, err)
+		}(subPath)
+	}
+	wait.Wait()
+
+	if len(failures) > 0 {
+		return secrets, pkg.NewErrMultipleSecrets(failures)
+	}
+	return secrets, nil
+}
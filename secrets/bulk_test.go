@@ -0,0 +1,79 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+)
+
+func TestGetMultipleSecrets(t *testing.T) {
+	client := newCountingClient()
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	client.On("GetSecrets", mock.Anything, "/slow").Return(map[string]string{"key": "slow"}, nil).Run(func(mock.Arguments) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+	})
+
+	subPaths := []string{"/redisdb", "/mqtt", "/unknown", "/redisdb", "/slow", "/slow", "/slow"}
+	secrets, err := GetMultipleSecrets(context.Background(), client, subPaths, 2)
+
+	assert.Equal(t, map[string]map[string]string{
+		"/redisdb": {"username": "redis5", "password": "password"},
+		"/mqtt":    {"password": "mqtt"},
+		"/slow":    {"key": "slow"},
+	}, secrets)
+	var multiErr pkg.ErrMultipleSecrets
+	require.True(t, errors.As(err, &multiErr), err)
+	assert.Len(t, multiErr.Errors(), 1)
+	assert.IsType(t, pkg.ErrSecretStore{}, multiErr.Errors()["/unknown"])
+	client.AssertNumberOfCalls(t, "GetSecrets", 4)
+	assert.LessOrEqual(t, maxRunning, 2)
+}
+
+func TestGetMultipleSecretsCanceled(t *testing.T) {
+	client := &mocks.SecretClient{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	secrets, err := GetMultipleSecrets(ctx, client, []string{"/redisdb", "/mqtt"}, 0)
+	assert.Empty(t, secrets)
+	var multiErr pkg.ErrMultipleSecrets
+	require.True(t, errors.As(err, &multiErr), err)
+	assert.Len(t, multiErr.Errors(), 2)
+	assert.True(t, errors.Is(multiErr.Errors()["/mqtt"], context.Canceled))
+}
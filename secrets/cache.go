@@ -0,0 +1,220 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
+
+// CacheOptions configures a CachingClient
+type CacheOptions struct {
+	// TTL is how long the secrets of a sub-path are cached
+	TTL time.Duration
+	// PathTTLs overrides TTL for individual sub-paths
+	PathTTLs map[string]time.Duration
+	// MaxEntries limits the number of cached sub-paths, evicting the least recently used. Zero means no limit.
+	MaxEntries int
+	// Metrics, when set, records whether each GetSecrets is served from the cache
+	Metrics pkg.Metrics
+}
+
+type cacheEntry struct {
+	subPath string
+	secrets map[string]string
+	expiry  time.Time
+}
+
+// CachingClient is a read-through cache decorating a SecretClient. All the secrets of a sub-path are cached on
+// the first GetSecrets and the requested keys are filtered from the cache until the entry expires.
+type CachingClient struct {
+	client  SecretClient
+	options CacheOptions
+	mutex   sync.Mutex
+	// entries indexes the elements of lru, which holds the *cacheEntry values with the most recently used first
+	entries map[string]*list.Element
+	lru     *list.List
+	// generation counts the invalidations, invalidated holds the generation of the last invalidation of each
+	// sub-path and invalidatedAll that of the last InvalidateAll. A read started before an invalidation of its
+	// sub-path is not added, so a stale read can never be cached after the invalidation of a StoreSecrets.
+	generation     uint64
+	invalidated    map[string]uint64
+	invalidatedAll uint64
+	// now abstracts the clock used for expiry, which is most useful for testing
+	now func() time.Time
+}
+
+// NewCachingClient constructs a CachingClient decorating client
+func NewCachingClient(client SecretClient, options CacheOptions) *CachingClient {
+	return &CachingClient{
+		client:      client,
+		options:     options,
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		invalidated: make(map[string]uint64),
+		now:         time.Now,
+	}
+}
+
+// GetSecrets retrieves the secrets at the provided sub-path that matches the specified keys, from the cache
+// when present and not expired.
+func (c *CachingClient) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	secrets, generation, ok := c.lookup(subPath)
+	if c.options.Metrics != nil {
+		c.options.Metrics.CacheLookup(ok)
+	}
+	if ok {
+		return pkg.FilterSecrets(secrets, keys...)
+	}
+
+	secrets, err := c.client.GetSecrets(ctx, subPath)
+	if err != nil {
+		return nil, err
+	}
+
+	c.add(subPath, secrets, generation)
+
+	return pkg.FilterSecrets(copySecrets(secrets), keys...)
+}
+
+// StoreSecrets stores the secrets with the decorated client and invalidates the cached sub-path
+func (c *CachingClient) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	defer c.Invalidate(subPath)
+	return c.client.StoreSecrets(ctx, subPath, secrets)
+}
+
+// RegisterSecretUpdatedCallback registers callback with the decorated client
+func (c *CachingClient) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.client.RegisterSecretUpdatedCallback(subPath, callback)
+}
+
+// ListSecretPaths lists the keys under the sub-path with the decorated client, the listings are never cached
+func (c *CachingClient) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	return c.client.ListSecretPaths(ctx, subPath)
+}
+
+// GenerateConsulToken generates a Consul token with the decorated client, the tokens are never cached
+func (c *CachingClient) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	return c.client.GenerateConsulToken(ctx, serviceKey)
+}
+
+// Invalidate removes the cached secrets of the sub-path, so the next GetSecrets reads them from the secret store
+func (c *CachingClient) Invalidate(subPath string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	c.invalidated[subPath] = c.generation
+	if element, ok := c.entries[subPath]; ok {
+		c.remove(element)
+	}
+}
+
+// Destroy removes all the cached secrets, clearing the maps holding them so no reference to the secrets is left
+// for the garbage collector to skip. The copies of the secrets returned by GetSecrets are left to their callers.
+func (c *CachingClient) Destroy() {
+	c.mutex.Lock()
+	for _, element := range c.entries {
+		secrets := element.Value.(*cacheEntry).secrets
+		for key := range secrets {
+			delete(secrets, key)
+		}
+	}
+	c.mutex.Unlock()
+
+	c.InvalidateAll()
+}
+
+// InvalidateAll removes all the cached secrets
+func (c *CachingClient) InvalidateAll() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.generation++
+	c.invalidatedAll = c.generation
+	// the invalidations of the sub-paths are all older than this one
+	c.invalidated = make(map[string]uint64)
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+// lookup returns a copy of the cached secrets, removing the entry if it has expired. On a miss the current
+// generation is returned for the add of the secrets read.
+func (c *CachingClient) lookup(subPath string) (map[string]string, uint64, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[subPath]
+	if !ok {
+		return nil, c.generation, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiry) {
+		c.remove(element)
+		return nil, c.generation, false
+	}
+
+	c.lru.MoveToFront(element)
+	return copySecrets(entry.secrets), c.generation, true
+}
+
+// add caches the secrets read at generation, unless the sub-path has been invalidated since
+func (c *CachingClient) add(subPath string, secrets map[string]string, generation uint64) {
+	ttl := c.options.TTL
+	if pathTTL, ok := c.options.PathTTLs[subPath]; ok {
+		ttl = pathTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.invalidatedAll > generation || c.invalidated[subPath] > generation {
+		return
+	}
+
+	entry := &cacheEntry{subPath: subPath, secrets: copySecrets(secrets), expiry: c.now().Add(ttl)}
+	if element, ok := c.entries[subPath]; ok {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[subPath] = c.lru.PushFront(entry)
+	for c.options.MaxEntries > 0 && c.lru.Len() > c.options.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove must be called with the mutex held
+func (c *CachingClient) remove(element *list.Element) {
+	c.lru.Remove(element)
+	delete(c.entries, element.Value.(*cacheEntry).subPath)
+}
+
+func copySecrets(secrets map[string]string) map[string]string {
+	copied := make(map[string]string, len(secrets))
+	for key, value := range secrets {
+		copied[key] = value
+	}
+	return copied
+}
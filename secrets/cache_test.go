@@ -0,0 +1,229 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/metrics"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+)
+
+func newCountingClient() *mocks.SecretClient {
+	client := &mocks.SecretClient{}
+	client.On("GetSecrets", mock.Anything, "/redisdb").Return(map[string]string{"username": "redis5", "password": "password"}, nil)
+	client.On("GetSecrets", mock.Anything, "/mqtt").Return(map[string]string{"password": "mqtt"}, nil)
+	client.On("GetSecrets", mock.Anything, "/unknown").Return(nil, pkg.NewErrSecretStore("not found"))
+	client.On("StoreSecrets", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	return client
+}
+
+func TestCachingGetSecrets(t *testing.T) {
+	ctx := context.Background()
+	client := newCountingClient()
+	collector := metrics.NewCollector()
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute, Metrics: collector})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	actual, err := cache.GetSecrets(ctx, "/redisdb", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "password"}, actual)
+
+	// served from the cache, including keys other than the first request's
+	actual, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "redis5", "password": "password"}, actual)
+	_, err = cache.GetSecrets(ctx, "/redisdb", "missing")
+	require.Error(t, err)
+	assert.IsType(t, pkg.ErrSecretsNotFound{}, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 1)
+
+	// modifying the returned secrets must not change the cache
+	actual["password"] = "changed"
+	actual, err = cache.GetSecrets(ctx, "/redisdb", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "password", actual["password"])
+
+	// errors are not cached
+	_, err = cache.GetSecrets(ctx, "/unknown")
+	require.Error(t, err)
+	_, err = cache.GetSecrets(ctx, "/unknown")
+	require.Error(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 3)
+
+	// expired entries are read again
+	now = now.Add(time.Minute)
+	_, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 4)
+	assert.Equal(t, map[bool]uint64{true: 3, false: 4}, collector.Snapshot().CacheLookups)
+}
+
+func TestCachingPathTTL(t *testing.T) {
+	ctx := context.Background()
+	client := newCountingClient()
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute, PathTTLs: map[string]time.Duration{"/mqtt": 0}})
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.GetSecrets(ctx, "/mqtt")
+		require.NoError(t, err)
+	}
+	client.AssertNumberOfCalls(t, "GetSecrets", 2)
+}
+
+func TestCachingMaxEntries(t *testing.T) {
+	ctx := context.Background()
+	client := newCountingClient()
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute, MaxEntries: 1})
+
+	_, err := cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	_, err = cache.GetSecrets(ctx, "/mqtt")
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.lru.Len())
+
+	// /redisdb was evicted as the least recently used
+	_, err = cache.GetSecrets(ctx, "/mqtt")
+	require.NoError(t, err)
+	_, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 3)
+}
+
+func TestCachingInvalidate(t *testing.T) {
+	ctx := context.Background()
+	client := newCountingClient()
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute})
+
+	_, err := cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	cache.Invalidate("/redisdb")
+	_, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 2)
+
+	// storing invalidates the sub-path
+	require.NoError(t, cache.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "new"}))
+	_, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 3)
+
+	cache.InvalidateAll()
+	assert.Equal(t, 0, cache.lru.Len())
+}
+
+func TestCachingStaleReadDropped(t *testing.T) {
+	ctx := context.Background()
+	reading := make(chan struct{})
+	release := make(chan struct{})
+	client := &mocks.SecretClient{}
+	client.On("GetSecrets", mock.Anything, "/redisdb").Return(map[string]string{"password": "old"}, nil).Run(func(mock.Arguments) {
+		close(reading)
+		<-release
+	}).Once()
+	client.On("GetSecrets", mock.Anything, "/redisdb").Return(map[string]string{"password": "new"}, nil)
+	client.On("StoreSecrets", mock.Anything, "/redisdb", mock.Anything).Return(nil)
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute})
+
+	// a read of the old secrets completing after the store must not be cached
+	done := make(chan map[string]string)
+	go func() {
+		secrets, err := cache.GetSecrets(ctx, "/redisdb")
+		assert.NoError(t, err)
+		done <- secrets
+	}()
+	<-reading
+	require.NoError(t, cache.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "new"}))
+	close(release)
+	assert.Equal(t, map[string]string{"password": "old"}, <-done)
+
+	actual, err := cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "new"}, actual)
+	actual, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "new"}, actual)
+	client.AssertNumberOfCalls(t, "GetSecrets", 2)
+}
+
+func TestCachingConcurrentStores(t *testing.T) {
+	ctx := context.Background()
+	var mutex sync.Mutex
+	stored := map[string]string{"version": "0"}
+	client := &mocks.SecretClient{}
+	client.On("GetSecrets", mock.Anything, "/redisdb").Return(func(context.Context, string, ...string) map[string]string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return copySecrets(stored)
+	}, nil)
+	client.On("StoreSecrets", mock.Anything, "/redisdb", mock.Anything).Return(func(_ context.Context, _ string, secrets map[string]string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		stored = copySecrets(secrets)
+		return nil
+	})
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(2)
+		go func(version int) {
+			defer wg.Done()
+			assert.NoError(t, cache.StoreSecrets(ctx, "/redisdb", map[string]string{"version": strconv.Itoa(version)}))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, err := cache.GetSecrets(ctx, "/redisdb")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// once the writes are done the cache holds the last stored secrets
+	actual, err := cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, stored, actual)
+}
+
+func TestCachingDestroy(t *testing.T) {
+	ctx := context.Background()
+	client := newCountingClient()
+	cache := NewCachingClient(client, CacheOptions{TTL: time.Minute})
+
+	_, err := cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	cached := cache.entries["/redisdb"].Value.(*cacheEntry).secrets
+
+	cache.Destroy()
+	assert.Empty(t, cached)
+	assert.Empty(t, cache.entries)
+
+	// read from the secret store again
+	_, err = cache.GetSecrets(ctx, "/redisdb")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetSecrets", 2)
+}
@@ -0,0 +1,156 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// CompositeClient is a SecretClient which chains an ordered list of providers, i.e. Vault first with a local
+// encrypted file as the fallback for when Vault is sealed or unreachable.
+type CompositeClient struct {
+	clients      []SecretClient
+	writeThrough bool
+	lc           logger.LoggingClient
+	// updateCallbacks are notified after StoreSecrets succeeds, once however many clients stored the secrets
+	updateCallbacks pkg.SecretUpdatedCallbacks
+}
+
+// NewCompositeClient constructs a CompositeClient which tries clients in the order given.
+// When writeThrough is true StoreSecrets writes to every client, otherwise only to the first client accepting it.
+func NewCompositeClient(lc logger.LoggingClient, writeThrough bool, clients ...SecretClient) (*CompositeClient, error) {
+	if len(clients) == 0 {
+		return nil, pkg.NewErrSecretStore("at least one SecretClient is required for the composite client")
+	}
+
+	return &CompositeClient{
+		clients:      clients,
+		writeThrough: writeThrough,
+		lc:           lc,
+	}, nil
+}
+
+// GetSecrets returns the secrets from the first client which successfully retrieves them.
+// If every client fails the error from the first client is returned.
+func (c *CompositeClient) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
+	var firstErr error
+	for index, client := range c.clients {
+		secrets, err := client.GetSecrets(ctx, subPath, keys...)
+		if err == nil {
+			return secrets, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		c.lc.Debugf("composite client provider %d failed to get secrets at '%s': %s", index, subPath, err.Error())
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+// StoreSecrets stores the secrets to every client when writing through, returning an error naming the failed
+// providers if any fail. Otherwise the secrets are stored to the first client which successfully stores them.
+func (c *CompositeClient) StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error {
+	var failures []string
+	var firstErr error
+	for index, client := range c.clients {
+		err := client.StoreSecrets(ctx, subPath, secrets)
+		if err == nil {
+			if !c.writeThrough {
+				c.updateCallbacks.Notify(subPath)
+				return nil
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+
+		c.lc.Debugf("composite client provider %d failed to store secrets at '%s': %s", index, subPath, err.Error())
+		failures = append(failures, fmt.Sprintf("provider %d: %s", index, err.Error()))
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	switch {
+	case len(failures) == 0:
+		c.updateCallbacks.Notify(subPath)
+		return nil
+	case !c.writeThrough:
+		return firstErr
+	default:
+		return pkg.NewErrSecretStore(fmt.Sprintf("unable to write secrets at '%s' through to all providers: %s",
+			subPath, strings.Join(failures, "; ")))
+	}
+}
+
+// RegisterSecretUpdatedCallback registers callback to be notified after StoreSecrets succeeds at the sub-path
+func (c *CompositeClient) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	c.updateCallbacks.Register(subPath, callback)
+}
+
+// ListSecretPaths returns the keys from the first client which successfully lists them.
+// If every client fails the error from the first client is returned.
+func (c *CompositeClient) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	var firstErr error
+	for index, client := range c.clients {
+		keys, err := client.ListSecretPaths(ctx, subPath)
+		if err == nil {
+			return keys, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		c.lc.Debugf("composite client provider %d failed to list secrets at '%s': %s", index, subPath, err.Error())
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+// GenerateConsulToken returns the token from the first client which successfully generates one
+func (c *CompositeClient) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	var firstErr error
+	for index, client := range c.clients {
+		token, err := client.GenerateConsulToken(ctx, serviceKey)
+		if err == nil {
+			return token, nil
+		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+
+		c.lc.Debugf("composite client provider %d failed to generate Consul token: %s", index, err.Error())
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return "", firstErr
+}
@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/inmemory"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func newSealedClient() *mocks.SecretClient {
+	sealed := &mocks.SecretClient{}
+	sealedErr := pkg.NewErrSecretStore("secret store is sealed")
+	sealed.On("GetSecrets", mock.Anything, mock.Anything, mock.Anything).Return(nil, sealedErr)
+	sealed.On("StoreSecrets", mock.Anything, mock.Anything, mock.Anything).Return(sealedErr)
+	sealed.On("GenerateConsulToken", mock.Anything, mock.Anything).Return("", sealedErr)
+	sealed.On("ListSecretPaths", mock.Anything, mock.Anything).Return(nil, sealedErr)
+	return sealed
+}
+
+func TestNewCompositeClient(t *testing.T) {
+	_, err := NewCompositeClient(logger.NewMockClient(), false)
+	require.Error(t, err)
+}
+
+func TestCompositeGetSecrets(t *testing.T) {
+	ctx := context.Background()
+	fallback := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+	require.NoError(t, fallback.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "fallback"}))
+
+	composite, err := NewCompositeClient(logger.NewMockClient(), false, newSealedClient(), fallback)
+	require.NoError(t, err)
+
+	actual, err := composite.GetSecrets(ctx, "/redisdb", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "fallback"}, actual)
+
+	// the primary's error is returned when every provider fails
+	_, err = composite.GetSecrets(ctx, "/unknown")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "secret store is sealed")
+
+	// the primary is preferred when it has the secrets
+	primary := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+	require.NoError(t, primary.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "primary"}))
+	composite, err = NewCompositeClient(logger.NewMockClient(), false, primary, fallback)
+	require.NoError(t, err)
+	actual, err = composite.GetSecrets(ctx, "/redisdb", "password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"password": "primary"}, actual)
+}
+
+func TestCompositeListSecretPaths(t *testing.T) {
+	ctx := context.Background()
+	fallback := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+	require.NoError(t, fallback.StoreSecrets(ctx, "/redisdb", map[string]string{"password": "fallback"}))
+
+	composite, err := NewCompositeClient(logger.NewMockClient(), false, newSealedClient(), fallback)
+	require.NoError(t, err)
+
+	keys, err := composite.ListSecretPaths(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"redisdb"}, keys)
+
+	composite, err = NewCompositeClient(logger.NewMockClient(), false, newSealedClient())
+	require.NoError(t, err)
+	_, err = composite.ListSecretPaths(ctx, "")
+	require.Error(t, err)
+}
+
+func TestCompositeCancelledContext(t *testing.T) {
+	fallback := &mocks.SecretClient{}
+	composite, err := NewCompositeClient(logger.NewMockClient(), false,
+		inmemory.NewSecretsClient(types.SecretConfig{}, nil), fallback)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = composite.GetSecrets(ctx, "/redisdb")
+	require.True(t, errors.Is(err, context.Canceled))
+	fallback.AssertNotCalled(t, "GetSecrets", mock.Anything, mock.Anything)
+}
+
+func TestCompositeStoreSecrets(t *testing.T) {
+	ctx := context.Background()
+	secrets := map[string]string{"password": "mqtt"}
+
+	t.Run("First accepting provider", func(t *testing.T) {
+		first := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+		second := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+		composite, err := NewCompositeClient(logger.NewMockClient(), false, newSealedClient(), first, second)
+		require.NoError(t, err)
+
+		require.NoError(t, composite.StoreSecrets(ctx, "/mqtt", secrets))
+		_, err = first.GetSecrets(ctx, "/mqtt")
+		require.NoError(t, err)
+		_, err = second.GetSecrets(ctx, "/mqtt")
+		require.Error(t, err)
+	})
+
+	t.Run("Write through", func(t *testing.T) {
+		first := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+		second := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+		composite, err := NewCompositeClient(logger.NewMockClient(), true, first, second)
+		require.NoError(t, err)
+		updates := 0
+		composite.RegisterSecretUpdatedCallback("/mqtt", func(string) { updates++ })
+
+		require.NoError(t, composite.StoreSecrets(ctx, "/mqtt", secrets))
+		assert.Equal(t, 1, updates, "the callback is notified once for all the providers")
+		for _, client := range []SecretClient{first, second} {
+			actual, err := client.GetSecrets(ctx, "/mqtt")
+			require.NoError(t, err)
+			assert.Equal(t, secrets, actual)
+		}
+	})
+
+	t.Run("Write through failure", func(t *testing.T) {
+		first := inmemory.NewSecretsClient(types.SecretConfig{}, nil)
+		composite, err := NewCompositeClient(logger.NewMockClient(), true, first, newSealedClient())
+		require.NoError(t, err)
+		composite.RegisterSecretUpdatedCallback("/mqtt", func(string) { t.Error("unexpected update callback") })
+
+		err = composite.StoreSecrets(ctx, "/mqtt", secrets)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "provider 1")
+
+		// the secrets are still stored to the providers which succeeded
+		_, err = first.GetSecrets(ctx, "/mqtt")
+		require.NoError(t, err)
+	})
+}
+
+func TestCompositeGenerateConsulToken(t *testing.T) {
+	composite, err := NewCompositeClient(logger.NewMockClient(), false,
+		newSealedClient(), inmemory.NewSecretsClient(types.SecretConfig{}, nil))
+	require.NoError(t, err)
+
+	token, err := composite.GenerateConsulToken(context.Background(), "core-data")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+}
@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ExportSecrets walks the ListSecretPaths results under rootPath, a sub-path of the configured Path, and returns the
+// whole subtree as a nested map. Keys are as listed: the value of a key ending with a "/" is the nested map of the
+// path, the value of any other key is the map[string]string of its secrets. Appending the keys to rootPath gives
+// the sub-path of each secret, so the map can be written back with StoreSecrets, i.e. to restore a backup. The
+// secrets of each path are read concurrently with GetMultipleSecrets.
+func ExportSecrets(ctx context.Context, client SecretClient, rootPath string) (map[string]interface{}, error) {
+	if rootPath != "" && !strings.HasSuffix(rootPath, "/") {
+		rootPath += "/"
+	}
+
+	keys, err := client.ListSecretPaths(ctx, rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the secrets at '%s': %w", rootPath, err)
+	}
+
+	tree := make(map[string]interface{}, len(keys))
+	var secretPaths []string
+	for _, key := range keys {
+		if strings.HasSuffix(key, "/") {
+			subtree, err := ExportSecrets(ctx, client, rootPath+key)
+			if err != nil {
+				return nil, err
+			}
+			tree[key] = subtree
+			continue
+		}
+		secretPaths = append(secretPaths, rootPath+key)
+	}
+
+	secrets, err := GetMultipleSecrets(ctx, client, secretPaths, DefaultBulkParallelism)
+	if err != nil {
+		return nil, err
+	}
+	for subPath, data := range secrets {
+		tree[strings.TrimPrefix(subPath, rootPath)] = data
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,65 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+)
+
+func TestExportSecrets(t *testing.T) {
+	client := &mocks.SecretClient{}
+	client.On("ListSecretPaths", mock.Anything, "").Return([]string{"mqtt/", "redisdb"}, nil)
+	client.On("ListSecretPaths", mock.Anything, "mqtt/").Return([]string{"broker", "tls/"}, nil)
+	client.On("ListSecretPaths", mock.Anything, "mqtt/tls/").Return([]string{"client"}, nil)
+	client.On("GetSecrets", mock.Anything, "redisdb").Return(map[string]string{"password": "redis"}, nil)
+	client.On("GetSecrets", mock.Anything, "mqtt/broker").Return(map[string]string{"password": "mqtt"}, nil)
+	client.On("GetSecrets", mock.Anything, "mqtt/tls/client").Return(map[string]string{"cert": "pem"}, nil)
+
+	tree, err := ExportSecrets(context.Background(), client, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"redisdb": map[string]string{"password": "redis"},
+		"mqtt/": map[string]interface{}{
+			"broker": map[string]string{"password": "mqtt"},
+			"tls/": map[string]interface{}{
+				"client": map[string]string{"cert": "pem"},
+			},
+		},
+	}, tree)
+
+	subtree, err := ExportSecrets(context.Background(), client, "mqtt/tls")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"client": map[string]string{"cert": "pem"}}, subtree)
+}
+
+func TestExportSecretsError(t *testing.T) {
+	client := &mocks.SecretClient{}
+	client.On("ListSecretPaths", mock.Anything, "").Return([]string{"denied/", "redisdb"}, nil)
+	client.On("ListSecretPaths", mock.Anything, "denied/").Return(nil, pkg.NewErrSecretStore("permission denied"))
+	client.On("GetSecrets", mock.Anything, "redisdb").Return(map[string]string{"password": "redis"}, nil)
+
+	_, err := ExportSecrets(context.Background(), client, "")
+	require.Error(t, err)
+	assert.True(t, errors.As(err, &pkg.ErrSecretStore{}))
+}
@@ -20,39 +20,145 @@ import (
 
 	"github.com/edgexfoundry/go-mod-secrets/v2/internal/pkg/vault"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/aws"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/azure"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/conjur"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/file"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/gcp"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/inmemory"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/kubernetes"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/onepassword"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
 )
 
-const Vault = "vault"
+const (
+	Vault       = "vault"
+	AWS         = "aws"
+	Azure       = "azure"
+	Kubernetes  = "kubernetes"
+	InMemory    = "inmemory"
+	File        = "file"
+	GCP         = "gcp"
+	Conjur      = "conjur"
+	OnePassword = "onepassword"
+)
+
+// the Vault client implements the optional SecretClient interfaces
+var (
+	_ ReauthenticationNotifier = (*vault.Client)(nil)
+	_ WarningNotifier          = (*vault.Client)(nil)
+	_ InstrumentedClient       = (*vault.Client)(nil)
+	_ MiddlewareClient         = (*vault.Client)(nil)
+	_ Destroyer                = (*vault.Client)(nil)
+	_ Destroyer                = (*CachingClient)(nil)
+	_ VersionedSecretClient    = (*vault.Client)(nil)
+	_ MountInfoClient          = (*vault.Client)(nil)
+	_ DynamicSecretClient      = (*vault.Client)(nil)
+	_ TransitClient            = (*vault.Client)(nil)
+	_ IdentityTokenClient      = (*vault.Client)(nil)
+	_ CapabilityClient         = (*vault.Client)(nil)
+	_ TokenInfoClient          = (*vault.Client)(nil)
+	_ ShutdownClient           = (*vault.Client)(nil)
+)
+
+func init() {
+	Register(Vault, func(ctx context.Context, config types.SecretConfig, lc logger.LoggingClient, callback pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := vault.NewSecretsClient(ctx, config, lc, callback)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(AWS, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := aws.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(Azure, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := azure.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(Kubernetes, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := kubernetes.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(InMemory, func(_ context.Context, config types.SecretConfig, _ logger.LoggingClient, callback pkg.TokenExpiredCallback) (SecretClient, error) {
+		return inmemory.NewSecretsClient(config, callback), nil
+	})
+	Register(File, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := file.NewSecretsClient(config, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(GCP, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := gcp.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(Conjur, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := conjur.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+	Register(OnePassword, func(_ context.Context, config types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		client, err := onepassword.NewSecretsClient(config, nil, lc)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	})
+}
 
 // NewSecretsClient creates a new instance of a SecretClient based on the passed in configuration.
 // The SecretClient allows access to secret(s) for the configured token.
+// config.Type selects the provider, which is one of the built-in providers or one added with Register.
+// The provider logs through lc with the credentials in its messages redacted.
 func NewSecretsClient(ctx context.Context, config types.SecretConfig, lc logger.LoggingClient, callback pkg.TokenExpiredCallback) (SecretClient, error) {
 	if ctx == nil {
 		return nil, pkg.NewErrSecretStore("background ctx is required and cannot be nil")
 	}
 
-	// Currently only have a Vault implementation, so no need to have/check type.
-
-	switch config.Type {
-	// Currently only have a Vault implementation, so type isn't actual set in configuration
-	case Vault:
-		return vault.NewSecretsClient(ctx, config, lc, callback)
-	default:
+	factory, ok := lookupProvider(config.Type)
+	if !ok {
 		return nil, fmt.Errorf("invalid secrets client type of '%s'", config.Type)
 	}
+
+	return factory(ctx, config, redactingLogger(lc), callback)
 }
 
 // NewSecretStoreClient creates a new instance of a SecretClient based on the passed in configuration.
-// The SecretStoreClient provides management functionality to manage the secret store.
+// The SecretStoreClient provides management functionality to manage the secret store, logging through lc with the
+// credentials in its messages redacted.
 func NewSecretStoreClient(config types.SecretConfig, lc logger.LoggingClient, requester pkg.Caller) (SecretStoreClient, error) {
 	switch config.Type {
 	case Vault:
-		return vault.NewClient(config, requester, false, lc)
+		return vault.NewClient(config, vault.WithHTTPClient(requester), vault.WithLogger(redactingLogger(lc)))
 
 	default:
 		return nil, fmt.Errorf("invalid secret store client type of '%s'", config.Type)
 	}
 }
+
+// redactingLogger wraps lc with pkg.NewRedactingLoggingClient, leaving a nil lc for the providers to handle
+func redactingLogger(lc logger.LoggingClient) logger.LoggingClient {
+	if lc == nil {
+		return nil
+	}
+	return pkg.NewRedactingLoggingClient(lc)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"net"
 	"net/url"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
@@ -61,6 +62,14 @@ func TestNewSecretsClient(t *testing.T) {
 		ExpectError bool
 	}{
 		{"Valid", context.Background(), Vault, false},
+		{"Valid - AWS", context.Background(), AWS, false},
+		{"Valid - Azure", context.Background(), Azure, false},
+		{"Valid - Kubernetes", context.Background(), Kubernetes, false},
+		{"Valid - InMemory", context.Background(), InMemory, false},
+		{"Valid - File", context.Background(), File, false},
+		{"Valid - GCP", context.Background(), GCP, false},
+		{"Valid - Conjur", context.Background(), Conjur, false},
+		{"Valid - OnePassword", context.Background(), OnePassword, false},
 		{"Invalid - no context", nil, Vault, true},
 		{"Invalid - bad type", context.Background(), "BAD", true},
 	}
@@ -75,6 +84,36 @@ func TestNewSecretsClient(t *testing.T) {
 				Authentication: types.AuthenticationInfo{
 					AuthToken: "TestToken",
 				},
+				AWS: types.AWSInfo{
+					Region:          "us-east-1",
+					AccessKeyId:     "TestKeyId",
+					SecretAccessKey: "TestSecretKey",
+				},
+				Azure: types.AzureInfo{
+					ClientId:     "TestClientId",
+					TenantId:     "TestTenantId",
+					ClientSecret: "TestClientSecret",
+				},
+				Kubernetes: types.KubernetesInfo{
+					Namespace: "edgex",
+					MountPath: "/run/secrets",
+				},
+				File: types.FileInfo{
+					FilePath:   filepath.Join(t.TempDir(), "secrets.json"),
+					Passphrase: "TestPassphrase",
+				},
+				GCP: types.GCPInfo{
+					ProjectId: "TestProject",
+				},
+				Conjur: types.ConjurInfo{
+					Account: "TestAccount",
+					Login:   "host/TestHost",
+					APIKey:  "TestAPIKey",
+				},
+				OnePassword: types.OnePasswordInfo{
+					Vault: "TestVault",
+					Token: "TestConnectToken",
+				},
 			}
 
 			client, err := NewSecretsClient(test.Ctx, config, mockLogger, nil)
@@ -0,0 +1,210 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/generate"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// ImportPolicy is what an Importer does with a manifest entry whose path already holds secrets
+type ImportPolicy string
+
+const (
+	// ImportOverwrite replaces the existing secrets with the entry's
+	ImportOverwrite ImportPolicy = "overwrite"
+	// ImportSkipExisting keeps the existing secrets, so importing a manifest again leaves changed secrets as is
+	ImportSkipExisting ImportPolicy = "skip"
+)
+
+// Manifest declares the secrets to import, in the layout of the secrets files seeded by security-secretstore-setup
+type Manifest struct {
+	Secrets []ManifestEntry `json:"secrets" yaml:"secrets"`
+}
+
+// ManifestEntry is the secrets to store at a sub-path. Policy overrides the ImporterOptions policy for the entry.
+type ManifestEntry struct {
+	Path       string           `json:"path" yaml:"path"`
+	Policy     ImportPolicy     `json:"policy,omitempty" yaml:"policy,omitempty"`
+	SecretData []ManifestSecret `json:"secretData" yaml:"secretData"`
+}
+
+// ManifestSecret is a key and value of a ManifestEntry. A secret without a value is given one generated from its
+// Generate spec, i.e. "password" or "hex:16", see generate.NewValue.
+type ManifestSecret struct {
+	Key      string `json:"key" yaml:"key"`
+	Value    string `json:"value,omitempty" yaml:"value,omitempty"`
+	Generate string `json:"generate,omitempty" yaml:"generate,omitempty"`
+}
+
+// ParseManifest decodes the manifest with unmarshal, i.e. the Unmarshal of a YAML package for a YAML manifest, or
+// as JSON when unmarshal is nil, and validates its entries.
+func ParseManifest(data []byte, unmarshal func(data []byte, v interface{}) error) (Manifest, error) {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+
+	var manifest Manifest
+	if err := unmarshal(data, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("unable to parse the secrets manifest: %w", err)
+	}
+	return manifest, manifest.Validate()
+}
+
+// Validate checks every entry has a path, at least one key and a known policy
+func (m Manifest) Validate() error {
+	for index, entry := range m.Secrets {
+		if strings.Trim(entry.Path, "/") == "" {
+			return fmt.Errorf("secrets manifest entry %d has no path", index)
+		}
+		if len(entry.SecretData) == 0 {
+			return fmt.Errorf("secrets manifest entry '%s' has no secretData", entry.Path)
+		}
+		for _, secret := range entry.SecretData {
+			if secret.Key == "" {
+				return fmt.Errorf("secrets manifest entry '%s' has a secret without a key", entry.Path)
+			}
+			if secret.Generate != "" {
+				if _, err := generate.NewValue(secret.Generate); err != nil {
+					return fmt.Errorf("secrets manifest entry '%s' secret '%s': %w", entry.Path, secret.Key, err)
+				}
+			}
+		}
+		switch entry.Policy {
+		case "", ImportOverwrite, ImportSkipExisting:
+		default:
+			return fmt.Errorf("secrets manifest entry '%s' has an invalid policy '%s'", entry.Path, entry.Policy)
+		}
+	}
+	return nil
+}
+
+// ImporterOptions configures an Importer. Zero values select the defaults.
+type ImporterOptions struct {
+	// Policy applies to the entries without a policy of their own, defaults to ImportSkipExisting so that importing
+	// at every startup doesn't undo rotated secrets
+	Policy ImportPolicy
+	// DryRun reports what an import would store and skip without storing anything
+	DryRun bool
+}
+
+// ImportResult lists the sub-paths an import stored, or would store in a dry run, and those it skipped
+type ImportResult struct {
+	Stored  []string
+	Skipped []string
+}
+
+// Importer stores the secrets declared by manifests through a SecretClient
+type Importer struct {
+	client  SecretClient
+	lc      logger.LoggingClient
+	options ImporterOptions
+}
+
+// NewImporter constructs an Importer which stores the secrets through client
+func NewImporter(client SecretClient, lc logger.LoggingClient, options ImporterOptions) *Importer {
+	if options.Policy == "" {
+		options.Policy = ImportSkipExisting
+	}
+
+	return &Importer{client: client, lc: lc, options: options}
+}
+
+// Import stores the secrets of every manifest entry with StoreSecrets, in order, applying the entry's policy when
+// its path already holds secrets. Secrets without a value are generated for each import which stores them. The
+// import stops at the first entry which fails, returning the result so far.
+func (i *Importer) Import(ctx context.Context, manifest Manifest) (ImportResult, error) {
+	var result ImportResult
+	if err := manifest.Validate(); err != nil {
+		return result, err
+	}
+
+	for _, entry := range manifest.Secrets {
+		policy := entry.Policy
+		if policy == "" {
+			policy = i.options.Policy
+		}
+
+		if policy == ImportSkipExisting {
+			exists, err := secretsExist(ctx, i.client, entry.Path)
+			if err != nil {
+				return result, fmt.Errorf("unable to check for existing secrets at '%s': %w", entry.Path, err)
+			}
+			if exists {
+				i.lc.Debugf("secrets already exist at '%s', skipping the import", entry.Path)
+				result.Skipped = append(result.Skipped, entry.Path)
+				continue
+			}
+		}
+
+		if !i.options.DryRun {
+			secrets := make(map[string]string, len(entry.SecretData))
+			for _, secret := range entry.SecretData {
+				value, err := secretValue(secret)
+				if err != nil {
+					return result, fmt.Errorf("unable to generate '%s' of the secrets at '%s': %w", secret.Key, entry.Path, err)
+				}
+				secrets[secret.Key] = value
+			}
+			if err := i.client.StoreSecrets(ctx, entry.Path, secrets); err != nil {
+				return result, fmt.Errorf("unable to import the secrets at '%s': %w", entry.Path, err)
+			}
+			i.lc.Infof("imported %d secrets at '%s'", len(secrets), entry.Path)
+		}
+		result.Stored = append(result.Stored, entry.Path)
+	}
+
+	return result, nil
+}
+
+// secretValue returns the value of the secret, generating it when the manifest gives none
+func secretValue(secret ManifestSecret) (string, error) {
+	if secret.Value != "" || secret.Generate == "" {
+		return secret.Value, nil
+	}
+
+	value, err := generate.NewValue(secret.Generate)
+	if err != nil {
+		return "", err
+	}
+	return value()
+}
+
+// secretsExist reports whether the sub-path holds secrets by listing its parent, which every provider reports the
+// same way, unlike a GetSecrets of a missing sub-path
+func secretsExist(ctx context.Context, client SecretClient, subPath string) (bool, error) {
+	subPath = strings.TrimSuffix(subPath, "/")
+	parent, name := "", subPath
+	if index := strings.LastIndex(subPath, "/"); index >= 0 {
+		parent, name = subPath[:index+1], subPath[index+1:]
+	}
+
+	keys, err := client.ListSecretPaths(ctx, parent)
+	if err != nil {
+		return false, err
+	}
+	for _, key := range keys {
+		if key == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,128 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+const testManifest = `{
+	"secrets": [
+		{"path": "redisdb", "secretData": [{"key": "username", "value": "redis5"}, {"key": "password", "value": "new"}]},
+		{"path": "mqtt/broker", "secretData": [{"key": "password", "value": "mqtt"}]},
+		{"path": "mqtt/client", "policy": "overwrite", "secretData": [{"key": "password", "value": "client"}]}
+	]
+}`
+
+func TestImporter(t *testing.T) {
+	manifest, err := ParseManifest([]byte(testManifest), nil)
+	require.NoError(t, err)
+	require.Len(t, manifest.Secrets, 3)
+
+	newClient := func() *mocks.SecretClient {
+		client := &mocks.SecretClient{}
+		client.On("ListSecretPaths", mock.Anything, "").Return([]string{"mqtt/", "redisdb"}, nil)
+		client.On("ListSecretPaths", mock.Anything, "mqtt/").Return([]string{"client"}, nil)
+		client.On("StoreSecrets", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		return client
+	}
+
+	tests := []struct {
+		name           string
+		options        ImporterOptions
+		expected       ImportResult
+		expectedStored int
+	}{
+		{"Skip existing", ImporterOptions{}, ImportResult{
+			Stored: []string{"mqtt/broker", "mqtt/client"}, Skipped: []string{"redisdb"}}, 2},
+		{"Overwrite", ImporterOptions{Policy: ImportOverwrite}, ImportResult{
+			Stored: []string{"redisdb", "mqtt/broker", "mqtt/client"}}, 3},
+		{"Dry run", ImporterOptions{DryRun: true}, ImportResult{
+			Stored: []string{"mqtt/broker", "mqtt/client"}, Skipped: []string{"redisdb"}}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newClient()
+			importer := NewImporter(client, logger.NewMockClient(), test.options)
+
+			result, err := importer.Import(context.Background(), manifest)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+			client.AssertNumberOfCalls(t, "StoreSecrets", test.expectedStored)
+			if test.expectedStored > 0 {
+				client.AssertCalled(t, "StoreSecrets", mock.Anything, "mqtt/client", map[string]string{"password": "client"})
+			}
+		})
+	}
+}
+
+func TestImporterStoreError(t *testing.T) {
+	manifest, err := ParseManifest([]byte(testManifest), nil)
+	require.NoError(t, err)
+
+	client := &mocks.SecretClient{}
+	client.On("StoreSecrets", mock.Anything, "redisdb", mock.Anything).Return(nil)
+	client.On("StoreSecrets", mock.Anything, "mqtt/broker", mock.Anything).Return(pkg.NewErrSecretStore("sealed"))
+
+	result, err := NewImporter(client, logger.NewMockClient(), ImporterOptions{Policy: ImportOverwrite}).
+		Import(context.Background(), manifest)
+	require.Error(t, err)
+	assert.Equal(t, ImportResult{Stored: []string{"redisdb"}}, result)
+}
+
+func TestImporterGenerate(t *testing.T) {
+	manifest, err := ParseManifest([]byte(`{"secrets": [{"path": "redisdb", "secretData": [
+		{"key": "username", "value": "redis5"}, {"key": "password", "generate": "password:16"}]}]}`), nil)
+	require.NoError(t, err)
+
+	var stored map[string]string
+	client := &mocks.SecretClient{}
+	client.On("StoreSecrets", mock.Anything, "redisdb", mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.Get(2).(map[string]string) }).Return(nil)
+
+	_, err = NewImporter(client, logger.NewMockClient(), ImporterOptions{Policy: ImportOverwrite}).
+		Import(context.Background(), manifest)
+	require.NoError(t, err)
+	assert.Equal(t, "redis5", stored["username"])
+	assert.Len(t, stored["password"], 16)
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	invalid := []string{
+		`not json`,
+		`{"secrets": [{"path": "", "secretData": [{"key": "password", "value": "p"}]}]}`,
+		`{"secrets": [{"path": "redisdb", "secretData": []}]}`,
+		`{"secrets": [{"path": "redisdb", "secretData": [{"key": "", "value": "p"}]}]}`,
+		`{"secrets": [{"path": "redisdb", "policy": "merge", "secretData": [{"key": "password", "value": "p"}]}]}`,
+		`{"secrets": [{"path": "redisdb", "secretData": [{"key": "password", "generate": "pin"}]}]}`,
+		`{"secrets": [{"path": "redisdb", "secretData": [{"key": "password", "generate": "password:0"}]}]}`,
+	}
+
+	for _, manifest := range invalid {
+		_, err := ParseManifest([]byte(manifest), nil)
+		assert.Error(t, err, manifest)
+	}
+}
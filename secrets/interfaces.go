@@ -16,24 +16,41 @@
 package secrets
 
 import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
 	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
 )
 
 // SecretClient provides a contract for storing and retrieving secrets from a secret store provider.
+// All calls accept a context.Context which is used to enforce deadlines and cancellation on the
+// underlying requests to the secret store provider.
 type SecretClient interface {
 	// GetSecrets retrieves secrets from a secret store.
 	// subPath specifies the type or location of the secrets to retrieve. If specified it is appended
 	// to the base path from the SecretConfig
 	// keys specifies the secrets which to retrieve. If no keys are provided then all the keys associated with the
 	// specified path will be returned.
-	GetSecrets(subPath string, keys ...string) (map[string]string, error)
+	GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error)
 
 	// StoreSecrets stores the secrets to a secret store.
 	// it sets the values requested at provided keys
 	// subPath specifies the type or location of the secrets to store. If specified it is appended
 	// to the base path from the SecretConfig
 	// secrets map specifies the "key": "value" pairs of secrets to store
-	StoreSecrets(subPath string, secrets map[string]string) error
+	StoreSecrets(ctx context.Context, subPath string, secrets map[string]string) error
+
+	// ListSecretPaths lists the keys directly under the provided sub-path, which is appended to the base path from
+	// the SecretConfig. Keys ending with a "/" contain further keys rather than secrets, and can be appended to
+	// subPath to list them. An empty list is returned when there is nothing at the sub-path.
+	ListSecretPaths(ctx context.Context, subPath string) ([]string, error)
+
+	// RegisterSecretUpdatedCallback registers callback to be notified with the sub-path after secrets are
+	// successfully stored at the sub-path through this client. Use a listener.Watcher to also be notified of
+	// secrets stored by other clients.
+	RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback)
 
 	// GenerateConsulToken generates a new Consul token based on the given serviceKey
 	// it uses a secret store token from config and requires the permission to generate a Consul token
@@ -42,23 +59,316 @@ type SecretClient interface {
 	// the generated token is unique every time
 	// caller should persist or cache the generated Consul token, at least per runtime cycle, to reduce the number of
 	// tokens stored in Consul server side and the number of calls to this API
-	GenerateConsulToken(serviceKey string) (string, error)
+	GenerateConsulToken(ctx context.Context, serviceKey string) (string, error)
+}
+
+// ReauthenticationNotifier is implemented by the SecretClients which log in again with their configured auth method
+// when the token is revoked or reaches its max TTL, i.e. the Vault client when an Authentication Method is set.
+type ReauthenticationNotifier interface {
+	// SetReauthenticationCallback sets the callback notified each time the client logs in again
+	SetReauthenticationCallback(callback pkg.ReauthenticationCallback)
+}
+
+// WarningNotifier is implemented by the SecretClients whose responses carry warnings, i.e. the Vault client, which
+// also logs them.
+type WarningNotifier interface {
+	// SetWarningCallback sets the callback notified with the warnings of each response having any
+	SetWarningCallback(callback pkg.WarningCallback)
+}
+
+// InstrumentedClient is implemented by the clients which record their interactions with the secret store as
+// metrics and traces, i.e. the Vault SecretClient and SecretStoreClient
+type InstrumentedClient interface {
+	// SetMetrics sets the metrics the requests and token renewals are recorded to, nil to stop recording them
+	SetMetrics(metrics pkg.Metrics)
+	// SetTracer sets the tracer starting a span for each request, nil to stop tracing them
+	SetTracer(tracer pkg.Tracer)
+}
+
+// MiddlewareClient is implemented by the clients whose HTTP requests can be wrapped by middleware, i.e. the Vault
+// SecretClient and SecretStoreClient
+type MiddlewareClient interface {
+	// Use adds the middleware around the HTTP client issuing the requests, inside the middleware added before
+	Use(middleware ...pkg.Middleware)
+}
+
+// Destroyer is implemented by the clients holding credentials or secrets in memory, i.e. the Vault client and the
+// CachingClient, so they can be forgotten once no longer needed
+type Destroyer interface {
+	// Destroy forgets the credentials and secrets held, after which the client must not be used
+	Destroy()
+}
+
+// ShutdownClient is implemented by the SecretClients which hold a token and dynamic credentials, i.e. the Vault
+// client, so a terminating service can revoke them rather than leave them live until they expire
+type ShutdownClient interface {
+	// RevokeSelf revokes the client's token, after which the client must not be used
+	RevokeSelf(ctx context.Context) error
+	// Shutdown stops the renewal of the token and revokes the leases obtained through the client, and the token
+	// when configured to, after which the client must not be used
+	Shutdown(ctx context.Context) error
+}
+
+// VersionedSecretClient is implemented by the SecretClients which keep every version of a secret, i.e. the Vault
+// client when Path is in a KV v2 secrets engine
+type VersionedSecretClient interface {
+	// GetSecretVersion retrieves the version of the secrets at the provided sub-path, or the latest when version is 0
+	GetSecretVersion(ctx context.Context, subPath string, version int) (types.SecretVersion, error)
+
+	// StoreSecretsCAS stores the secrets at the provided sub-path as a new version, provided cas is the current
+	// version, and returns the metadata of the new version. A pkg.ErrSecretVersionConflict is returned when another
+	// writer has stored a newer version.
+	StoreSecretsCAS(ctx context.Context, subPath string, secrets map[string]string, cas int) (types.SecretVersionMetadata, error)
+
+	// PatchSecrets merges the secrets into the existing secret at the provided sub-path as a new version, keeping the
+	// keys which are not in secrets, and returns the metadata of the new version
+	PatchSecrets(ctx context.Context, subPath string, secrets map[string]string) (types.SecretVersionMetadata, error)
+
+	// StoreSecretsMulti stores the secrets of several sub-paths, keyed by sub-path, as new versions. When a write
+	// fails the sub-paths already written are rolled back to their previous version on a best effort basis.
+	StoreSecretsMulti(ctx context.Context, secrets map[string]map[string]string) error
+
+	// GetSecretMetadata retrieves the metadata of the secret at the provided sub-path and of all its versions
+	GetSecretMetadata(ctx context.Context, subPath string) (types.SecretMetadata, error)
+
+	// UpdateSecretMetadata updates the version settings and custom metadata of the secret at the provided sub-path
+	UpdateSecretMetadata(ctx context.Context, subPath string, update types.SecretMetadataUpdate) error
+}
+
+// MountInfoClient is implemented by the SecretClients which describe the secrets engine mounts, i.e. the Vault client
+type MountInfoClient interface {
+	// GetMountInfo describes the mount holding mountPoint, which can be any path in the mount, or the configured
+	// Path when empty. Its KVVersion tells a KV v1 mount from a KV v2 one.
+	GetMountInfo(ctx context.Context, mountPoint string) (types.MountInfo, error)
+}
+
+// DynamicSecretClient is implemented by the SecretClients which create short lived credentials on demand, i.e. the
+// Vault client with the database, Consul and RabbitMQ secrets engines mounted at "database", "consul" and "rabbitmq"
+type DynamicSecretClient interface {
+	// GetDatabaseCredentials creates new credentials for the database role, which expire with their lease
+	GetDatabaseCredentials(ctx context.Context, role string) (types.DatabaseCredentials, error)
+
+	// GetConsulCredentials creates a Consul ACL token for the Consul role, which is revoked with its lease
+	GetConsulCredentials(ctx context.Context, role string) (types.ConsulCredentials, error)
+
+	// GetRabbitMQCredentials creates a RabbitMQ user for the RabbitMQ role, which is deleted with its lease
+	GetRabbitMQCredentials(ctx context.Context, role string) (types.RabbitMQCredentials, error)
+
+	// RenewLease extends the lease of dynamic credentials by up to increment and returns the renewed lease
+	RenewLease(ctx context.Context, leaseId string, increment time.Duration) (types.Lease, error)
+
+	// RevokeLease revokes the lease, invalidating the dynamic credentials
+	RevokeLease(ctx context.Context, leaseId string) error
+}
+
+// TransitClient is implemented by the SecretClients which encrypt and decrypt with keys held by the secret store,
+// i.e. the Vault client with the transit secrets engine mounted at "transit"
+type TransitClient interface {
+	// Encrypt encrypts the plaintext with the named key and returns the ciphertext, i.e. "vault:v1:..."
+	Encrypt(ctx context.Context, keyName string, plaintext []byte) (string, error)
+
+	// Decrypt decrypts the ciphertext, which was returned by Encrypt or Rewrap, with the named key
+	Decrypt(ctx context.Context, keyName string, ciphertext string) ([]byte, error)
+
+	// Rewrap re-encrypts the ciphertext with the latest version of the named key, without revealing the plaintext
+	Rewrap(ctx context.Context, keyName string, ciphertext string) (string, error)
+
+	// Sign signs the input with the named asymmetric key and returns the signature, i.e. "vault:v1:..."
+	Sign(ctx context.Context, keyName string, input []byte, options types.TransitSignOptions) (string, error)
+
+	// SignBatch signs every input in one request, returning the signatures in the order of the inputs
+	SignBatch(ctx context.Context, keyName string, inputs [][]byte, options types.TransitSignOptions) ([]string, error)
+
+	// Verify reports whether the signature, returned by Sign, is valid for the input
+	Verify(ctx context.Context, keyName string, input []byte, signature string, options types.TransitSignOptions) (bool, error)
+
+	// HMAC returns the HMAC of the input computed with the named key
+	HMAC(ctx context.Context, keyName string, input []byte, options types.TransitSignOptions) (string, error)
+
+	// HMACBatch computes the HMAC of every input in one request, returning them in the order of the inputs
+	HMACBatch(ctx context.Context, keyName string, inputs [][]byte, options types.TransitSignOptions) ([]string, error)
+
+	// VerifyHMAC reports whether the HMAC, returned by HMAC, is valid for the input
+	VerifyHMAC(ctx context.Context, keyName string, input []byte, hmac string, options types.TransitSignOptions) (bool, error)
+}
+
+// IdentityTokenClient is implemented by the SecretClients which issue OIDC identity tokens for the client's
+// identity, so services can authenticate each other with tokens signed by the secret store
+type IdentityTokenClient interface {
+	// GetIdentityToken generates an identity token for the named role
+	GetIdentityToken(ctx context.Context, role string) (types.IdentityToken, error)
+
+	// VerifyIdentityToken reports whether the identity token is valid and, when clientId isn't empty, issued for it
+	VerifyIdentityToken(ctx context.Context, token string, clientId string) (bool, error)
+}
+
+// CapabilityClient is implemented by the SecretClients which report the capabilities of the client's token, so a
+// service can fail fast at startup when its policy doesn't grant the access it needs
+type CapabilityClient interface {
+	// GetCapabilities returns the token's capabilities on each of the API paths, i.e. "secret/data/edgex/core-data"
+	GetCapabilities(ctx context.Context, paths ...string) (map[string][]string, error)
+
+	// RequireSecretCapabilities returns a pkg.ErrMissingCapabilities error when the token lacks any of the
+	// capabilities, i.e. "read", on the secrets at the provided sub-path
+	RequireSecretCapabilities(ctx context.Context, subPath string, capabilities ...string) error
+}
+
+// TokenInfoClient is implemented by the SecretClients which authenticate with a token, i.e. the Vault client, so a
+// service can check the policies and lifetime of the token it was actually issued
+type TokenInfoClient interface {
+	// LookupSelf returns the properties of the client's own token
+	LookupSelf(ctx context.Context) (types.TokenInfo, error)
 }
 
 // SecretStoreClient provides a contract for managing a Secret Store from a secret store provider.
+// Cancelling the ctx of a method aborts its requests, i.e. to a hung Secret Store.
 type SecretStoreClient interface {
-	HealthCheck() (int, error)
-	Init(secretThreshold int, secretShares int) (types.InitResponse, error)
-	Unseal(keysBase64 []string) error
-	InstallPolicy(token string, policyName string, policyDocument string) error
-	CheckSecretEngineInstalled(token string, mountPoint string, engine string) (bool, error)
-	EnableKVSecretEngine(token string, mountPoint string, kvVersion string) error
-	EnableConsulSecretEngine(token string, mountPoint string, defaultLeaseTTL string) error
-	RegenRootToken(keys []string) (string, error)
-	CreateToken(token string, parameters map[string]interface{}) (map[string]interface{}, error)
-	ListTokenAccessors(token string) ([]string, error)
-	RevokeTokenAccessor(token string, accessor string) error
-	LookupTokenAccessor(token string, accessor string) (types.TokenMetadata, error)
-	LookupToken(token string) (types.TokenMetadata, error)
-	RevokeToken(token string) error
+	HealthCheck(ctx context.Context) (int, error)
+	Init(ctx context.Context, secretThreshold int, secretShares int) (types.InitResponse, error)
+	Unseal(ctx context.Context, keysBase64 []string) error
+	// SealStatus reports whether the Secret Store is sealed and the unseal progress, Seal seals it deliberately,
+	// i.e. during maintenance, after which it must be unsealed with the key shares again
+	SealStatus(ctx context.Context) (types.SealStatus, error)
+	Seal(ctx context.Context, token string) error
+	// RekeyInit, RekeyStatus, RekeySubmitShare and RekeyCancel drive the rekey of the unseal key shares: the
+	// current key shares are submitted with the nonce returned by RekeyInit until the status is complete. When
+	// verification is required, RekeyVerify then submits the new key shares with the verification nonce.
+	RekeyInit(ctx context.Context, request types.RekeyRequest) (types.RekeyStatus, error)
+	RekeyStatus(ctx context.Context) (types.RekeyStatus, error)
+	RekeySubmitShare(ctx context.Context, key string, nonce string) (types.RekeyStatus, error)
+	RekeyCancel(ctx context.Context) error
+	RekeyVerify(ctx context.Context, key string, verificationNonce string) (types.RekeyVerificationStatus, error)
+	// RotateEncryptionKey rotates the encryption key of the storage barrier, GetKeyStatus reports the current key term
+	RotateEncryptionKey(ctx context.Context, token string) error
+	GetKeyStatus(ctx context.Context, token string) (types.KeyStatus, error)
+	// TakeRaftSnapshot and RestoreRaftSnapshot back up and restore the integrated (raft) storage, ListRaftPeers and
+	// RemoveRaftPeer manage the nodes of its cluster
+	TakeRaftSnapshot(ctx context.Context, token string, snapshot io.Writer) error
+	RestoreRaftSnapshot(ctx context.Context, token string, snapshot io.Reader) error
+	ListRaftPeers(ctx context.Context, token string) ([]types.RaftPeer, error)
+	RemoveRaftPeer(ctx context.Context, token string, nodeId string) error
+	// GetLeader and GetHAStatus tell the active node from the standby nodes, StepDown makes the active node give
+	// up leadership so that a standby node takes over
+	GetLeader(ctx context.Context) (types.LeaderStatus, error)
+	GetHAStatus(ctx context.Context, token string) ([]types.HANode, error)
+	StepDown(ctx context.Context, token string) error
+	InstallPolicy(ctx context.Context, token string, policyName string, policyDocument string) error
+	// CheckSecretEngineInstalled returns the metadata of the engine mounted at mountPoint, or a
+	// pkg.ErrSecretEngineNotFound when no engine of the type is mounted there
+	CheckSecretEngineInstalled(ctx context.Context, token string, mountPoint string, engine string) (types.SecretEngineMount, error)
+	EnableKVSecretEngine(ctx context.Context, token string, mountPoint string, kvVersion string) error
+	EnableConsulSecretEngine(ctx context.Context, token string, mountPoint string, defaultLeaseTTL string) error
+	// DisableSecretEngine, RemountSecretEngine and TuneMount manage the lifecycle of an enabled secrets engine.
+	// Disabling an engine removes its secrets and revokes its leases.
+	DisableSecretEngine(ctx context.Context, token string, mountPoint string) error
+	RemountSecretEngine(ctx context.Context, token string, fromMountPoint string, toMountPoint string) error
+	TuneMount(ctx context.Context, token string, mountPoint string, config types.MountConfig) error
+	RegenRootToken(ctx context.Context, keys []string) (string, error)
+	// StartGenerateRoot, SubmitGenerateRootShare and DecodeRootToken are the steps of RegenRootToken, for tooling
+	// which collects the key shares from several operators. CancelGenerateRoot abandons a generation in progress.
+	StartGenerateRoot(ctx context.Context) (types.GenerateRootStatus, error)
+	SubmitGenerateRootShare(ctx context.Context, key string, nonce string) (types.GenerateRootStatus, error)
+	DecodeRootToken(encodedToken string, otp string) (string, error)
+	CancelGenerateRoot(ctx context.Context) error
+	CreateToken(ctx context.Context, token string, parameters map[string]interface{}) (map[string]interface{}, error)
+	// CreateTokenWithRequest creates the token described by the typed request, i.e. a batch or orphan token
+	CreateTokenWithRequest(ctx context.Context, token string, request types.TokenCreateRequest) (types.TokenCreateResponse, error)
+	// ExchangeToken creates a short-lived, renewable child token of parentToken narrowed to the requested policies,
+	// optionally revoking the parent token
+	ExchangeToken(ctx context.Context, parentToken string, request types.TokenExchangeRequest) (types.TokenCreateResponse, error)
+	// CreateTokenRole, GetTokenRole, DeleteTokenRole and ListTokenRoles manage the token roles used to create
+	// per-service tokens
+	CreateTokenRole(ctx context.Context, token string, roleName string, role types.TokenRole) error
+	GetTokenRole(ctx context.Context, token string, roleName string) (types.TokenRole, error)
+	DeleteTokenRole(ctx context.Context, token string, roleName string) error
+	ListTokenRoles(ctx context.Context, token string) ([]string, error)
+	// ListTokenAccessors, LookupTokenAccessor and RevokeTokenAccessor audit and revoke issued tokens by their
+	// accessors, so the issuer doesn't need to keep the tokens themselves
+	ListTokenAccessors(ctx context.Context, token string) ([]string, error)
+	RevokeTokenAccessor(ctx context.Context, token string, accessor string) error
+	LookupTokenAccessor(ctx context.Context, token string, accessor string) (types.TokenMetadata, error)
+	LookupToken(ctx context.Context, token string) (types.TokenMetadata, error)
+	RevokeToken(ctx context.Context, token string) error
+	// RenewToken renews the token and returns its new TTL
+	RenewToken(ctx context.Context, token string) (time.Duration, error)
+	// WrapData wraps data in a single use wrapping token valid for ttl
+	WrapData(ctx context.Context, token string, data map[string]interface{}, ttl time.Duration) (types.WrapInfo, error)
+	// LookupWrappingToken returns the creation details of a wrapping token without unwrapping it
+	LookupWrappingToken(ctx context.Context, wrappingToken string) (types.WrapInfo, error)
+	// UnwrapToken validates the wrapping token's creation path and TTL, then returns the wrapped response
+	UnwrapToken(ctx context.Context, wrappingToken string, options types.UnwrapOptions) (map[string]interface{}, error)
+	// DeliverToCubbyhole writes payload into the cubbyhole of a new single use delivery token, which is returned
+	DeliverToCubbyhole(ctx context.Context, token string, path string, payload map[string]string, ttl time.Duration) (string, error)
+	// ReceiveFromCubbyhole reads the delivered payload and revokes the delivery token, destroying the cubbyhole
+	ReceiveFromCubbyhole(ctx context.Context, deliveryToken string, path string) (map[string]string, error)
+	// ServerFlavor returns "vault" or "openbao" per the configured Compatibility, detecting it when set to "auto"
+	ServerFlavor(ctx context.Context) (string, error)
+	// EnableDatabaseSecretEngine, ConfigureDatabaseConnection, CreateDatabaseRole and DeleteDatabaseRole set up
+	// the database secrets engine which creates dynamic database credentials for the services
+	EnableDatabaseSecretEngine(ctx context.Context, token string, mountPoint string) error
+	ConfigureDatabaseConnection(ctx context.Context, token string, mountPoint string, name string, connection types.DatabaseConnection) error
+	CreateDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string, role types.DatabaseRole) error
+	DeleteDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string) error
+	// LookupLease returns the details of a lease and RevokeLeasePrefix revokes all the leases under a prefix,
+	// i.e. every credential created for a database role
+	LookupLease(ctx context.Context, token string, leaseId string) (types.LeaseInfo, error)
+	RevokeLeasePrefix(ctx context.Context, token string, prefix string) error
+	// EnableTransitEngine and CreateTransitKey set up the transit secrets engine, which encrypts and decrypts
+	// payloads for the services without handing out the key material
+	EnableTransitEngine(ctx context.Context, token string, mountPoint string) error
+	CreateTransitKey(ctx context.Context, token string, mountPoint string, keyName string, key types.TransitKey) error
+	// RotateTransitKey, ReadTransitKey, UpdateTransitKeyConfig and TrimTransitKeyVersions manage the versions of a
+	// transit key, i.e. for scheduled rotation followed by raising the min decryption version once the
+	// ciphertexts have been rewrapped
+	RotateTransitKey(ctx context.Context, token string, mountPoint string, keyName string) error
+	ReadTransitKey(ctx context.Context, token string, mountPoint string, keyName string) (types.TransitKeyInfo, error)
+	UpdateTransitKeyConfig(ctx context.Context, token string, mountPoint string, keyName string, config types.TransitKeyConfig) error
+	TrimTransitKeyVersions(ctx context.Context, token string, mountPoint string, keyName string, minAvailableVersion int) error
+	// EnablePKIEngine, ConfigureCA, CreatePKIRole and IssueCertificate set up the PKI secrets engine and issue the
+	// services' TLS certificates. maxLeaseTTL, i.e. "87600h", limits the validity of the certificates.
+	EnablePKIEngine(ctx context.Context, token string, mountPoint string, maxLeaseTTL string) error
+	ConfigureCA(ctx context.Context, token string, mountPoint string, ca types.PKICAConfig) (types.Certificate, error)
+	CreatePKIRole(ctx context.Context, token string, mountPoint string, roleName string, role types.PKIRole) error
+	IssueCertificate(ctx context.Context, token string, mountPoint string, roleName string, request types.CertificateRequest) (types.Certificate, error)
+	// ConfigureConsulAccess and CreateConsulRole set up the Consul secrets engine enabled by EnableConsulSecretEngine,
+	// so services obtain short lived Consul ACL tokens
+	ConfigureConsulAccess(ctx context.Context, token string, mountPoint string, access types.ConsulAccess) error
+	CreateConsulRole(ctx context.Context, token string, mountPoint string, roleName string, role types.ConsulRole) error
+	// EnableSSHEngine, ConfigureSSHCA, CreateSSHRole and SignSSHKey set up the SSH secrets engine and sign short
+	// lived SSH certificates. ConfigureSSHCA returns the CA public key trusted by the SSH servers.
+	EnableSSHEngine(ctx context.Context, token string, mountPoint string) error
+	ConfigureSSHCA(ctx context.Context, token string, mountPoint string, keyType string) (string, error)
+	CreateSSHRole(ctx context.Context, token string, mountPoint string, roleName string, role types.SSHRole) error
+	SignSSHKey(ctx context.Context, token string, mountPoint string, roleName string, request types.SSHSignRequest) (types.SSHCertificate, error)
+	// EnableRabbitMQEngine, ConfigureRabbitMQConnection and CreateRabbitMQRole set up the RabbitMQ secrets engine,
+	// which creates a RabbitMQ user per service
+	EnableRabbitMQEngine(ctx context.Context, token string, mountPoint string) error
+	ConfigureRabbitMQConnection(ctx context.Context, token string, mountPoint string, connection types.RabbitMQConnection) error
+	CreateRabbitMQRole(ctx context.Context, token string, mountPoint string, roleName string, role types.RabbitMQRole) error
+	// CreateEntity, CreateEntityAlias and LookupEntity model each service as an identity entity with an alias per
+	// auth method. CreateEntity and CreateGroup create or update by name and return the id.
+	CreateEntity(ctx context.Context, token string, entity types.Entity) (string, error)
+	CreateEntityAlias(ctx context.Context, token string, alias types.EntityAlias) (string, error)
+	LookupEntity(ctx context.Context, token string, name string) (types.Entity, error)
+	// CreateGroup, LookupGroup, AddGroupMember and RemoveGroupMember manage the identity groups and their entities
+	CreateGroup(ctx context.Context, token string, group types.Group) (string, error)
+	LookupGroup(ctx context.Context, token string, name string) (types.Group, error)
+	AddGroupMember(ctx context.Context, token string, groupName string, entityId string) error
+	RemoveGroupMember(ctx context.Context, token string, groupName string, entityId string) error
+	// CreateIdentityTokenKey and CreateIdentityTokenRole set up the signing keys and roles of identity tokens
+	CreateIdentityTokenKey(ctx context.Context, token string, keyName string, key types.IdentityTokenKey) error
+	CreateIdentityTokenRole(ctx context.Context, token string, roleName string, role types.IdentityTokenRole) error
+	// EnableAuthMethod, DisableAuthMethod, ListAuthMethods and TuneAuthMethod manage the auth methods mounted at
+	// the paths under "auth/", ListAuthMethods keying the enabled auth methods by their path, i.e. "approle/"
+	EnableAuthMethod(ctx context.Context, token string, mountPath string, method types.AuthMethod) error
+	DisableAuthMethod(ctx context.Context, token string, mountPath string) error
+	ListAuthMethods(ctx context.Context, token string) (map[string]types.AuthMount, error)
+	TuneAuthMethod(ctx context.Context, token string, mountPath string, config types.AuthMethodConfig) error
+	// EnableAuditDevice, DisableAuditDevice and ListAuditDevices manage the audit devices, ListAuditDevices keying
+	// them by their path, i.e. "file/". AuditHash hashes input with the salt of the audit device at devicePath, so
+	// that a value can be found in the HMAC-ed audit log.
+	EnableAuditDevice(ctx context.Context, token string, devicePath string, device types.AuditDevice) error
+	DisableAuditDevice(ctx context.Context, token string, devicePath string) error
+	ListAuditDevices(ctx context.Context, token string) (map[string]types.AuditDevice, error)
+	AuditHash(ctx context.Context, token string, devicePath string, input string) (string, error)
 }
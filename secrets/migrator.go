@@ -0,0 +1,198 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// ConflictPolicy is what a Migrator does with a sub-path which already holds secrets in the destination
+type ConflictPolicy string
+
+const (
+	// ConflictSkip keeps the destination's secrets
+	ConflictSkip ConflictPolicy = "skip"
+	// ConflictOverwrite replaces the destination's secrets with the source's
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	// ConflictFail stops the migration
+	ConflictFail ConflictPolicy = "fail"
+)
+
+// MigratorOptions configures a Migrator. Zero values select the defaults.
+type MigratorOptions struct {
+	// RootPath is the sub-path whose subtree is migrated, defaults to the whole configured Path of the source
+	RootPath string
+	// Include and Exclude are path.Match patterns, i.e. "mqtt/*", of the sub-paths under RootPath to migrate. All
+	// the sub-paths are included when Include is empty, and Exclude applies after Include.
+	Include []string
+	Exclude []string
+	// Conflict applies to the sub-paths which already hold secrets in the destination, defaults to ConflictFail
+	Conflict ConflictPolicy
+	// Verify reads every migrated sub-path back from the destination and compares the hash of its secrets to the
+	// source's
+	Verify bool
+}
+
+// MigrationResult lists the sub-paths a migration copied, skipped as they exist in the destination, and, when
+// verifying, those whose secrets differ in the destination
+type MigrationResult struct {
+	Copied     []string
+	Skipped    []string
+	Mismatched []string
+}
+
+// Migrator copies secrets from one SecretClient to another, i.e. from Vault to AWS Secrets Manager or from a KV v1
+// mount to a KV v2 mount. The sub-paths are kept, the clients' configured Path can differ.
+type Migrator struct {
+	source      SecretClient
+	destination SecretClient
+	lc          logger.LoggingClient
+	options     MigratorOptions
+}
+
+// NewMigrator constructs a Migrator copying the secrets of source to destination
+func NewMigrator(source SecretClient, destination SecretClient, lc logger.LoggingClient, options MigratorOptions) (*Migrator, error) {
+	if options.Conflict == "" {
+		options.Conflict = ConflictFail
+	}
+	switch options.Conflict {
+	case ConflictSkip, ConflictOverwrite, ConflictFail:
+	default:
+		return nil, fmt.Errorf("invalid migration conflict policy '%s'", options.Conflict)
+	}
+	for _, pattern := range append(append([]string{}, options.Include...), options.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid migration path pattern '%s': %w", pattern, err)
+		}
+	}
+
+	return &Migrator{source: source, destination: destination, lc: lc, options: options}, nil
+}
+
+// Migrate exports the secrets under RootPath from the source and stores the included sub-paths in the destination,
+// in sorted order. The migration stops at the first sub-path which fails, returning the result so far. When
+// verifying, an error naming the sub-paths whose secrets differ is returned after all of them are compared.
+func (m *Migrator) Migrate(ctx context.Context) (MigrationResult, error) {
+	var result MigrationResult
+
+	tree, err := ExportSecrets(ctx, m.source, m.options.RootPath)
+	if err != nil {
+		return result, fmt.Errorf("unable to export the secrets to migrate: %w", err)
+	}
+
+	root := m.options.RootPath
+	if root != "" && !strings.HasSuffix(root, "/") {
+		root += "/"
+	}
+	secrets := make(map[string]map[string]string)
+	flattenSecrets(tree, "", secrets)
+
+	relativePaths := make([]string, 0, len(secrets))
+	for relativePath := range secrets {
+		if m.included(relativePath) {
+			relativePaths = append(relativePaths, relativePath)
+		}
+	}
+	sort.Strings(relativePaths)
+
+	for _, relativePath := range relativePaths {
+		subPath := root + relativePath
+		if m.options.Conflict != ConflictOverwrite {
+			exists, err := secretsExist(ctx, m.destination, subPath)
+			if err != nil {
+				return result, fmt.Errorf("unable to check the destination for secrets at '%s': %w", subPath, err)
+			}
+			if exists && m.options.Conflict == ConflictFail {
+				return result, fmt.Errorf("secrets already exist at '%s' in the destination", subPath)
+			}
+			if exists {
+				m.lc.Debugf("secrets already exist at '%s' in the destination, skipping the migration", subPath)
+				result.Skipped = append(result.Skipped, subPath)
+				continue
+			}
+		}
+
+		if err := m.destination.StoreSecrets(ctx, subPath, secrets[relativePath]); err != nil {
+			return result, fmt.Errorf("unable to migrate the secrets at '%s': %w", subPath, err)
+		}
+		result.Copied = append(result.Copied, subPath)
+	}
+	m.lc.Infof("migrated the secrets of %d sub-paths, skipped %d", len(result.Copied), len(result.Skipped))
+
+	if !m.options.Verify {
+		return result, nil
+	}
+
+	for _, subPath := range result.Copied {
+		migrated, err := m.destination.GetSecrets(ctx, subPath)
+		if err != nil {
+			return result, fmt.Errorf("unable to verify the migrated secrets at '%s': %w", subPath, err)
+		}
+		if hashSecrets(migrated) != hashSecrets(secrets[strings.TrimPrefix(subPath, root)]) {
+			result.Mismatched = append(result.Mismatched, subPath)
+		}
+	}
+	if len(result.Mismatched) > 0 {
+		return result, fmt.Errorf("migrated secrets differ from the source at %s", strings.Join(result.Mismatched, ", "))
+	}
+
+	return result, nil
+}
+
+// included reports whether the sub-path, relative to RootPath, matches the Include and none of the Exclude patterns
+func (m *Migrator) included(relativePath string) bool {
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			// the patterns were validated by NewMigrator
+			if matched, _ := path.Match(pattern, relativePath); matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(m.options.Include) > 0 && !matches(m.options.Include) {
+		return false
+	}
+	return !matches(m.options.Exclude)
+}
+
+// flattenSecrets adds the secrets of the nested map returned by ExportSecrets to secrets, keyed by their path
+// relative to the exported root
+func flattenSecrets(tree map[string]interface{}, prefix string, secrets map[string]map[string]string) {
+	for key, value := range tree {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			flattenSecrets(value, prefix+key, secrets)
+		case map[string]string:
+			secrets[prefix+key] = value
+		}
+	}
+}
+
+// hashSecrets returns the SHA-256 of the secrets encoded as JSON, whose object keys are sorted
+func hashSecrets(secrets map[string]string) [sha256.Size]byte {
+	// marshaling a map of strings cannot fail
+	encoded, _ := json.Marshal(secrets)
+	return sha256.Sum256(encoded)
+}
@@ -0,0 +1,111 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/providers/inmemory"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func newMigrationClients(t *testing.T) (*inmemory.Client, *inmemory.Client) {
+	ctx := context.Background()
+	source := inmemory.NewSecretsClient(types.SecretConfig{Path: "edgex/v1"}, nil)
+	require.NoError(t, source.StoreSecrets(ctx, "redisdb", map[string]string{"password": "redis"}))
+	require.NoError(t, source.StoreSecrets(ctx, "mqtt/broker", map[string]string{"password": "broker"}))
+	require.NoError(t, source.StoreSecrets(ctx, "mqtt/client", map[string]string{"password": "client"}))
+	require.NoError(t, source.StoreSecrets(ctx, "mqtt/tls/ca", map[string]string{"cert": "pem"}))
+
+	destination := inmemory.NewSecretsClient(types.SecretConfig{Path: "edgex/v2"}, nil)
+	require.NoError(t, destination.StoreSecrets(ctx, "mqtt/client", map[string]string{"password": "rotated"}))
+	return source, destination
+}
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     MigratorOptions
+		expected    MigrationResult
+		expectError bool
+	}{
+		{"Skip conflicts", MigratorOptions{Conflict: ConflictSkip, Verify: true}, MigrationResult{
+			Copied:  []string{"mqtt/broker", "mqtt/tls/ca", "redisdb"},
+			Skipped: []string{"mqtt/client"}}, false},
+		{"Overwrite conflicts", MigratorOptions{Conflict: ConflictOverwrite, Verify: true}, MigrationResult{
+			Copied: []string{"mqtt/broker", "mqtt/client", "mqtt/tls/ca", "redisdb"}}, false},
+		{"Fail on conflict", MigratorOptions{}, MigrationResult{
+			Copied: []string{"mqtt/broker"}}, true},
+		{"Root path and filters", MigratorOptions{RootPath: "mqtt", Include: []string{"*"}, Exclude: []string{"client"}},
+			MigrationResult{Copied: []string{"mqtt/broker"}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, destination := newMigrationClients(t)
+			migrator, err := NewMigrator(source, destination, logger.NewMockClient(), test.options)
+			require.NoError(t, err)
+
+			result, err := migrator.Migrate(context.Background())
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+
+			for _, subPath := range result.Copied {
+				expected, err := source.GetSecrets(context.Background(), subPath)
+				require.NoError(t, err)
+				actual, err := destination.GetSecrets(context.Background(), subPath)
+				require.NoError(t, err)
+				assert.Equal(t, expected, actual, subPath)
+			}
+		})
+	}
+}
+
+func TestMigrateVerifyMismatch(t *testing.T) {
+	source, _ := newMigrationClients(t)
+
+	// the destination drops a key of the secrets it stores
+	destination := &mocks.SecretClient{}
+	destination.On("StoreSecrets", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	destination.On("GetSecrets", mock.Anything, "redisdb").Return(map[string]string{"password": "redis"}, nil)
+	destination.On("GetSecrets", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+	migrator, err := NewMigrator(source, destination, logger.NewMockClient(),
+		MigratorOptions{Conflict: ConflictOverwrite, Verify: true})
+	require.NoError(t, err)
+
+	result, err := migrator.Migrate(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, []string{"mqtt/broker", "mqtt/client", "mqtt/tls/ca"}, result.Mismatched)
+}
+
+func TestNewMigratorInvalid(t *testing.T) {
+	_, err := NewMigrator(nil, nil, logger.NewMockClient(), MigratorOptions{Conflict: "merge"})
+	require.Error(t, err)
+	_, err = NewMigrator(nil, nil, logger.NewMockClient(), MigratorOptions{Include: []string{"["}})
+	require.Error(t, err)
+}
@@ -2,27 +2,33 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pkg "github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+)
 
 // SecretClient is an autogenerated mock type for the SecretClient type
 type SecretClient struct {
 	mock.Mock
 }
 
-// GenerateConsulToken provides a mock function with given fields: serviceKey
-func (_m *SecretClient) GenerateConsulToken(serviceKey string) (string, error) {
-	ret := _m.Called(serviceKey)
+// GenerateConsulToken provides a mock function with given fields: ctx, serviceKey
+func (_m *SecretClient) GenerateConsulToken(ctx context.Context, serviceKey string) (string, error) {
+	ret := _m.Called(ctx, serviceKey)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(serviceKey)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, serviceKey)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(serviceKey)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -30,20 +36,20 @@ func (_m *SecretClient) GenerateConsulToken(serviceKey string) (string, error) {
 	return r0, r1
 }
 
-// GetSecrets provides a mock function with given fields: subPath, keys
-func (_m *SecretClient) GetSecrets(subPath string, keys ...string) (map[string]string, error) {
+// GetSecrets provides a mock function with given fields: ctx, subPath, keys
+func (_m *SecretClient) GetSecrets(ctx context.Context, subPath string, keys ...string) (map[string]string, error) {
 	_va := make([]interface{}, len(keys))
 	for _i := range keys {
 		_va[_i] = keys[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, subPath)
+	_ca = append(_ca, ctx, subPath)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func(string, ...string) map[string]string); ok {
-		r0 = rf(subPath, keys...)
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) map[string]string); ok {
+		r0 = rf(ctx, subPath, keys...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]string)
@@ -51,8 +57,31 @@ func (_m *SecretClient) GetSecrets(subPath string, keys ...string) (map[string]s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, ...string) error); ok {
-		r1 = rf(subPath, keys...)
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, subPath, keys...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSecretPaths provides a mock function with given fields: ctx, subPath
+func (_m *SecretClient) ListSecretPaths(ctx context.Context, subPath string) ([]string, error) {
+	ret := _m.Called(ctx, subPath)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, subPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subPath)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,13 +89,18 @@ func (_m *SecretClient) GetSecrets(subPath string, keys ...string) (map[string]s
 	return r0, r1
 }
 
-// StoreSecrets provides a mock function with given fields: subPath, _a1
-func (_m *SecretClient) StoreSecrets(subPath string, _a1 map[string]string) error {
-	ret := _m.Called(subPath, _a1)
+// RegisterSecretUpdatedCallback provides a mock function with given fields: subPath, callback
+func (_m *SecretClient) RegisterSecretUpdatedCallback(subPath string, callback pkg.SecretUpdatedCallback) {
+	_m.Called(subPath, callback)
+}
+
+// StoreSecrets provides a mock function with given fields: ctx, subPath, _a2
+func (_m *SecretClient) StoreSecrets(ctx context.Context, subPath string, _a2 map[string]string) error {
+	ret := _m.Called(ctx, subPath, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, map[string]string) error); ok {
-		r0 = rf(subPath, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) error); ok {
+		r0 = rf(ctx, subPath, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -3,6 +3,10 @@
 package mocks
 
 import (
+	context "context"
+	io "io"
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
@@ -13,20 +17,34 @@ type SecretStoreClient struct {
 	mock.Mock
 }
 
-// CheckSecretEngineInstalled provides a mock function with given fields: token, mountPoint, engine
-func (_m *SecretStoreClient) CheckSecretEngineInstalled(token string, mountPoint string, engine string) (bool, error) {
-	ret := _m.Called(token, mountPoint, engine)
+// AddGroupMember provides a mock function with given fields: ctx, token, groupName, entityId
+func (_m *SecretStoreClient) AddGroupMember(ctx context.Context, token string, groupName string, entityId string) error {
+	ret := _m.Called(ctx, token, groupName, entityId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, groupName, entityId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditHash provides a mock function with given fields: ctx, token, devicePath, input
+func (_m *SecretStoreClient) AuditHash(ctx context.Context, token string, devicePath string, input string) (string, error) {
+	ret := _m.Called(ctx, token, devicePath, input)
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
-		r0 = rf(token, mountPoint, engine)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, token, devicePath, input)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(token, mountPoint, engine)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, token, devicePath, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -34,22 +52,55 @@ func (_m *SecretStoreClient) CheckSecretEngineInstalled(token string, mountPoint
 	return r0, r1
 }
 
-// CreateToken provides a mock function with given fields: token, parameters
-func (_m *SecretStoreClient) CreateToken(token string, parameters map[string]interface{}) (map[string]interface{}, error) {
-	ret := _m.Called(token, parameters)
+// CancelGenerateRoot provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) CancelGenerateRoot(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
-	var r0 map[string]interface{}
-	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) map[string]interface{}); ok {
-		r0 = rf(token, parameters)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]interface{})
-		}
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckSecretEngineInstalled provides a mock function with given fields: ctx, token, mountPoint, engine
+func (_m *SecretStoreClient) CheckSecretEngineInstalled(ctx context.Context, token string, mountPoint string, engine string) (types.SecretEngineMount, error) {
+	ret := _m.Called(ctx, token, mountPoint, engine)
+
+	var r0 types.SecretEngineMount
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) types.SecretEngineMount); ok {
+		r0 = rf(ctx, token, mountPoint, engine)
+	} else {
+		r0 = ret.Get(0).(types.SecretEngineMount)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, token, mountPoint, engine)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigureCA provides a mock function with given fields: ctx, token, mountPoint, ca
+func (_m *SecretStoreClient) ConfigureCA(ctx context.Context, token string, mountPoint string, ca types.PKICAConfig) (types.Certificate, error) {
+	ret := _m.Called(ctx, token, mountPoint, ca)
+
+	var r0 types.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.PKICAConfig) types.Certificate); ok {
+		r0 = rf(ctx, token, mountPoint, ca)
+	} else {
+		r0 = ret.Get(0).(types.Certificate)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
-		r1 = rf(token, parameters)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, types.PKICAConfig) error); ok {
+		r1 = rf(ctx, token, mountPoint, ca)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +108,13 @@ func (_m *SecretStoreClient) CreateToken(token string, parameters map[string]int
 	return r0, r1
 }
 
-// EnableConsulSecretEngine provides a mock function with given fields: token, mountPoint, defaultLeaseTTL
-func (_m *SecretStoreClient) EnableConsulSecretEngine(token string, mountPoint string, defaultLeaseTTL string) error {
-	ret := _m.Called(token, mountPoint, defaultLeaseTTL)
+// ConfigureConsulAccess provides a mock function with given fields: ctx, token, mountPoint, access
+func (_m *SecretStoreClient) ConfigureConsulAccess(ctx context.Context, token string, mountPoint string, access types.ConsulAccess) error {
+	ret := _m.Called(ctx, token, mountPoint, access)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(token, mountPoint, defaultLeaseTTL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.ConsulAccess) error); ok {
+		r0 = rf(ctx, token, mountPoint, access)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -71,13 +122,13 @@ func (_m *SecretStoreClient) EnableConsulSecretEngine(token string, mountPoint s
 	return r0
 }
 
-// EnableKVSecretEngine provides a mock function with given fields: token, mountPoint, kvVersion
-func (_m *SecretStoreClient) EnableKVSecretEngine(token string, mountPoint string, kvVersion string) error {
-	ret := _m.Called(token, mountPoint, kvVersion)
+// ConfigureDatabaseConnection provides a mock function with given fields: ctx, token, mountPoint, name, connection
+func (_m *SecretStoreClient) ConfigureDatabaseConnection(ctx context.Context, token string, mountPoint string, name string, connection types.DatabaseConnection) error {
+	ret := _m.Called(ctx, token, mountPoint, name, connection)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(token, mountPoint, kvVersion)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.DatabaseConnection) error); ok {
+		r0 = rf(ctx, token, mountPoint, name, connection)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -85,20 +136,34 @@ func (_m *SecretStoreClient) EnableKVSecretEngine(token string, mountPoint strin
 	return r0
 }
 
-// HealthCheck provides a mock function with given fields:
-func (_m *SecretStoreClient) HealthCheck() (int, error) {
-	ret := _m.Called()
+// ConfigureRabbitMQConnection provides a mock function with given fields: ctx, token, mountPoint, connection
+func (_m *SecretStoreClient) ConfigureRabbitMQConnection(ctx context.Context, token string, mountPoint string, connection types.RabbitMQConnection) error {
+	ret := _m.Called(ctx, token, mountPoint, connection)
 
-	var r0 int
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.RabbitMQConnection) error); ok {
+		r0 = rf(ctx, token, mountPoint, connection)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConfigureSSHCA provides a mock function with given fields: ctx, token, mountPoint, keyType
+func (_m *SecretStoreClient) ConfigureSSHCA(ctx context.Context, token string, mountPoint string, keyType string) (string, error) {
+	ret := _m.Called(ctx, token, mountPoint, keyType)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, token, mountPoint, keyType)
+	} else {
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, token, mountPoint, keyType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,20 +171,90 @@ func (_m *SecretStoreClient) HealthCheck() (int, error) {
 	return r0, r1
 }
 
-// Init provides a mock function with given fields: secretThreshold, secretShares
-func (_m *SecretStoreClient) Init(secretThreshold int, secretShares int) (types.InitResponse, error) {
-	ret := _m.Called(secretThreshold, secretShares)
+// CreateConsulRole provides a mock function with given fields: ctx, token, mountPoint, roleName, role
+func (_m *SecretStoreClient) CreateConsulRole(ctx context.Context, token string, mountPoint string, roleName string, role types.ConsulRole) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName, role)
 
-	var r0 types.InitResponse
-	if rf, ok := ret.Get(0).(func(int, int) types.InitResponse); ok {
-		r0 = rf(secretThreshold, secretShares)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.ConsulRole) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, role)
 	} else {
-		r0 = ret.Get(0).(types.InitResponse)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateDatabaseRole provides a mock function with given fields: ctx, token, mountPoint, roleName, role
+func (_m *SecretStoreClient) CreateDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string, role types.DatabaseRole) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.DatabaseRole) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEntity provides a mock function with given fields: ctx, token, entity
+func (_m *SecretStoreClient) CreateEntity(ctx context.Context, token string, entity types.Entity) (string, error) {
+	ret := _m.Called(ctx, token, entity)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Entity) string); ok {
+		r0 = rf(ctx, token, entity)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.Entity) error); ok {
+		r1 = rf(ctx, token, entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEntityAlias provides a mock function with given fields: ctx, token, alias
+func (_m *SecretStoreClient) CreateEntityAlias(ctx context.Context, token string, alias types.EntityAlias) (string, error) {
+	ret := _m.Called(ctx, token, alias)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.EntityAlias) string); ok {
+		r0 = rf(ctx, token, alias)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.EntityAlias) error); ok {
+		r1 = rf(ctx, token, alias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroup provides a mock function with given fields: ctx, token, group
+func (_m *SecretStoreClient) CreateGroup(ctx context.Context, token string, group types.Group) (string, error) {
+	ret := _m.Called(ctx, token, group)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Group) string); ok {
+		r0 = rf(ctx, token, group)
+	} else {
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(secretThreshold, secretShares)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.Group) error); ok {
+		r1 = rf(ctx, token, group)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -127,13 +262,13 @@ func (_m *SecretStoreClient) Init(secretThreshold int, secretShares int) (types.
 	return r0, r1
 }
 
-// InstallPolicy provides a mock function with given fields: token, policyName, policyDocument
-func (_m *SecretStoreClient) InstallPolicy(token string, policyName string, policyDocument string) error {
-	ret := _m.Called(token, policyName, policyDocument)
+// CreateIdentityTokenKey provides a mock function with given fields: ctx, token, keyName, key
+func (_m *SecretStoreClient) CreateIdentityTokenKey(ctx context.Context, token string, keyName string, key types.IdentityTokenKey) error {
+	ret := _m.Called(ctx, token, keyName, key)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(token, policyName, policyDocument)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.IdentityTokenKey) error); ok {
+		r0 = rf(ctx, token, keyName, key)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -141,22 +276,78 @@ func (_m *SecretStoreClient) InstallPolicy(token string, policyName string, poli
 	return r0
 }
 
-// ListTokenAccessors provides a mock function with given fields: token
-func (_m *SecretStoreClient) ListTokenAccessors(token string) ([]string, error) {
-	ret := _m.Called(token)
+// CreateIdentityTokenRole provides a mock function with given fields: ctx, token, roleName, role
+func (_m *SecretStoreClient) CreateIdentityTokenRole(ctx context.Context, token string, roleName string, role types.IdentityTokenRole) error {
+	ret := _m.Called(ctx, token, roleName, role)
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(token)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.IdentityTokenRole) error); ok {
+		r0 = rf(ctx, token, roleName, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePKIRole provides a mock function with given fields: ctx, token, mountPoint, roleName, role
+func (_m *SecretStoreClient) CreatePKIRole(ctx context.Context, token string, mountPoint string, roleName string, role types.PKIRole) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.PKIRole) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRabbitMQRole provides a mock function with given fields: ctx, token, mountPoint, roleName, role
+func (_m *SecretStoreClient) CreateRabbitMQRole(ctx context.Context, token string, mountPoint string, roleName string, role types.RabbitMQRole) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.RabbitMQRole) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSSHRole provides a mock function with given fields: ctx, token, mountPoint, roleName, role
+func (_m *SecretStoreClient) CreateSSHRole(ctx context.Context, token string, mountPoint string, roleName string, role types.SSHRole) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.SSHRole) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateToken provides a mock function with given fields: ctx, token, parameters
+func (_m *SecretStoreClient) CreateToken(ctx context.Context, token string, parameters map[string]interface{}) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, token, parameters)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) map[string]interface{}); ok {
+		r0 = rf(ctx, token, parameters)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(map[string]interface{})
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(token)
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, token, parameters)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -164,20 +355,34 @@ func (_m *SecretStoreClient) ListTokenAccessors(token string) ([]string, error)
 	return r0, r1
 }
 
-// LookupToken provides a mock function with given fields: token
-func (_m *SecretStoreClient) LookupToken(token string) (types.TokenMetadata, error) {
-	ret := _m.Called(token)
+// CreateTokenRole provides a mock function with given fields: ctx, token, roleName, role
+func (_m *SecretStoreClient) CreateTokenRole(ctx context.Context, token string, roleName string, role types.TokenRole) error {
+	ret := _m.Called(ctx, token, roleName, role)
 
-	var r0 types.TokenMetadata
-	if rf, ok := ret.Get(0).(func(string) types.TokenMetadata); ok {
-		r0 = rf(token)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.TokenRole) error); ok {
+		r0 = rf(ctx, token, roleName, role)
 	} else {
-		r0 = ret.Get(0).(types.TokenMetadata)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTokenWithRequest provides a mock function with given fields: ctx, token, request
+func (_m *SecretStoreClient) CreateTokenWithRequest(ctx context.Context, token string, request types.TokenCreateRequest) (types.TokenCreateResponse, error) {
+	ret := _m.Called(ctx, token, request)
+
+	var r0 types.TokenCreateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.TokenCreateRequest) types.TokenCreateResponse); ok {
+		r0 = rf(ctx, token, request)
+	} else {
+		r0 = ret.Get(0).(types.TokenCreateResponse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(token)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.TokenCreateRequest) error); ok {
+		r1 = rf(ctx, token, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -185,20 +390,34 @@ func (_m *SecretStoreClient) LookupToken(token string) (types.TokenMetadata, err
 	return r0, r1
 }
 
-// LookupTokenAccessor provides a mock function with given fields: token, accessor
-func (_m *SecretStoreClient) LookupTokenAccessor(token string, accessor string) (types.TokenMetadata, error) {
-	ret := _m.Called(token, accessor)
+// CreateTransitKey provides a mock function with given fields: ctx, token, mountPoint, keyName, key
+func (_m *SecretStoreClient) CreateTransitKey(ctx context.Context, token string, mountPoint string, keyName string, key types.TransitKey) error {
+	ret := _m.Called(ctx, token, mountPoint, keyName, key)
 
-	var r0 types.TokenMetadata
-	if rf, ok := ret.Get(0).(func(string, string) types.TokenMetadata); ok {
-		r0 = rf(token, accessor)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.TransitKey) error); ok {
+		r0 = rf(ctx, token, mountPoint, keyName, key)
 	} else {
-		r0 = ret.Get(0).(types.TokenMetadata)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DecodeRootToken provides a mock function with given fields: encodedToken, otp
+func (_m *SecretStoreClient) DecodeRootToken(encodedToken string, otp string) (string, error) {
+	ret := _m.Called(encodedToken, otp)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(encodedToken, otp)
+	} else {
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(token, accessor)
+		r1 = rf(encodedToken, otp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -206,20 +425,48 @@ func (_m *SecretStoreClient) LookupTokenAccessor(token string, accessor string)
 	return r0, r1
 }
 
-// RegenRootToken provides a mock function with given fields: keys
-func (_m *SecretStoreClient) RegenRootToken(keys []string) (string, error) {
-	ret := _m.Called(keys)
+// DeleteDatabaseRole provides a mock function with given fields: ctx, token, mountPoint, roleName
+func (_m *SecretStoreClient) DeleteDatabaseRole(ctx context.Context, token string, mountPoint string, roleName string) error {
+	ret := _m.Called(ctx, token, mountPoint, roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTokenRole provides a mock function with given fields: ctx, token, roleName
+func (_m *SecretStoreClient) DeleteTokenRole(ctx context.Context, token string, roleName string) error {
+	ret := _m.Called(ctx, token, roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeliverToCubbyhole provides a mock function with given fields: ctx, token, path, payload, ttl
+func (_m *SecretStoreClient) DeliverToCubbyhole(ctx context.Context, token string, path string, payload map[string]string, ttl time.Duration) (string, error) {
+	ret := _m.Called(ctx, token, path, payload, ttl)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func([]string) string); ok {
-		r0 = rf(keys)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, time.Duration) string); ok {
+		r0 = rf(ctx, token, path, payload, ttl)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]string) error); ok {
-		r1 = rf(keys)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]string, time.Duration) error); ok {
+		r1 = rf(ctx, token, path, payload, ttl)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -227,13 +474,125 @@ func (_m *SecretStoreClient) RegenRootToken(keys []string) (string, error) {
 	return r0, r1
 }
 
-// RevokeToken provides a mock function with given fields: token
-func (_m *SecretStoreClient) RevokeToken(token string) error {
-	ret := _m.Called(token)
+// DisableAuditDevice provides a mock function with given fields: ctx, token, devicePath
+func (_m *SecretStoreClient) DisableAuditDevice(ctx context.Context, token string, devicePath string) error {
+	ret := _m.Called(ctx, token, devicePath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, devicePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableAuthMethod provides a mock function with given fields: ctx, token, mountPath
+func (_m *SecretStoreClient) DisableAuthMethod(ctx context.Context, token string, mountPath string) error {
+	ret := _m.Called(ctx, token, mountPath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableSecretEngine provides a mock function with given fields: ctx, token, mountPoint
+func (_m *SecretStoreClient) DisableSecretEngine(ctx context.Context, token string, mountPoint string) error {
+	ret := _m.Called(ctx, token, mountPoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableAuditDevice provides a mock function with given fields: ctx, token, devicePath, device
+func (_m *SecretStoreClient) EnableAuditDevice(ctx context.Context, token string, devicePath string, device types.AuditDevice) error {
+	ret := _m.Called(ctx, token, devicePath, device)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.AuditDevice) error); ok {
+		r0 = rf(ctx, token, devicePath, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableAuthMethod provides a mock function with given fields: ctx, token, mountPath, method
+func (_m *SecretStoreClient) EnableAuthMethod(ctx context.Context, token string, mountPath string, method types.AuthMethod) error {
+	ret := _m.Called(ctx, token, mountPath, method)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.AuthMethod) error); ok {
+		r0 = rf(ctx, token, mountPath, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableConsulSecretEngine provides a mock function with given fields: ctx, token, mountPoint, defaultLeaseTTL
+func (_m *SecretStoreClient) EnableConsulSecretEngine(ctx context.Context, token string, mountPoint string, defaultLeaseTTL string) error {
+	ret := _m.Called(ctx, token, mountPoint, defaultLeaseTTL)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint, defaultLeaseTTL)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableDatabaseSecretEngine provides a mock function with given fields: ctx, token, mountPoint
+func (_m *SecretStoreClient) EnableDatabaseSecretEngine(ctx context.Context, token string, mountPoint string) error {
+	ret := _m.Called(ctx, token, mountPoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableKVSecretEngine provides a mock function with given fields: ctx, token, mountPoint, kvVersion
+func (_m *SecretStoreClient) EnableKVSecretEngine(ctx context.Context, token string, mountPoint string, kvVersion string) error {
+	ret := _m.Called(ctx, token, mountPoint, kvVersion)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint, kvVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnablePKIEngine provides a mock function with given fields: ctx, token, mountPoint, maxLeaseTTL
+func (_m *SecretStoreClient) EnablePKIEngine(ctx context.Context, token string, mountPoint string, maxLeaseTTL string) error {
+	ret := _m.Called(ctx, token, mountPoint, maxLeaseTTL)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(token)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint, maxLeaseTTL)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -241,13 +600,13 @@ func (_m *SecretStoreClient) RevokeToken(token string) error {
 	return r0
 }
 
-// RevokeTokenAccessor provides a mock function with given fields: token, accessor
-func (_m *SecretStoreClient) RevokeTokenAccessor(token string, accessor string) error {
-	ret := _m.Called(token, accessor)
+// EnableRabbitMQEngine provides a mock function with given fields: ctx, token, mountPoint
+func (_m *SecretStoreClient) EnableRabbitMQEngine(ctx context.Context, token string, mountPoint string) error {
+	ret := _m.Called(ctx, token, mountPoint)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(token, accessor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -255,16 +614,1026 @@ func (_m *SecretStoreClient) RevokeTokenAccessor(token string, accessor string)
 	return r0
 }
 
-// Unseal provides a mock function with given fields: keysBase64
-func (_m *SecretStoreClient) Unseal(keysBase64 []string) error {
-	ret := _m.Called(keysBase64)
+// EnableSSHEngine provides a mock function with given fields: ctx, token, mountPoint
+func (_m *SecretStoreClient) EnableSSHEngine(ctx context.Context, token string, mountPoint string) error {
+	ret := _m.Called(ctx, token, mountPoint)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]string) error); ok {
-		r0 = rf(keysBase64)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint)
 	} else {
 		r0 = ret.Error(0)
 	}
 
 	return r0
 }
+
+// EnableTransitEngine provides a mock function with given fields: ctx, token, mountPoint
+func (_m *SecretStoreClient) EnableTransitEngine(ctx context.Context, token string, mountPoint string) error {
+	ret := _m.Called(ctx, token, mountPoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExchangeToken provides a mock function with given fields: ctx, parentToken, request
+func (_m *SecretStoreClient) ExchangeToken(ctx context.Context, parentToken string, request types.TokenExchangeRequest) (types.TokenCreateResponse, error) {
+	ret := _m.Called(ctx, parentToken, request)
+
+	var r0 types.TokenCreateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.TokenExchangeRequest) types.TokenCreateResponse); ok {
+		r0 = rf(ctx, parentToken, request)
+	} else {
+		r0 = ret.Get(0).(types.TokenCreateResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.TokenExchangeRequest) error); ok {
+		r1 = rf(ctx, parentToken, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHAStatus provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) GetHAStatus(ctx context.Context, token string) ([]types.HANode, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 []types.HANode
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.HANode); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.HANode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKeyStatus provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) GetKeyStatus(ctx context.Context, token string) (types.KeyStatus, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 types.KeyStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.KeyStatus); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(types.KeyStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeader provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) GetLeader(ctx context.Context) (types.LeaderStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.LeaderStatus
+	if rf, ok := ret.Get(0).(func(context.Context) types.LeaderStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.LeaderStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokenRole provides a mock function with given fields: ctx, token, roleName
+func (_m *SecretStoreClient) GetTokenRole(ctx context.Context, token string, roleName string) (types.TokenRole, error) {
+	ret := _m.Called(ctx, token, roleName)
+
+	var r0 types.TokenRole
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.TokenRole); ok {
+		r0 = rf(ctx, token, roleName)
+	} else {
+		r0 = ret.Get(0).(types.TokenRole)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, roleName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HealthCheck provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) HealthCheck(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Init provides a mock function with given fields: ctx, secretThreshold, secretShares
+func (_m *SecretStoreClient) Init(ctx context.Context, secretThreshold int, secretShares int) (types.InitResponse, error) {
+	ret := _m.Called(ctx, secretThreshold, secretShares)
+
+	var r0 types.InitResponse
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) types.InitResponse); ok {
+		r0 = rf(ctx, secretThreshold, secretShares)
+	} else {
+		r0 = ret.Get(0).(types.InitResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, secretThreshold, secretShares)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallPolicy provides a mock function with given fields: ctx, token, policyName, policyDocument
+func (_m *SecretStoreClient) InstallPolicy(ctx context.Context, token string, policyName string, policyDocument string) error {
+	ret := _m.Called(ctx, token, policyName, policyDocument)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, policyName, policyDocument)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IssueCertificate provides a mock function with given fields: ctx, token, mountPoint, roleName, request
+func (_m *SecretStoreClient) IssueCertificate(ctx context.Context, token string, mountPoint string, roleName string, request types.CertificateRequest) (types.Certificate, error) {
+	ret := _m.Called(ctx, token, mountPoint, roleName, request)
+
+	var r0 types.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.CertificateRequest) types.Certificate); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, request)
+	} else {
+		r0 = ret.Get(0).(types.Certificate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, types.CertificateRequest) error); ok {
+		r1 = rf(ctx, token, mountPoint, roleName, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditDevices provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) ListAuditDevices(ctx context.Context, token string) (map[string]types.AuditDevice, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 map[string]types.AuditDevice
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]types.AuditDevice); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.AuditDevice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuthMethods provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) ListAuthMethods(ctx context.Context, token string) (map[string]types.AuthMount, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 map[string]types.AuthMount
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]types.AuthMount); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.AuthMount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRaftPeers provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) ListRaftPeers(ctx context.Context, token string) ([]types.RaftPeer, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 []types.RaftPeer
+	if rf, ok := ret.Get(0).(func(context.Context, string) []types.RaftPeer); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.RaftPeer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTokenAccessors provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) ListTokenAccessors(ctx context.Context, token string) ([]string, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTokenRoles provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) ListTokenRoles(ctx context.Context, token string) ([]string, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupEntity provides a mock function with given fields: ctx, token, name
+func (_m *SecretStoreClient) LookupEntity(ctx context.Context, token string, name string) (types.Entity, error) {
+	ret := _m.Called(ctx, token, name)
+
+	var r0 types.Entity
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.Entity); ok {
+		r0 = rf(ctx, token, name)
+	} else {
+		r0 = ret.Get(0).(types.Entity)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupGroup provides a mock function with given fields: ctx, token, name
+func (_m *SecretStoreClient) LookupGroup(ctx context.Context, token string, name string) (types.Group, error) {
+	ret := _m.Called(ctx, token, name)
+
+	var r0 types.Group
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.Group); ok {
+		r0 = rf(ctx, token, name)
+	} else {
+		r0 = ret.Get(0).(types.Group)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupLease provides a mock function with given fields: ctx, token, leaseId
+func (_m *SecretStoreClient) LookupLease(ctx context.Context, token string, leaseId string) (types.LeaseInfo, error) {
+	ret := _m.Called(ctx, token, leaseId)
+
+	var r0 types.LeaseInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.LeaseInfo); ok {
+		r0 = rf(ctx, token, leaseId)
+	} else {
+		r0 = ret.Get(0).(types.LeaseInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, leaseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupToken provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) LookupToken(ctx context.Context, token string) (types.TokenMetadata, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 types.TokenMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.TokenMetadata); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(types.TokenMetadata)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupTokenAccessor provides a mock function with given fields: ctx, token, accessor
+func (_m *SecretStoreClient) LookupTokenAccessor(ctx context.Context, token string, accessor string) (types.TokenMetadata, error) {
+	ret := _m.Called(ctx, token, accessor)
+
+	var r0 types.TokenMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.TokenMetadata); ok {
+		r0 = rf(ctx, token, accessor)
+	} else {
+		r0 = ret.Get(0).(types.TokenMetadata)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, token, accessor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupWrappingToken provides a mock function with given fields: ctx, wrappingToken
+func (_m *SecretStoreClient) LookupWrappingToken(ctx context.Context, wrappingToken string) (types.WrapInfo, error) {
+	ret := _m.Called(ctx, wrappingToken)
+
+	var r0 types.WrapInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.WrapInfo); ok {
+		r0 = rf(ctx, wrappingToken)
+	} else {
+		r0 = ret.Get(0).(types.WrapInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, wrappingToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadTransitKey provides a mock function with given fields: ctx, token, mountPoint, keyName
+func (_m *SecretStoreClient) ReadTransitKey(ctx context.Context, token string, mountPoint string, keyName string) (types.TransitKeyInfo, error) {
+	ret := _m.Called(ctx, token, mountPoint, keyName)
+
+	var r0 types.TransitKeyInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) types.TransitKeyInfo); ok {
+		r0 = rf(ctx, token, mountPoint, keyName)
+	} else {
+		r0 = ret.Get(0).(types.TransitKeyInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, token, mountPoint, keyName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiveFromCubbyhole provides a mock function with given fields: ctx, deliveryToken, path
+func (_m *SecretStoreClient) ReceiveFromCubbyhole(ctx context.Context, deliveryToken string, path string) (map[string]string, error) {
+	ret := _m.Called(ctx, deliveryToken, path)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) map[string]string); ok {
+		r0 = rf(ctx, deliveryToken, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, deliveryToken, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegenRootToken provides a mock function with given fields: ctx, keys
+func (_m *SecretStoreClient) RegenRootToken(ctx context.Context, keys []string) (string, error) {
+	ret := _m.Called(ctx, keys)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, []string) string); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RekeyCancel provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) RekeyCancel(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RekeyInit provides a mock function with given fields: ctx, request
+func (_m *SecretStoreClient) RekeyInit(ctx context.Context, request types.RekeyRequest) (types.RekeyStatus, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 types.RekeyStatus
+	if rf, ok := ret.Get(0).(func(context.Context, types.RekeyRequest) types.RekeyStatus); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(types.RekeyStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.RekeyRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RekeyStatus provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) RekeyStatus(ctx context.Context) (types.RekeyStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.RekeyStatus
+	if rf, ok := ret.Get(0).(func(context.Context) types.RekeyStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.RekeyStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RekeySubmitShare provides a mock function with given fields: ctx, key, nonce
+func (_m *SecretStoreClient) RekeySubmitShare(ctx context.Context, key string, nonce string) (types.RekeyStatus, error) {
+	ret := _m.Called(ctx, key, nonce)
+
+	var r0 types.RekeyStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.RekeyStatus); ok {
+		r0 = rf(ctx, key, nonce)
+	} else {
+		r0 = ret.Get(0).(types.RekeyStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RekeyVerify provides a mock function with given fields: ctx, key, verificationNonce
+func (_m *SecretStoreClient) RekeyVerify(ctx context.Context, key string, verificationNonce string) (types.RekeyVerificationStatus, error) {
+	ret := _m.Called(ctx, key, verificationNonce)
+
+	var r0 types.RekeyVerificationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.RekeyVerificationStatus); ok {
+		r0 = rf(ctx, key, verificationNonce)
+	} else {
+		r0 = ret.Get(0).(types.RekeyVerificationStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, verificationNonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemountSecretEngine provides a mock function with given fields: ctx, token, fromMountPoint, toMountPoint
+func (_m *SecretStoreClient) RemountSecretEngine(ctx context.Context, token string, fromMountPoint string, toMountPoint string) error {
+	ret := _m.Called(ctx, token, fromMountPoint, toMountPoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, fromMountPoint, toMountPoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveGroupMember provides a mock function with given fields: ctx, token, groupName, entityId
+func (_m *SecretStoreClient) RemoveGroupMember(ctx context.Context, token string, groupName string, entityId string) error {
+	ret := _m.Called(ctx, token, groupName, entityId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, groupName, entityId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveRaftPeer provides a mock function with given fields: ctx, token, nodeId
+func (_m *SecretStoreClient) RemoveRaftPeer(ctx context.Context, token string, nodeId string) error {
+	ret := _m.Called(ctx, token, nodeId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, nodeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenewToken provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) RenewToken(ctx context.Context, token string) (time.Duration, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRaftSnapshot provides a mock function with given fields: ctx, token, snapshot
+func (_m *SecretStoreClient) RestoreRaftSnapshot(ctx context.Context, token string, snapshot io.Reader) error {
+	ret := _m.Called(ctx, token, snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) error); ok {
+		r0 = rf(ctx, token, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeLeasePrefix provides a mock function with given fields: ctx, token, prefix
+func (_m *SecretStoreClient) RevokeLeasePrefix(ctx context.Context, token string, prefix string) error {
+	ret := _m.Called(ctx, token, prefix)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, prefix)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeToken provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) RevokeToken(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeTokenAccessor provides a mock function with given fields: ctx, token, accessor
+func (_m *SecretStoreClient) RevokeTokenAccessor(ctx context.Context, token string, accessor string) error {
+	ret := _m.Called(ctx, token, accessor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, token, accessor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateEncryptionKey provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) RotateEncryptionKey(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateTransitKey provides a mock function with given fields: ctx, token, mountPoint, keyName
+func (_m *SecretStoreClient) RotateTransitKey(ctx context.Context, token string, mountPoint string, keyName string) error {
+	ret := _m.Called(ctx, token, mountPoint, keyName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, token, mountPoint, keyName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Seal provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) Seal(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SealStatus provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) SealStatus(ctx context.Context) (types.SealStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.SealStatus
+	if rf, ok := ret.Get(0).(func(context.Context) types.SealStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.SealStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerFlavor provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) ServerFlavor(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignSSHKey provides a mock function with given fields: ctx, token, mountPoint, roleName, request
+func (_m *SecretStoreClient) SignSSHKey(ctx context.Context, token string, mountPoint string, roleName string, request types.SSHSignRequest) (types.SSHCertificate, error) {
+	ret := _m.Called(ctx, token, mountPoint, roleName, request)
+
+	var r0 types.SSHCertificate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.SSHSignRequest) types.SSHCertificate); ok {
+		r0 = rf(ctx, token, mountPoint, roleName, request)
+	} else {
+		r0 = ret.Get(0).(types.SSHCertificate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, types.SSHSignRequest) error); ok {
+		r1 = rf(ctx, token, mountPoint, roleName, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartGenerateRoot provides a mock function with given fields: ctx
+func (_m *SecretStoreClient) StartGenerateRoot(ctx context.Context) (types.GenerateRootStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.GenerateRootStatus
+	if rf, ok := ret.Get(0).(func(context.Context) types.GenerateRootStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.GenerateRootStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StepDown provides a mock function with given fields: ctx, token
+func (_m *SecretStoreClient) StepDown(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubmitGenerateRootShare provides a mock function with given fields: ctx, key, nonce
+func (_m *SecretStoreClient) SubmitGenerateRootShare(ctx context.Context, key string, nonce string) (types.GenerateRootStatus, error) {
+	ret := _m.Called(ctx, key, nonce)
+
+	var r0 types.GenerateRootStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) types.GenerateRootStatus); ok {
+		r0 = rf(ctx, key, nonce)
+	} else {
+		r0 = ret.Get(0).(types.GenerateRootStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TakeRaftSnapshot provides a mock function with given fields: ctx, token, snapshot
+func (_m *SecretStoreClient) TakeRaftSnapshot(ctx context.Context, token string, snapshot io.Writer) error {
+	ret := _m.Called(ctx, token, snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Writer) error); ok {
+		r0 = rf(ctx, token, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TrimTransitKeyVersions provides a mock function with given fields: ctx, token, mountPoint, keyName, minAvailableVersion
+func (_m *SecretStoreClient) TrimTransitKeyVersions(ctx context.Context, token string, mountPoint string, keyName string, minAvailableVersion int) error {
+	ret := _m.Called(ctx, token, mountPoint, keyName, minAvailableVersion)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) error); ok {
+		r0 = rf(ctx, token, mountPoint, keyName, minAvailableVersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TuneAuthMethod provides a mock function with given fields: ctx, token, mountPath, config
+func (_m *SecretStoreClient) TuneAuthMethod(ctx context.Context, token string, mountPath string, config types.AuthMethodConfig) error {
+	ret := _m.Called(ctx, token, mountPath, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.AuthMethodConfig) error); ok {
+		r0 = rf(ctx, token, mountPath, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TuneMount provides a mock function with given fields: ctx, token, mountPoint, config
+func (_m *SecretStoreClient) TuneMount(ctx context.Context, token string, mountPoint string, config types.MountConfig) error {
+	ret := _m.Called(ctx, token, mountPoint, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, types.MountConfig) error); ok {
+		r0 = rf(ctx, token, mountPoint, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unseal provides a mock function with given fields: ctx, keysBase64
+func (_m *SecretStoreClient) Unseal(ctx context.Context, keysBase64 []string) error {
+	ret := _m.Called(ctx, keysBase64)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, keysBase64)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnwrapToken provides a mock function with given fields: ctx, wrappingToken, options
+func (_m *SecretStoreClient) UnwrapToken(ctx context.Context, wrappingToken string, options types.UnwrapOptions) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, wrappingToken, options)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.UnwrapOptions) map[string]interface{}); ok {
+		r0 = rf(ctx, wrappingToken, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.UnwrapOptions) error); ok {
+		r1 = rf(ctx, wrappingToken, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTransitKeyConfig provides a mock function with given fields: ctx, token, mountPoint, keyName, config
+func (_m *SecretStoreClient) UpdateTransitKeyConfig(ctx context.Context, token string, mountPoint string, keyName string, config types.TransitKeyConfig) error {
+	ret := _m.Called(ctx, token, mountPoint, keyName, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.TransitKeyConfig) error); ok {
+		r0 = rf(ctx, token, mountPoint, keyName, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WrapData provides a mock function with given fields: ctx, token, data, ttl
+func (_m *SecretStoreClient) WrapData(ctx context.Context, token string, data map[string]interface{}, ttl time.Duration) (types.WrapInfo, error) {
+	ret := _m.Called(ctx, token, data, ttl)
+
+	var r0 types.WrapInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}, time.Duration) types.WrapInfo); ok {
+		r0 = rf(ctx, token, data, ttl)
+	} else {
+		r0 = ret.Get(0).(types.WrapInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}, time.Duration) error); ok {
+		r1 = rf(ctx, token, data, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,76 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// ProviderFactory creates the SecretClient for a provider registered with Register.
+// callback is the TokenExpiredCallback passed to NewSecretsClient, which providers without tokens can ignore.
+type ProviderFactory func(ctx context.Context, config types.SecretConfig, lc logger.LoggingClient,
+	callback pkg.TokenExpiredCallback) (SecretClient, error)
+
+var (
+	providersMutex sync.RWMutex
+	providers      = make(map[string]ProviderFactory)
+)
+
+// Register makes a SecretClient provider available to NewSecretsClient, which selects it when SecretConfig.Type
+// matches name. It is intended to be called from the init function of the package implementing the provider.
+// Register panics if factory is nil or a provider is already registered with name, as both are programming errors.
+func Register(name string, factory ProviderFactory) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	if factory == nil {
+		panic(fmt.Sprintf("secrets: Register factory is nil for provider '%s'", name))
+	}
+	if _, exists := providers[name]; exists {
+		panic(fmt.Sprintf("secrets: Register called twice for provider '%s'", name))
+	}
+
+	providers[name] = factory
+}
+
+// Providers returns the sorted names of the registered providers
+func Providers() []string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func lookupProvider(name string) (ProviderFactory, bool) {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	factory, ok := providers[name]
+	return factory, ok
+}
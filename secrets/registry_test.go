@@ -0,0 +1,87 @@
+/*******************************************************************************
+ * Copyright 2026 Intel Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/v2/pkg/types"
+	"github.com/edgexfoundry/go-mod-secrets/v2/secrets/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+func TestRegister(t *testing.T) {
+	expected := &mocks.SecretClient{}
+	var receivedConfig types.SecretConfig
+
+	Register("custom", func(_ context.Context, config types.SecretConfig, _ logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		receivedConfig = config
+		return expected, nil
+	})
+	defer func() {
+		providersMutex.Lock()
+		delete(providers, "custom")
+		providersMutex.Unlock()
+	}()
+
+	config := types.SecretConfig{Type: "custom", Path: "/edgex/"}
+	client, err := NewSecretsClient(context.Background(), config, logger.NewMockClient(), nil)
+	require.NoError(t, err)
+	assert.Same(t, expected, client)
+	assert.Equal(t, config, receivedConfig)
+
+	assert.Contains(t, Providers(), "custom")
+	assert.Contains(t, Providers(), Vault)
+
+	assert.Panics(t, func() {
+		Register("custom", func(context.Context, types.SecretConfig, logger.LoggingClient, pkg.TokenExpiredCallback) (SecretClient, error) {
+			return nil, nil
+		})
+	})
+	assert.Panics(t, func() { Register("nil", nil) })
+}
+
+// infoRecorder records the messages logged at the INFO level
+type infoRecorder struct {
+	logger.LoggingClient
+	messages []string
+}
+
+func (r *infoRecorder) Info(msg string, _ ...interface{}) {
+	r.messages = append(r.messages, msg)
+}
+
+func TestRegisterRedactedLogger(t *testing.T) {
+	Register("logging", func(_ context.Context, _ types.SecretConfig, lc logger.LoggingClient, _ pkg.TokenExpiredCallback) (SecretClient, error) {
+		lc.Infof("logged in with token %s", "hvs.CAESIJ7l0vS1mXk3P4u8q2Zb9aWx")
+		return &mocks.SecretClient{}, nil
+	})
+	defer func() {
+		providersMutex.Lock()
+		delete(providers, "logging")
+		providersMutex.Unlock()
+	}()
+
+	recorder := &infoRecorder{LoggingClient: logger.NewMockClient()}
+	_, err := NewSecretsClient(context.Background(), types.SecretConfig{Type: "logging"}, recorder, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logged in with token " + types.Redacted}, recorder.messages)
+}